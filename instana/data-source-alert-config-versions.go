@@ -0,0 +1,160 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewAlertConfigVersionsDataSource creates a new DataSource for the versions of alert configs
+func NewAlertConfigVersionsDataSource() DataSource {
+	return &alertConfigVersionsDataSource{}
+}
+
+const (
+	//AlertConfigVersionsFieldAlertConfigType constant value for the schema field alert_config_type
+	AlertConfigVersionsFieldAlertConfigType = "alert_config_type"
+	//AlertConfigVersionsFieldAlertConfigID constant value for the schema field alert_config_id
+	AlertConfigVersionsFieldAlertConfigID = "alert_config_id"
+	//AlertConfigVersionsFieldVersions constant value for the computed schema field versions
+	AlertConfigVersionsFieldVersions = "versions"
+	//AlertConfigVersionsFieldCreated constant value for the computed schema field versions.created
+	AlertConfigVersionsFieldCreated = "created"
+	//AlertConfigVersionsFieldDeleted constant value for the computed schema field versions.deleted
+	AlertConfigVersionsFieldDeleted = "deleted"
+	//AlertConfigVersionsFieldEnabled constant value for the computed schema field versions.enabled
+	AlertConfigVersionsFieldEnabled = "enabled"
+	//AlertConfigVersionsFieldChangeType constant value for the computed schema field versions.change_type
+	AlertConfigVersionsFieldChangeType = "change_type"
+	//AlertConfigVersionsFieldAuthorID constant value for the computed schema field versions.author_id
+	AlertConfigVersionsFieldAuthorID = "author_id"
+	//AlertConfigVersionsFieldAuthorType constant value for the computed schema field versions.author_type
+	AlertConfigVersionsFieldAuthorType = "author_type"
+	//DataSourceAlertConfigVersions the name of the terraform-provider-instana data source for the versions of alert configs
+	DataSourceAlertConfigVersions = "instana_alert_config_versions"
+)
+
+type alertConfigVersionsDataSource struct{}
+
+// CreateResource creates the resource handle for the versions of alert configs
+func (ds *alertConfigVersionsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			AlertConfigVersionsFieldAlertConfigType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(restapi.SupportedAlertConfigTypes.ToStringSlice(), false),
+				Description:  "The type of the alert config (application, global_application or website)",
+			},
+			AlertConfigVersionsFieldAlertConfigID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the alert config",
+			},
+			AlertConfigVersionsFieldVersions: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the alert config in the order returned by the Instana API, i.e. sorted descending by their creation timestamp (newest version first)",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						AlertConfigVersionsFieldCreated: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The creation timestamp of the version in milliseconds since epoch. The timestamp identifies the version",
+						},
+						AlertConfigVersionsFieldDeleted: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates if the alert config was deleted with this version",
+						},
+						AlertConfigVersionsFieldEnabled: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates if the alert config was enabled in this version",
+						},
+						AlertConfigVersionsFieldChangeType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the change which created the version",
+						},
+						AlertConfigVersionsFieldAuthorID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the author of the version",
+						},
+						AlertConfigVersionsFieldAuthorType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the author of the version",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *alertConfigVersionsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	alertConfigType := restapi.AlertConfigType(d.Get(AlertConfigVersionsFieldAlertConfigType).(string))
+	alertConfigID := d.Get(AlertConfigVersionsFieldAlertConfigID).(string)
+
+	versionResource, err := getAlertConfigVersionResource(instanaAPI, alertConfigType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versions, err := versionResource.GetVersions(alertConfigID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, alertConfigType, alertConfigID, *versions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *alertConfigVersionsDataSource) updateState(d *schema.ResourceData, alertConfigType restapi.AlertConfigType, alertConfigID string, versions []*restapi.AlertConfigVersion) error {
+	versionsState := make([]interface{}, len(versions))
+	for i, version := range versions {
+		versionState := map[string]interface{}{
+			AlertConfigVersionsFieldCreated: version.Created,
+			AlertConfigVersionsFieldDeleted: version.Deleted,
+			AlertConfigVersionsFieldEnabled: version.Enabled,
+		}
+		if version.ChangeSummary != nil {
+			versionState[AlertConfigVersionsFieldChangeType] = version.ChangeSummary.ChangeType
+			versionState[AlertConfigVersionsFieldAuthorID] = version.ChangeSummary.Author.ID
+			versionState[AlertConfigVersionsFieldAuthorType] = version.ChangeSummary.Author.Type
+		}
+		versionsState[i] = versionState
+	}
+
+	d.SetId(string(alertConfigType) + ":" + alertConfigID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		AlertConfigVersionsFieldVersions: versionsState,
+	})
+}
+
+func getAlertConfigVersionResource(api restapi.InstanaAPI, alertConfigType restapi.AlertConfigType) (restapi.AlertConfigVersionResource, error) {
+	switch alertConfigType {
+	case restapi.AlertConfigTypeApplication:
+		return api.ApplicationAlertConfigVersions(), nil
+	case restapi.AlertConfigTypeGlobalApplication:
+		return api.GlobalApplicationAlertConfigVersions(), nil
+	case restapi.AlertConfigTypeWebsite:
+		return api.WebsiteAlertConfigVersions(), nil
+	default:
+		return nil, fmt.Errorf("alert config type %s is not supported", alertConfigType)
+	}
+}
@@ -0,0 +1,184 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAlertConfigVersionsDataSource(t *testing.T) {
+	unitTest := &dataSourceAlertConfigVersionsUnitTest{}
+	t.Run("integration test read of alert config versions", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read versions of application alert config", unitTest.createTestShouldSuccessfullyReadVersions(restapi.AlertConfigTypeApplication, func(api *mocks.MockInstanaAPI, versionResource restapi.AlertConfigVersionResource) {
+		api.EXPECT().ApplicationAlertConfigVersions().Return(versionResource).Times(1)
+	}))
+	t.Run("should successfully read versions of global application alert config", unitTest.createTestShouldSuccessfullyReadVersions(restapi.AlertConfigTypeGlobalApplication, func(api *mocks.MockInstanaAPI, versionResource restapi.AlertConfigVersionResource) {
+		api.EXPECT().GlobalApplicationAlertConfigVersions().Return(versionResource).Times(1)
+	}))
+	t.Run("should successfully read versions of website alert config", unitTest.createTestShouldSuccessfullyReadVersions(restapi.AlertConfigTypeWebsite, func(api *mocks.MockInstanaAPI, versionResource restapi.AlertConfigVersionResource) {
+		api.EXPECT().WebsiteAlertConfigVersions().Return(versionResource).Times(1)
+	}))
+	t.Run("should fail to read versions when api call fails", unitTest.shouldFailToReadVersionsWhenApiCallFails)
+}
+
+const dataSourceAlertConfigVersionsDefinitionPath = "data.instana_alert_config_versions.example"
+const alertConfigVersionsAlertConfigID = "alert-config-id"
+
+type dataSourceAlertConfigVersionsUnitTest struct{}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+[
+	{
+		"id": "alert-config-id",
+		"created": 1000,
+		"deleted": false,
+		"enabled": true,
+		"changeSummary": { "author": { "id": "user-id", "type": "USER" }, "changeType": "CREATE" }
+	},
+	{
+		"id": "alert-config-id",
+		"created": 2000,
+		"deleted": false,
+		"enabled": false
+	}
+]
+`
+	httpServer := createMockHttpServerForDataSource(restapi.WebsiteAlertConfigResourcePath+"/{id}/versions", newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceAlertConfigVersionsDefinition := `
+data "instana_alert_config_versions" "example" {
+  alert_config_type = "website"
+  alert_config_id   = "alert-config-id"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceAlertConfigVersionsDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAlertConfigVersionsDefinitionPath, "id", "website:alert-config-id"),
+					resource.TestCheckResourceAttr(dataSourceAlertConfigVersionsDefinitionPath, fmt.Sprintf("%s.#", AlertConfigVersionsFieldVersions), "2"),
+					resource.TestCheckResourceAttr(dataSourceAlertConfigVersionsDefinitionPath, fmt.Sprintf("%s.0.%s", AlertConfigVersionsFieldVersions, AlertConfigVersionsFieldCreated), "1000"),
+					resource.TestCheckResourceAttr(dataSourceAlertConfigVersionsDefinitionPath, fmt.Sprintf("%s.0.%s", AlertConfigVersionsFieldVersions, AlertConfigVersionsFieldEnabled), trueAsString),
+					resource.TestCheckResourceAttr(dataSourceAlertConfigVersionsDefinitionPath, fmt.Sprintf("%s.0.%s", AlertConfigVersionsFieldVersions, AlertConfigVersionsFieldChangeType), "CREATE"),
+					resource.TestCheckResourceAttr(dataSourceAlertConfigVersionsDefinitionPath, fmt.Sprintf("%s.0.%s", AlertConfigVersionsFieldVersions, AlertConfigVersionsFieldAuthorID), "user-id"),
+					resource.TestCheckResourceAttr(dataSourceAlertConfigVersionsDefinitionPath, fmt.Sprintf("%s.0.%s", AlertConfigVersionsFieldVersions, AlertConfigVersionsFieldAuthorType), "USER"),
+					resource.TestCheckResourceAttr(dataSourceAlertConfigVersionsDefinitionPath, fmt.Sprintf("%s.1.%s", AlertConfigVersionsFieldVersions, AlertConfigVersionsFieldCreated), "2000"),
+					resource.TestCheckResourceAttr(dataSourceAlertConfigVersionsDefinitionPath, fmt.Sprintf("%s.1.%s", AlertConfigVersionsFieldVersions, AlertConfigVersionsFieldEnabled), falseAsString),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewAlertConfigVersionsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 3)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertConfigVersionsFieldAlertConfigType)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertConfigVersionsFieldAlertConfigID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertConfigVersionsFieldVersions)
+
+	versionSchema := schemaData[AlertConfigVersionsFieldVersions].Elem.(*schema.Resource).Schema
+	require.Len(t, versionSchema, 6)
+	versionSchemaAssert := testutils.NewTerraformSchemaAssert(versionSchema, t)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeInt(AlertConfigVersionsFieldCreated)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeBool(AlertConfigVersionsFieldDeleted)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeBool(AlertConfigVersionsFieldEnabled)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertConfigVersionsFieldChangeType)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertConfigVersionsFieldAuthorID)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertConfigVersionsFieldAuthorType)
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) createTestShouldSuccessfullyReadVersions(alertConfigType restapi.AlertConfigType, expectVersionResource func(api *mocks.MockInstanaAPI, versionResource restapi.AlertConfigVersionResource)) func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.AlertConfigVersion](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+			//versions are returned newest first by the Instana API
+			versions := []*restapi.AlertConfigVersion{
+				{ID: alertConfigVersionsAlertConfigID, Created: 2000, Deleted: true},
+				{
+					ID:      alertConfigVersionsAlertConfigID,
+					Created: 1000,
+					Enabled: true,
+					ChangeSummary: &restapi.AlertConfigChangeSummary{
+						Author:     restapi.AlertConfigVersionAuthor{ID: "user-id", Type: "USER"},
+						ChangeType: "CREATE",
+					},
+				},
+			}
+
+			versionResource := mocks.NewMockAlertConfigVersionResource(ctrl)
+			versionResource.EXPECT().GetVersions(alertConfigVersionsAlertConfigID).Times(1).Return(&versions, nil)
+			expectVersionResource(mockInstanaApi, versionResource)
+
+			sut := NewAlertConfigVersionsDataSource().CreateResource()
+			resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+				AlertConfigVersionsFieldAlertConfigType: string(alertConfigType),
+				AlertConfigVersionsFieldAlertConfigID:   alertConfigVersionsAlertConfigID,
+			})
+
+			diag := sut.ReadContext(nil, resourceData, meta)
+
+			require.Nil(t, diag)
+			require.Equal(t, fmt.Sprintf("%s:%s", alertConfigType, alertConfigVersionsAlertConfigID), resourceData.Id())
+			require.Equal(t, []interface{}{
+				map[string]interface{}{
+					AlertConfigVersionsFieldCreated:    2000,
+					AlertConfigVersionsFieldDeleted:    true,
+					AlertConfigVersionsFieldEnabled:    false,
+					AlertConfigVersionsFieldChangeType: "",
+					AlertConfigVersionsFieldAuthorID:   "",
+					AlertConfigVersionsFieldAuthorType: "",
+				},
+				map[string]interface{}{
+					AlertConfigVersionsFieldCreated:    1000,
+					AlertConfigVersionsFieldDeleted:    false,
+					AlertConfigVersionsFieldEnabled:    true,
+					AlertConfigVersionsFieldChangeType: "CREATE",
+					AlertConfigVersionsFieldAuthorID:   "user-id",
+					AlertConfigVersionsFieldAuthorType: "USER",
+				},
+			}, resourceData.Get(AlertConfigVersionsFieldVersions))
+		})
+	}
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) shouldFailToReadVersionsWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertConfigVersion](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		versionResource := mocks.NewMockAlertConfigVersionResource(ctrl)
+		versionResource.EXPECT().GetVersions(alertConfigVersionsAlertConfigID).Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().WebsiteAlertConfigVersions().Return(versionResource).Times(1)
+
+		sut := NewAlertConfigVersionsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			AlertConfigVersionsFieldAlertConfigType: string(restapi.AlertConfigTypeWebsite),
+			AlertConfigVersionsFieldAlertConfigID:   alertConfigVersionsAlertConfigID,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
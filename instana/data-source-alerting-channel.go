@@ -7,7 +7,7 @@ import (
 	"github.com/gessnerfl/terraform-provider-instana/tfutils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"reflect"
+	"maps"
 	"strings"
 )
 
@@ -32,53 +32,11 @@ func (ds *alertingChannelDataSource) CreateResource() *schema.Resource {
 }
 
 func (ds *alertingChannelDataSource) convertResourceSchema() map[string]*schema.Schema {
-	resourceSchema := NewAlertingChannelResourceHandle().MetaData().Schema
+	resourceSchema := maps.Clone(NewAlertingChannelResourceHandle().MetaData().Schema)
+	//verification of alerting channels is only supported when the alerting channel is managed by terraform
+	delete(resourceSchema, AlertingChannelFieldVerifyOnApply)
 
-	return ds.convertSchemaMap(resourceSchema)
-}
-
-func (ds *alertingChannelDataSource) convertSchemaMap(schemaMap map[string]*schema.Schema) map[string]*schema.Schema {
-	result := make(map[string]*schema.Schema)
-
-	for k, v := range schemaMap {
-		if k == AlertingChannelFieldName {
-			//for the key we assume a simple type. Here we copy the schema including all configuration and make sure
-			//the field is required
-			s := *v
-			s.Required = true
-			s.Optional = false
-			s.Computed = false
-			result[k] = &s
-		} else {
-			//For all other fields we create a new schema, mark it as computed and then set the minimal data required
-			//for the computed datasource field
-			s := &schema.Schema{}
-			s.Description = v.Description
-			s.Deprecated = v.Deprecated
-			s.Type = v.Type
-			s.Required = false
-			s.Optional = false
-			s.Computed = true
-
-			if v.Type == schema.TypeList || v.Type == schema.TypeSet || v.Type == schema.TypeMap {
-				if reflect.TypeOf(v.Elem) == reflect.TypeOf(&schema.Resource{}) {
-					nestedSchema := v.Elem.(*schema.Resource).Schema
-					convertedNestedSchema := ds.convertSchemaMap(nestedSchema)
-					s.Elem = &schema.Resource{
-						Schema: convertedNestedSchema,
-					}
-				} else if reflect.TypeOf(v.Elem) == reflect.TypeOf(&schema.Schema{}) {
-					nestedSchema := *v.Elem.(*schema.Schema)
-					s.Elem = &nestedSchema
-				} else {
-					s.Elem = v.Elem
-				}
-			}
-			result[k] = s
-		}
-	}
-
-	return result
+	return convertResourceSchemaToDataSourceSchema(resourceSchema, AlertingChannelFieldName)
 }
 
 func (ds *alertingChannelDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -152,6 +110,24 @@ func (ds *alertingChannelDataSource) mapChannelToState(channel *restapi.Alerting
 	if channel.Kind == restapi.GoogleChatChannelType {
 		return ds.mapGoogleChatChannelToState(channel), nil
 	}
+	if channel.Kind == restapi.ServiceNowChannelType {
+		return ds.mapServiceNowChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.SalesforceChannelType {
+		return ds.mapSalesforceChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.PrometheusWebhookChannelType {
+		return ds.mapPrometheusWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WatsonAIOpsWebhookChannelType {
+		return ds.mapWatsonAIOpsWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WebexTeamsWebhookChannelType {
+		return ds.mapWebexTeamsWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.ZChatOpsChannelType {
+		return ds.mapZChatOpsChannelToState(channel), nil
+	}
 	return nil, fmt.Errorf("received unsupported alerting channel of type %s", channel.Kind)
 }
 
@@ -284,3 +260,83 @@ func (ds *alertingChannelDataSource) mapGoogleChatChannelToState(channel *restap
 		},
 	}
 }
+
+func (ds *alertingChannelDataSource) mapServiceNowChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelServiceNow: []interface{}{
+			map[string]interface{}{
+				AlertingChannelServiceNowFieldServiceNowURL: channel.ServiceNowURL,
+				AlertingChannelServiceNowFieldUsername:      channel.Username,
+				AlertingChannelServiceNowFieldPassword:      channel.Password,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapSalesforceChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelSalesforce: []interface{}{
+			map[string]interface{}{
+				AlertingChannelSalesforceFieldSalesforceURL: channel.SalesforceURL,
+				AlertingChannelSalesforceFieldClientID:      channel.ClientID,
+				AlertingChannelSalesforceFieldClientSecret:  channel.ClientSecret,
+				AlertingChannelSalesforceFieldBearerToken:   channel.BearerToken,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapPrometheusWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelPrometheusWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL:    channel.WebhookURL,
+				AlertingChannelPrometheusWebhookFieldReceiver: channel.Receiver,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapWatsonAIOpsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	headers := ds.createHTTPHeaderMapFromList(channel.Headers)
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWatsonAIOpsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+				AlertingChannelWebhookFieldHTTPHeaders:     headers,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapWebexTeamsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWebexTeamsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapZChatOpsChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	var zChatOpsChannel *string
+	if len(channel.Channels) > 0 {
+		zChatOpsChannel = &channel.Channels[0]
+	}
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelZChatOps: []interface{}{
+			map[string]interface{}{
+				AlertingChannelZChatOpsFieldIncidentsURL:    channel.ZChatOpsIncidentsURL,
+				AlertingChannelZChatOpsFieldBearerAuthToken: channel.BearerAuthToken,
+				AlertingChannelZChatOpsFieldChannel:         zChatOpsChannel,
+			},
+		},
+	}
+}
@@ -25,6 +25,12 @@ func TestAlertingChannelDataSource(t *testing.T) {
 	t.Run("integration test read of webhook alerting channel", alertingChannelWebhookDataSourceIntegrationTest().testRead)
 	t.Run("integration test read of office 365 alerting channel", alertingChannelOffice365DataSourceIntegrationTest().testRead)
 	t.Run("integration test read of google chat alerting channel", alertingChannelGoogleChatDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of service now alerting channel", alertingChannelServiceNowDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of salesforce alerting channel", alertingChannelSalesforceDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of prometheus webhook alerting channel", alertingChannelPrometheusWebhookDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of watson aiops webhook alerting channel", alertingChannelWatsonAIOpsWebhookDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of webex teams webhook alerting channel", alertingChannelWebexTeamsWebhookDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of zchatops alerting channel", alertingChannelZChatOpsDataSourceIntegrationTest().testRead)
 	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
 	t.Run("schema version should be 0", unitTest.shouldHaveSchemaVersion0)
 	t.Run("should successfully read channel", unitTest.shouldSuccessfullyReadChannel)
@@ -138,6 +144,75 @@ func alertingChannelGoogleChatDataSourceIntegrationTest() *dataSourceAlertingCha
 	)
 }
 
+func alertingChannelServiceNowDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666670",
+		"my-service-now-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldServiceNowURL), "service-now-url"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldUsername), "username"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldPassword), ""),
+		},
+	)
+}
+
+func alertingChannelSalesforceDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666671",
+		"my-salesforce-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldSalesforceURL), "salesforce-url"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldClientID), "client-id"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldClientSecret), ""),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldBearerToken), ""),
+		},
+	)
+}
+
+func alertingChannelPrometheusWebhookDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666672",
+		"my-prometheus-webhook-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url-prometheus"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelPrometheusWebhookFieldReceiver), "receiver"),
+		},
+	)
+}
+
+func alertingChannelWatsonAIOpsWebhookDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666673",
+		"my-watson-aiops-webhook-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url-watson-aiops"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookFieldHTTPHeaders), "key1"), "value1"),
+		},
+	)
+}
+
+func alertingChannelWebexTeamsWebhookDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666674",
+		"my-webex-teams-webhook-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebexTeamsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url-webex-teams"),
+		},
+	)
+}
+
+func alertingChannelZChatOpsDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666675",
+		"my-zchatops-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelZChatOps, AlertingChannelZChatOpsFieldIncidentsURL), "incidents-url"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelZChatOps, AlertingChannelZChatOpsFieldBearerAuthToken), ""),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelZChatOps, AlertingChannelZChatOpsFieldChannel), "channel"),
+		},
+	)
+}
+
 func newDataSourceAlertingChannelIntegrationTest(id, channelName string, additionalChecks []resource.TestCheckFunc) *dataSourceAlertingChannelIntegrationTest {
 	return &dataSourceAlertingChannelIntegrationTest{
 		id:               id,
@@ -206,6 +281,41 @@ func (r *dataSourceAlertingChannelIntegrationTest) testRead(t *testing.T) {
 	"name"   	 : "my-google-chat-channel",
 	"kind"   	 : "GOOGLE_CHAT",
 	"webhookUrl" : "webhook-url-google-chat"
+},{
+	"id": "666670",
+	"name": "my-service-now-channel",
+	"kind": "SERVICE_NOW_WEBHOOK",
+	"serviceNowUrl": "service-now-url",
+	"username": "username"
+},{
+	"id": "666671",
+	"name": "my-salesforce-channel",
+	"kind": "SALESFORCE",
+	"salesforceUrl": "salesforce-url",
+	"clientId": "client-id"
+},{
+	"id": "666672",
+	"name": "my-prometheus-webhook-channel",
+	"kind": "PROMETHEUS_WEBHOOK",
+	"webhookUrl": "webhook-url-prometheus",
+	"receiver": "receiver"
+},{
+	"id": "666673",
+	"name": "my-watson-aiops-webhook-channel",
+	"kind": "WATSON_AIOPS_WEBHOOK",
+	"webhookUrl": "webhook-url-watson-aiops",
+	"headers": [ "key1: value1" ]
+},{
+	"id": "666674",
+	"name": "my-webex-teams-webhook-channel",
+	"kind": "WEBEX_TEAMS_WEBHOOK",
+	"webhookUrl": "webhook-url-webex-teams"
+},{
+	"id": "666675",
+	"name": "my-zchatops-channel",
+	"kind": "Z_CHATOPS",
+	"zchatOpsIncidentsUrl": "incidents-url",
+	"channels": [ "channel" ]
 }]
 `
 	httpServer := createMockHttpServerForDataSource(restapi.AlertingChannelsResourcePath, newStringContentResponseProvider(serverResponse))
@@ -243,7 +353,7 @@ func (r *dataSourceAlertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaData := NewAlertingChannelDataSource().CreateResource().Schema
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
-	require.Len(t, schemaData, 10)
+	require.Len(t, schemaData, 16)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
 
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelEmail)
@@ -255,6 +365,12 @@ func (r *dataSourceAlertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelWebhook)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelOffice365)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelGoogleChat)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelServiceNow)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelSalesforce)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelPrometheusWebhook)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelWatsonAIOpsWebhook)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelWebexTeamsWebhook)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelZChatOps)
 
 	r.validateEmailChannelSchema(t, schemaData[AlertingChannelFieldChannelEmail].Elem.(*schema.Resource).Schema)
 	r.validateOpsGenieChannelSchema(t, schemaData[AlertingChannelFieldChannelOpsGenie].Elem.(*schema.Resource).Schema)
@@ -265,6 +381,12 @@ func (r *dataSourceAlertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	r.validateWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWebhook].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelOffice365].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelGoogleChat].Elem.(*schema.Resource).Schema)
+	r.validateServiceNowChannelSchema(t, schemaData[AlertingChannelFieldChannelServiceNow].Elem.(*schema.Resource).Schema)
+	r.validateSalesforceChannelSchema(t, schemaData[AlertingChannelFieldChannelSalesforce].Elem.(*schema.Resource).Schema)
+	r.validatePrometheusWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelPrometheusWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWatsonAIOpsWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWatsonAIOpsWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelWebexTeamsWebhook].Elem.(*schema.Resource).Schema)
+	r.validateZChatOpsChannelSchema(t, schemaData[AlertingChannelFieldChannelZChatOps].Elem.(*schema.Resource).Schema)
 }
 
 func (r *dataSourceAlertingChannelUnitTest) validateEmailChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
@@ -322,6 +444,49 @@ func (r *dataSourceAlertingChannelUnitTest) validateWebhookBasedChannelSchema(t
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
 }
 
+func (r *dataSourceAlertingChannelUnitTest) validateServiceNowChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelServiceNowFieldServiceNowURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelServiceNowFieldUsername)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelServiceNowFieldPassword)
+	require.True(t, channelSchema[AlertingChannelServiceNowFieldPassword].Sensitive)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validateSalesforceChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 4)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelSalesforceFieldSalesforceURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelSalesforceFieldClientID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelSalesforceFieldClientSecret)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelSalesforceFieldBearerToken)
+	require.True(t, channelSchema[AlertingChannelSalesforceFieldClientSecret].Sensitive)
+	require.True(t, channelSchema[AlertingChannelSalesforceFieldBearerToken].Sensitive)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validatePrometheusWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelPrometheusWebhookFieldReceiver)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validateWatsonAIOpsWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeMapOfStrings(AlertingChannelWebhookFieldHTTPHeaders)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validateZChatOpsChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelZChatOpsFieldIncidentsURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelZChatOpsFieldBearerAuthToken)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelZChatOpsFieldChannel)
+	require.True(t, channelSchema[AlertingChannelZChatOpsFieldBearerAuthToken].Sensitive)
+}
+
 func (r *dataSourceAlertingChannelUnitTest) shouldHaveSchemaVersion0(t *testing.T) {
 	require.Equal(t, 0, NewAlertingChannelResourceHandle().MetaData().SchemaVersion)
 }
@@ -0,0 +1,191 @@
+package instana
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewAlertingChannelsDataSource creates a new DataSource for multiple alerting channels
+func NewAlertingChannelsDataSource() DataSource {
+	return &alertingChannelsDataSource{}
+}
+
+const (
+	//AlertingChannelsFieldNameRegex constant value for the schema field name_regex
+	AlertingChannelsFieldNameRegex = "name_regex"
+	//AlertingChannelsFieldNamePrefix constant value for the schema field name_prefix
+	AlertingChannelsFieldNamePrefix = "name_prefix"
+	//AlertingChannelsFieldTypes constant value for the schema field types
+	AlertingChannelsFieldTypes = "types"
+	//AlertingChannelsFieldIDs constant value for the computed schema field ids
+	AlertingChannelsFieldIDs = "ids"
+	//AlertingChannelsFieldChannels constant value for the computed schema field channels
+	AlertingChannelsFieldChannels = "channels"
+	//AlertingChannelsFieldChannelID constant value for the computed schema field channels.id
+	AlertingChannelsFieldChannelID = "id"
+	//AlertingChannelsFieldChannelName constant value for the computed schema field channels.name
+	AlertingChannelsFieldChannelName = "name"
+	//AlertingChannelsFieldChannelType constant value for the computed schema field channels.type
+	AlertingChannelsFieldChannelType = "type"
+	//DataSourceAlertingChannels the name of the terraform-provider-instana data source for multiple alerting channels
+	DataSourceAlertingChannels = "instana_alerting_channels"
+)
+
+// alertingChannelTypeFieldsByKind maps the kind of alerting channels of the Instana API to the corresponding
+// terraform field names of the alerting channel resource
+var alertingChannelTypeFieldsByKind = map[restapi.AlertingChannelType]string{
+	restapi.EmailChannelType:              AlertingChannelFieldChannelEmail,
+	restapi.OpsGenieChannelType:           AlertingChannelFieldChannelOpsGenie,
+	restapi.PagerDutyChannelType:          AlertingChannelFieldChannelPageDuty,
+	restapi.SlackChannelType:              AlertingChannelFieldChannelSlack,
+	restapi.SplunkChannelType:             AlertingChannelFieldChannelSplunk,
+	restapi.VictorOpsChannelType:          AlertingChannelFieldChannelVictorOps,
+	restapi.WebhookChannelType:            AlertingChannelFieldChannelWebhook,
+	restapi.Office365ChannelType:          AlertingChannelFieldChannelOffice365,
+	restapi.GoogleChatChannelType:         AlertingChannelFieldChannelGoogleChat,
+	restapi.ServiceNowChannelType:         AlertingChannelFieldChannelServiceNow,
+	restapi.SalesforceChannelType:         AlertingChannelFieldChannelSalesforce,
+	restapi.PrometheusWebhookChannelType:  AlertingChannelFieldChannelPrometheusWebhook,
+	restapi.WatsonAIOpsWebhookChannelType: AlertingChannelFieldChannelWatsonAIOpsWebhook,
+	restapi.WebexTeamsWebhookChannelType:  AlertingChannelFieldChannelWebexTeamsWebhook,
+	restapi.ZChatOpsChannelType:           AlertingChannelFieldChannelZChatOps,
+}
+
+type alertingChannelsDataSource struct{}
+
+// CreateResource creates the resource handle for multiple alerting channels
+func (ds *alertingChannelsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			AlertingChannelsFieldNameRegex: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Regular expression the name of the alerting channels must match",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			AlertingChannelsFieldNamePrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefix the name of the alerting channels must start with",
+			},
+			AlertingChannelsFieldTypes: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(AlertingChannelTypeFields, false),
+				},
+				Description: "The types of the alerting channels which should be returned. The types are named like the channel blocks of the alerting channel resource",
+			},
+			AlertingChannelsFieldIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the matching alerting channels",
+			},
+			AlertingChannelsFieldChannels: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching alerting channels",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						AlertingChannelsFieldChannelID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the alerting channel",
+						},
+						AlertingChannelsFieldChannelName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the alerting channel",
+						},
+						AlertingChannelsFieldChannelType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the alerting channel",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	nameFilter, err := newNameFilter(d, AlertingChannelsFieldNameRegex, AlertingChannelsFieldNamePrefix)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data, err := instanaAPI.AlertingChannels().GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alertingChannels := ds.filterAlertingChannels(d, nameFilter, data)
+
+	err = ds.updateState(d, alertingChannels)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *alertingChannelsDataSource) filterAlertingChannels(d *schema.ResourceData, nameFilter *nameFilter, data *[]*restapi.AlertingChannel) []*restapi.AlertingChannel {
+	types := ReadStringSetParameterFromResource(d, AlertingChannelsFieldTypes)
+
+	result := make([]*restapi.AlertingChannel, 0)
+	for _, channel := range *data {
+		if !nameFilter.matches(channel.Name) {
+			continue
+		}
+		if len(types) > 0 && !slices.Contains(types, alertingChannelTypeFieldsByKind[channel.Kind]) {
+			continue
+		}
+		result = append(result, channel)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name == result[j].Name {
+			return result[i].ID < result[j].ID
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func (ds *alertingChannelsDataSource) updateState(d *schema.ResourceData, alertingChannels []*restapi.AlertingChannel) error {
+	ids := make([]string, len(alertingChannels))
+	channels := make([]interface{}, len(alertingChannels))
+	for i, channel := range alertingChannels {
+		ids[i] = channel.ID
+		channelType, ok := alertingChannelTypeFieldsByKind[channel.Kind]
+		if !ok {
+			//unsupported channel types are provided as reported by the Instana API
+			channelType = string(channel.Kind)
+		}
+		channels[i] = map[string]interface{}{
+			AlertingChannelsFieldChannelID:   channel.ID,
+			AlertingChannelsFieldChannelName: channel.Name,
+			AlertingChannelsFieldChannelType: channelType,
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	return tfutils.UpdateState(d, map[string]interface{}{
+		AlertingChannelsFieldIDs:      ids,
+		AlertingChannelsFieldChannels: channels,
+	})
+}
@@ -0,0 +1,199 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAlertingChannelsDataSource(t *testing.T) {
+	unitTest := &dataSourceAlertingChannelsUnitTest{}
+	t.Run("integration test read of alerting channels", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should return all alerting channels sorted by name when no filter is provided", unitTest.shouldReturnAllAlertingChannelsSortedByNameWhenNoFilterIsProvided)
+	t.Run("should filter alerting channels by name regex and prefix", unitTest.shouldFilterAlertingChannelsByNameRegexAndPrefix)
+	t.Run("should filter alerting channels by types", unitTest.shouldFilterAlertingChannelsByTypes)
+	t.Run("should fail to read alerting channels when regex is invalid", unitTest.shouldFailToReadAlertingChannelsWhenRegexIsInvalid)
+	t.Run("should fail to read alerting channels when api call fails", unitTest.shouldFailToReadAlertingChannelsWhenApiCallFails)
+}
+
+const dataSourceAlertingChannelsDefinitionPath = "data.instana_alerting_channels.example"
+
+type dataSourceAlertingChannelsUnitTest struct{}
+
+func (r *dataSourceAlertingChannelsUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+[
+	{
+		"id" : "channel-id-1",
+		"name" : "team-a-email",
+		"kind" : "EMAIL",
+		"emails" : [ "team-a@example.com" ]
+	},
+	{
+		"id" : "channel-id-2",
+		"name" : "team-a-slack",
+		"kind" : "SLACK",
+		"webhookUrl" : "https://hooks.slack.com/services/abc"
+	},
+	{
+		"id" : "channel-id-3",
+		"name" : "team-b-email",
+		"kind" : "EMAIL",
+		"emails" : [ "team-b@example.com" ]
+	}
+]
+`
+	httpServer := createMockHttpServerForDataSource(restapi.AlertingChannelsResourcePath, newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceAlertingChannelsDefinition := `
+data "instana_alerting_channels" "example" {
+  name_prefix = "team-a-"
+  types       = [ "email" ]
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceAlertingChannelsDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAlertingChannelsDefinitionPath, fmt.Sprintf("%s.#", AlertingChannelsFieldIDs), "1"),
+					resource.TestCheckResourceAttr(dataSourceAlertingChannelsDefinitionPath, fmt.Sprintf("%s.0", AlertingChannelsFieldIDs), "channel-id-1"),
+					resource.TestCheckResourceAttr(dataSourceAlertingChannelsDefinitionPath, fmt.Sprintf("%s.#", AlertingChannelsFieldChannels), "1"),
+					resource.TestCheckResourceAttr(dataSourceAlertingChannelsDefinitionPath, fmt.Sprintf("%s.0.%s", AlertingChannelsFieldChannels, AlertingChannelsFieldChannelName), "team-a-email"),
+					resource.TestCheckResourceAttr(dataSourceAlertingChannelsDefinitionPath, fmt.Sprintf("%s.0.%s", AlertingChannelsFieldChannels, AlertingChannelsFieldChannelType), AlertingChannelFieldChannelEmail),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceAlertingChannelsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewAlertingChannelsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 5)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelsFieldNameRegex)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelsFieldNamePrefix)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(AlertingChannelsFieldTypes)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelsFieldChannels)
+	require.Equal(t, schema.TypeSet, schemaData[AlertingChannelsFieldIDs].Type)
+	require.True(t, schemaData[AlertingChannelsFieldIDs].Computed)
+
+	channelSchemaAssert := testutils.NewTerraformSchemaAssert(schemaData[AlertingChannelsFieldChannels].Elem.(*schema.Resource).Schema, t)
+	channelSchemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelsFieldChannelID)
+	channelSchemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelsFieldChannelName)
+	channelSchemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelsFieldChannelType)
+}
+
+func (r *dataSourceAlertingChannelsUnitTest) shouldReturnAllAlertingChannelsSortedByNameWhenNoFilterIsProvided(t *testing.T) {
+	resourceData := r.executeRead(t, map[string]interface{}{})
+
+	require.Equal(t, []interface{}{"channel-id-4", "channel-id-1", "channel-id-2", "channel-id-3"}, r.readChannelIDs(resourceData))
+	require.Len(t, resourceData.Get(AlertingChannelsFieldIDs).(*schema.Set).List(), 4)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{AlertingChannelsFieldChannelID: "channel-id-4", AlertingChannelsFieldChannelName: "legacy", AlertingChannelsFieldChannelType: "UNKNOWN"},
+		map[string]interface{}{AlertingChannelsFieldChannelID: "channel-id-1", AlertingChannelsFieldChannelName: "team-a-email", AlertingChannelsFieldChannelType: AlertingChannelFieldChannelEmail},
+		map[string]interface{}{AlertingChannelsFieldChannelID: "channel-id-2", AlertingChannelsFieldChannelName: "team-a-slack", AlertingChannelsFieldChannelType: AlertingChannelFieldChannelSlack},
+		map[string]interface{}{AlertingChannelsFieldChannelID: "channel-id-3", AlertingChannelsFieldChannelName: "team-b-email", AlertingChannelsFieldChannelType: AlertingChannelFieldChannelEmail},
+	}, resourceData.Get(AlertingChannelsFieldChannels))
+}
+
+func (r *dataSourceAlertingChannelsUnitTest) shouldFilterAlertingChannelsByNameRegexAndPrefix(t *testing.T) {
+	resourceData := r.executeRead(t, map[string]interface{}{
+		AlertingChannelsFieldNameRegex:  "-email$",
+		AlertingChannelsFieldNamePrefix: "team-",
+	})
+
+	require.Equal(t, []interface{}{"channel-id-1", "channel-id-3"}, r.readChannelIDs(resourceData))
+}
+
+func (r *dataSourceAlertingChannelsUnitTest) shouldFilterAlertingChannelsByTypes(t *testing.T) {
+	resourceData := r.executeRead(t, map[string]interface{}{
+		AlertingChannelsFieldTypes: []interface{}{AlertingChannelFieldChannelSlack},
+	})
+
+	require.Equal(t, []interface{}{"channel-id-2"}, r.readChannelIDs(resourceData))
+}
+
+func (r *dataSourceAlertingChannelsUnitTest) shouldFailToReadAlertingChannelsWhenRegexIsInvalid(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		sut := NewAlertingChannelsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			AlertingChannelsFieldNameRegex: "team-(",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "invalid regular expression provided for name_regex")
+	})
+}
+
+func (r *dataSourceAlertingChannelsUnitTest) shouldFailToReadAlertingChannelsWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		alertingChannelsAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		alertingChannelsAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().AlertingChannels().Return(alertingChannelsAPI).Times(1)
+
+		sut := NewAlertingChannelsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
+
+func (r *dataSourceAlertingChannelsUnitTest) executeRead(t *testing.T, config map[string]interface{}) *schema.ResourceData {
+	var resourceData *schema.ResourceData
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		alertingChannels := []*restapi.AlertingChannel{
+			{ID: "channel-id-3", Name: "team-b-email", Kind: restapi.EmailChannelType},
+			{ID: "channel-id-1", Name: "team-a-email", Kind: restapi.EmailChannelType},
+			{ID: "channel-id-2", Name: "team-a-slack", Kind: restapi.SlackChannelType},
+			{ID: "channel-id-4", Name: "legacy", Kind: restapi.AlertingChannelType("UNKNOWN")},
+		}
+		alertingChannelsAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		alertingChannelsAPI.EXPECT().GetAll().Times(1).Return(&alertingChannels, nil)
+		mockInstanaApi.EXPECT().AlertingChannels().Return(alertingChannelsAPI).Times(1)
+
+		sut := NewAlertingChannelsDataSource().CreateResource()
+		resourceData = schema.TestResourceDataRaw(t, sut.Schema, config)
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+	})
+	return resourceData
+}
+
+func (r *dataSourceAlertingChannelsUnitTest) readChannelIDs(resourceData *schema.ResourceData) []interface{} {
+	result := make([]interface{}, 0)
+	for _, channel := range resourceData.Get(AlertingChannelsFieldChannels).([]interface{}) {
+		result = append(result, channel.(map[string]interface{})[AlertingChannelsFieldChannelID])
+	}
+	return result
+}
@@ -0,0 +1,153 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewApdexReportDataSource creates a new DataSource for apdex reports
+func NewApdexReportDataSource() DataSource {
+	return &apdexReportDataSource{}
+}
+
+const (
+	//ApdexReportFieldApdexID constant value for the schema field apdex_id
+	ApdexReportFieldApdexID = "apdex_id"
+	//ApdexReportFieldApdexScore constant value for the computed schema field apdex_score
+	ApdexReportFieldApdexScore = "apdex_score"
+	//ApdexReportFieldApdexScores constant value for the computed schema field apdex_scores
+	ApdexReportFieldApdexScores = "apdex_scores"
+	//ApdexReportFieldTimestamp constant value for the computed schema field apdex_scores.timestamp
+	ApdexReportFieldTimestamp = "timestamp"
+	//ApdexReportFieldScore constant value for the computed schema field apdex_scores.score
+	ApdexReportFieldScore = "score"
+	//ApdexReportFieldFrom constant value for the computed schema field from
+	ApdexReportFieldFrom = "from"
+	//ApdexReportFieldTo constant value for the computed schema field to
+	ApdexReportFieldTo = "to"
+	//ApdexReportDefaultTimeFrame the default time frame of the apdex report when from is not defined
+	ApdexReportDefaultTimeFrame = 24 * time.Hour
+	//DataSourceApdexReport the name of the terraform-provider-instana data source for apdex reports
+	DataSourceApdexReport = "instana_apdex_report"
+)
+
+type apdexReportDataSource struct{}
+
+// CreateResource creates the resource handle for apdex reports
+func (ds *apdexReportDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			ApdexReportFieldApdexID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the apdex configuration (instana_apdex_config)",
+			},
+			ApdexReportFieldApdexScore: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The most recent apdex score of the report",
+			},
+			ApdexReportFieldApdexScores: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The apdex scores of the report in chronological order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ApdexReportFieldTimestamp: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The timestamp of the apdex score in milliseconds since epoch",
+						},
+						ApdexReportFieldScore: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The apdex score",
+						},
+					},
+				},
+			},
+			ApdexReportFieldFrom: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The start of the time frame of the report in milliseconds since epoch. Defaults to 24 hours before the end of the time frame",
+			},
+			ApdexReportFieldTo: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The end of the time frame of the report in milliseconds since epoch. Defaults to the current time",
+			},
+		},
+	}
+}
+
+func (ds *apdexReportDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	apdexID := d.Get(ApdexReportFieldApdexID).(string)
+	from, to, err := ds.getTimeFrame(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	report, err := instanaAPI.ApdexReports().GetReport(apdexID, from, to)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, apdexID, report)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *apdexReportDataSource) getTimeFrame(d *schema.ResourceData) (int64, int64, error) {
+	to := time.Now().UnixMilli()
+	if v, ok := d.GetOk(ApdexReportFieldTo); ok {
+		to = int64(v.(int))
+	}
+	from := to - ApdexReportDefaultTimeFrame.Milliseconds()
+	if v, ok := d.GetOk(ApdexReportFieldFrom); ok {
+		from = int64(v.(int))
+	}
+	if from >= to {
+		return 0, 0, fmt.Errorf("%s must be before %s of the apdex report", ApdexReportFieldFrom, ApdexReportFieldTo)
+	}
+	return from, to, nil
+}
+
+func (ds *apdexReportDataSource) updateState(d *schema.ResourceData, apdexID string, report *restapi.ApdexReport) error {
+	var latestScore *float64
+	scores := make([]interface{}, 0, len(report.ApdexScore))
+	for _, s := range report.ApdexScore {
+		if len(s) != 2 {
+			continue
+		}
+		score := s[1]
+		latestScore = &score
+		scores = append(scores, map[string]interface{}{
+			ApdexReportFieldTimestamp: int64(s[0]),
+			ApdexReportFieldScore:     score,
+		})
+	}
+
+	d.SetId(apdexID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ApdexReportFieldApdexScore:  latestScore,
+		ApdexReportFieldApdexScores: scores,
+		ApdexReportFieldFrom:        report.From,
+		ApdexReportFieldTo:          report.To,
+	})
+}
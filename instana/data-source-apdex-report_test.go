@@ -0,0 +1,247 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestApdexReportDataSource(t *testing.T) {
+	unitTest := &dataSourceApdexReportUnitTest{}
+	t.Run("integration test read of apdex report", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read apdex report", unitTest.shouldSuccessfullyReadApdexReport)
+	t.Run("should successfully read apdex report without scores", unitTest.shouldSuccessfullyReadApdexReportWithoutScores)
+	t.Run("should fail to read apdex report when api call fails", unitTest.shouldFailToReadApdexReportWhenApiCallFails)
+	t.Run("should request apdex report of the last 24 hours when no time frame is defined", unitTest.shouldRequestApdexReportOfTheLast24HoursWhenNoTimeFrameIsDefined)
+	t.Run("should request apdex report of 24 hours before the end when only to is defined", unitTest.shouldRequestApdexReportOf24HoursBeforeTheEndWhenOnlyToIsDefined)
+	t.Run("should fail to read apdex report when from is not before to", unitTest.shouldFailToReadApdexReportWhenFromIsNotBeforeTo)
+}
+
+const dataSourceApdexReportDefinitionPath = "data.instana_apdex_report.example"
+const apdexReportID = "apdex-id"
+
+type dataSourceApdexReportUnitTest struct{}
+
+func (r *dataSourceApdexReportUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+[
+	{
+		"apdexId": "apdex-id",
+		"apdexScore": [ [ 1700000000000, 0.75 ], [ 1700000060000, 0.9 ] ],
+		"from": 1700000000000,
+		"to": 1700000060000
+	}
+]
+`
+	httpServer := createMockHttpServerForDataSource(restapi.ApdexReportResourcePath+"/{id}", newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceApdexReportDefinition := `
+data "instana_apdex_report" "example" {
+  apdex_id = "apdex-id"
+  from     = 1700000000000
+  to       = 1700000060000
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceApdexReportDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceApdexReportDefinitionPath, "id", apdexReportID),
+					resource.TestCheckResourceAttr(dataSourceApdexReportDefinitionPath, ApdexReportFieldApdexScore, "0.9"),
+					resource.TestCheckResourceAttr(dataSourceApdexReportDefinitionPath, fmt.Sprintf("%s.#", ApdexReportFieldApdexScores), "2"),
+					resource.TestCheckResourceAttr(dataSourceApdexReportDefinitionPath, fmt.Sprintf("%s.0.%s", ApdexReportFieldApdexScores, ApdexReportFieldTimestamp), "1700000000000"),
+					resource.TestCheckResourceAttr(dataSourceApdexReportDefinitionPath, fmt.Sprintf("%s.0.%s", ApdexReportFieldApdexScores, ApdexReportFieldScore), "0.75"),
+					resource.TestCheckResourceAttr(dataSourceApdexReportDefinitionPath, ApdexReportFieldFrom, "1700000000000"),
+					resource.TestCheckResourceAttr(dataSourceApdexReportDefinitionPath, ApdexReportFieldTo, "1700000060000"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceApdexReportUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewApdexReportDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 5)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApdexReportFieldApdexID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(ApdexReportFieldApdexScores)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(ApdexReportFieldFrom)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(ApdexReportFieldFrom)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(ApdexReportFieldTo)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(ApdexReportFieldTo)
+	require.Equal(t, schema.TypeFloat, schemaData[ApdexReportFieldApdexScore].Type)
+	require.True(t, schemaData[ApdexReportFieldApdexScore].Computed)
+
+	scoreSchema := schemaData[ApdexReportFieldApdexScores].Elem.(*schema.Resource).Schema
+	require.Len(t, scoreSchema, 2)
+	testutils.NewTerraformSchemaAssert(scoreSchema, t).AssertSchemaIsComputedAndOfTypeInt(ApdexReportFieldTimestamp)
+	require.Equal(t, schema.TypeFloat, scoreSchema[ApdexReportFieldScore].Type)
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldSuccessfullyReadApdexReport(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexReport](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		report := &restapi.ApdexReport{
+			ApdexID:    apdexReportID,
+			ApdexScore: [][]float64{{1000, 0.5}, {2000, 0.8}},
+			From:       1000,
+			To:         2000,
+		}
+
+		apdexReportAPI := mocks.NewMockApdexReportResource(ctrl)
+		apdexReportAPI.EXPECT().GetReport(apdexReportID, int64(1000), int64(2000)).Times(1).Return(report, nil)
+		mockInstanaApi.EXPECT().ApdexReports().Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: apdexReportID,
+			ApdexReportFieldFrom:    1000,
+			ApdexReportFieldTo:      2000,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, apdexReportID, resourceData.Id())
+		require.Equal(t, 0.8, resourceData.Get(ApdexReportFieldApdexScore))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{ApdexReportFieldTimestamp: 1000, ApdexReportFieldScore: 0.5},
+			map[string]interface{}{ApdexReportFieldTimestamp: 2000, ApdexReportFieldScore: 0.8},
+		}, resourceData.Get(ApdexReportFieldApdexScores))
+		require.Equal(t, 1000, resourceData.Get(ApdexReportFieldFrom))
+		require.Equal(t, 2000, resourceData.Get(ApdexReportFieldTo))
+	})
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldSuccessfullyReadApdexReportWithoutScores(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexReport](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		apdexReportAPI := mocks.NewMockApdexReportResource(ctrl)
+		apdexReportAPI.EXPECT().GetReport(apdexReportID, gomock.Any(), gomock.Any()).Times(1).Return(&restapi.ApdexReport{ApdexID: apdexReportID}, nil)
+		mockInstanaApi.EXPECT().ApdexReports().Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: apdexReportID,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, apdexReportID, resourceData.Id())
+		_, scoreSet := resourceData.GetOk(ApdexReportFieldApdexScore)
+		require.False(t, scoreSet)
+		require.Empty(t, resourceData.Get(ApdexReportFieldApdexScores))
+	})
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldFailToReadApdexReportWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexReport](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		apdexReportAPI := mocks.NewMockApdexReportResource(ctrl)
+		apdexReportAPI.EXPECT().GetReport(apdexReportID, gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().ApdexReports().Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: apdexReportID,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldRequestApdexReportOfTheLast24HoursWhenNoTimeFrameIsDefined(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexReport](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		var requestedFrom, requestedTo int64
+		before := time.Now().UnixMilli()
+
+		apdexReportAPI := mocks.NewMockApdexReportResource(ctrl)
+		apdexReportAPI.EXPECT().GetReport(apdexReportID, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ string, from int64, to int64) (*restapi.ApdexReport, error) {
+			requestedFrom = from
+			requestedTo = to
+			return &restapi.ApdexReport{ApdexID: apdexReportID, From: from, To: to}, nil
+		})
+		mockInstanaApi.EXPECT().ApdexReports().Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: apdexReportID,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.GreaterOrEqual(t, requestedTo, before)
+		require.LessOrEqual(t, requestedTo, time.Now().UnixMilli())
+		require.Equal(t, (24 * time.Hour).Milliseconds(), requestedTo-requestedFrom)
+		require.Equal(t, int(requestedFrom), resourceData.Get(ApdexReportFieldFrom))
+		require.Equal(t, int(requestedTo), resourceData.Get(ApdexReportFieldTo))
+	})
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldRequestApdexReportOf24HoursBeforeTheEndWhenOnlyToIsDefined(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexReport](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		to := int64(1700000000000)
+		from := to - (24 * time.Hour).Milliseconds()
+
+		apdexReportAPI := mocks.NewMockApdexReportResource(ctrl)
+		apdexReportAPI.EXPECT().GetReport(apdexReportID, from, to).Times(1).Return(&restapi.ApdexReport{ApdexID: apdexReportID, From: from, To: to}, nil)
+		mockInstanaApi.EXPECT().ApdexReports().Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: apdexReportID,
+			ApdexReportFieldTo:      int(to),
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+	})
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldFailToReadApdexReportWhenFromIsNotBeforeTo(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexReport](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		mockInstanaApi.EXPECT().ApdexReports().Times(0)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: apdexReportID,
+			ApdexReportFieldFrom:    2000,
+			ApdexReportFieldTo:      1000,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "from must be before to")
+	})
+}
@@ -0,0 +1,13 @@
+package instana
+
+import "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+
+// DataSourceApplicationConfig the name of the terraform-provider-instana data source to read application configs (application perspectives) by label
+const DataSourceApplicationConfig = "instana_application_config"
+
+// NewApplicationConfigDataSource creates a new DataSource for application configs (application perspectives)
+func NewApplicationConfigDataSource() DataSource {
+	return newResourceLookupDataSource[*restapi.ApplicationConfig](NewApplicationConfigResourceHandle(), ApplicationConfigFieldLabel, "application config", func(obj *restapi.ApplicationConfig) string {
+		return obj.Label
+	}, false)
+}
@@ -0,0 +1,116 @@
+package instana_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestApplicationConfigDataSource(t *testing.T) {
+	unitTest := &dataSourceApplicationConfigUnitTest{}
+	t.Run("integration test read of application config", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read application config by label", unitTest.shouldSuccessfullyReadApplicationConfigByLabel)
+}
+
+const dataSourceApplicationConfigDefinitionPath = "data.instana_application_config.example"
+
+const dataSourceApplicationConfigServerResponse = `
+[
+	{
+		"id" : "application-config-id-1",
+		"label" : "application-1",
+		"scope" : "INCLUDE_NO_DOWNSTREAM",
+		"boundaryScope" : "DEFAULT"
+	},
+	{
+		"id" : "application-config-id-2",
+		"label" : "application-2",
+		"scope" : "INCLUDE_ALL_DOWNSTREAM",
+		"boundaryScope" : "ALL",
+		"tagFilterExpression" : {
+			"type" : "TAG_FILTER",
+			"name" : "service.name",
+			"entity" : "DESTINATION",
+			"operator" : "EQUALS",
+			"stringValue" : "my-service",
+			"value" : "my-service"
+		}
+	}
+]
+`
+
+type dataSourceApplicationConfigUnitTest struct{}
+
+func (r *dataSourceApplicationConfigUnitTest) integrationTestRead(t *testing.T) {
+	httpServer := createMockHttpServerForDataSource(restapi.ApplicationConfigsResourcePath, newStringContentResponseProvider(dataSourceApplicationConfigServerResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceApplicationConfigDefinition := `
+data "instana_application_config" "example" {
+  label = "application-2"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceApplicationConfigDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceApplicationConfigDefinitionPath, "id", "application-config-id-2"),
+					resource.TestCheckResourceAttr(dataSourceApplicationConfigDefinitionPath, ApplicationConfigFieldLabel, "application-2"),
+					resource.TestCheckResourceAttr(dataSourceApplicationConfigDefinitionPath, ApplicationConfigFieldScope, "INCLUDE_ALL_DOWNSTREAM"),
+					resource.TestCheckResourceAttr(dataSourceApplicationConfigDefinitionPath, ApplicationConfigFieldBoundaryScope, "ALL"),
+					resource.TestCheckResourceAttr(dataSourceApplicationConfigDefinitionPath, ApplicationConfigFieldTagFilter, "service.name@dest EQUALS 'my-service'"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceApplicationConfigUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewApplicationConfigDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 4)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApplicationConfigFieldLabel)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(ApplicationConfigFieldScope)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(ApplicationConfigFieldBoundaryScope)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(ApplicationConfigFieldTagFilter)
+}
+
+func (r *dataSourceApplicationConfigUnitTest) shouldSuccessfullyReadApplicationConfigByLabel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		applicationConfigs := []*restapi.ApplicationConfig{
+			{ID: "application-config-id-1", Label: "application-1", Scope: restapi.ApplicationConfigScopeIncludeNoDownstream, BoundaryScope: restapi.BoundaryScopeDefault},
+			{ID: "application-config-id-2", Label: "application-2", Scope: restapi.ApplicationConfigScopeIncludeAllDownstream, BoundaryScope: restapi.BoundaryScopeAll},
+		}
+
+		applicationConfigsAPI := mocks.NewMockRestResource[*restapi.ApplicationConfig](ctrl)
+		applicationConfigsAPI.EXPECT().GetAll().Times(1).Return(&applicationConfigs, nil)
+		mockInstanaApi.EXPECT().ApplicationConfigs().Return(applicationConfigsAPI).Times(1)
+
+		sut := NewApplicationConfigDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApplicationConfigFieldLabel: "application-2",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "application-config-id-2", resourceData.Id())
+		require.Equal(t, "application-2", resourceData.Get(ApplicationConfigFieldLabel))
+		require.Equal(t, string(restapi.ApplicationConfigScopeIncludeAllDownstream), resourceData.Get(ApplicationConfigFieldScope))
+		require.Equal(t, string(restapi.BoundaryScopeAll), resourceData.Get(ApplicationConfigFieldBoundaryScope))
+	})
+}
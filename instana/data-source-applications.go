@@ -0,0 +1,100 @@
+package instana
+
+import (
+	"context"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewApplicationsDataSource creates a new DataSource for the applications discovered by application monitoring
+func NewApplicationsDataSource() DataSource {
+	return &applicationsDataSource{}
+}
+
+const (
+	//ApplicationsFieldApplications constant value for the computed schema field applications
+	ApplicationsFieldApplications = "applications"
+	//ApplicationsFieldApplicationID constant value for the computed schema field applications.id
+	ApplicationsFieldApplicationID = "id"
+	//ApplicationsFieldApplicationLabel constant value for the computed schema field applications.label
+	ApplicationsFieldApplicationLabel = "label"
+	//ApplicationsFieldApplicationBoundaryScope constant value for the computed schema field applications.boundary_scope
+	ApplicationsFieldApplicationBoundaryScope = "boundary_scope"
+	//DataSourceApplications the name of the terraform-provider-instana data source for applications
+	DataSourceApplications = "instana_applications"
+)
+
+type applicationsDataSource struct{}
+
+// CreateResource creates the resource handle for applications
+func (ds *applicationsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: createDiscoverySchema("application", map[string]*schema.Schema{
+			ApplicationsFieldApplications: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching applications",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ApplicationsFieldApplicationID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the application",
+						},
+						ApplicationsFieldApplicationLabel: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the application",
+						},
+						ApplicationsFieldApplicationBoundaryScope: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The boundary scope of the application",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (ds *applicationsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	data, err := instanaAPI.Applications().GetAll(readDiscoveryQuery(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	applications := filterDiscoveredObjects(d, data, func(a *restapi.Application) string { return a.Label }, func(_ *restapi.Application) bool { return true })
+
+	err = ds.updateState(d, applications)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *applicationsDataSource) updateState(d *schema.ResourceData, data []*restapi.Application) error {
+	ids := make([]string, len(data))
+	applications := make([]interface{}, len(data))
+	for i, application := range data {
+		ids[i] = application.ID
+		applications[i] = map[string]interface{}{
+			ApplicationsFieldApplicationID:            application.ID,
+			ApplicationsFieldApplicationLabel:         application.Label,
+			ApplicationsFieldApplicationBoundaryScope: application.BoundaryScope,
+		}
+	}
+
+	d.SetId(createDiscoveryID(ids))
+	return tfutils.UpdateState(d, map[string]interface{}{
+		DiscoveryFieldIDs:             ids,
+		ApplicationsFieldApplications: applications,
+	})
+}
@@ -0,0 +1,163 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestApplicationsDataSource(t *testing.T) {
+	unitTest := &dataSourceApplicationsUnitTest{}
+	t.Run("integration test read of applications", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should return all applications sorted by label when no filter is provided", unitTest.shouldReturnAllApplicationsSortedByLabelWhenNoFilterIsProvided)
+	t.Run("should pass name filter and window size to api and filter applications by label", unitTest.shouldPassNameFilterAndWindowSizeToApiAndFilterApplicationsByLabel)
+	t.Run("should fail to read applications when api call fails", unitTest.shouldFailToReadApplicationsWhenApiCallFails)
+}
+
+const dataSourceApplicationsDefinitionPath = "data.instana_applications.example"
+
+type dataSourceApplicationsUnitTest struct{}
+
+func (r *dataSourceApplicationsUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+{
+	"items" : [
+		{ "id" : "application-id-1", "label" : "shop", "boundaryScope" : "INBOUND", "entityType" : "APPLICATION" },
+		{ "id" : "application-id-2", "label" : "shop-backend", "boundaryScope" : "ALL", "entityType" : "APPLICATION" }
+	],
+	"page" : 1,
+	"pageSize" : 200,
+	"totalHits" : 2
+}
+`
+	httpServer := createMockHttpServerForDataSource(restapi.ApplicationsResourcePath, newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceApplicationsDefinition := `
+data "instana_applications" "example" {
+  name_filter = "shop"
+  label       = "shop"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceApplicationsDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceApplicationsDefinitionPath, fmt.Sprintf("%s.#", DiscoveryFieldIDs), "1"),
+					resource.TestCheckResourceAttr(dataSourceApplicationsDefinitionPath, fmt.Sprintf("%s.0", DiscoveryFieldIDs), "application-id-1"),
+					resource.TestCheckResourceAttr(dataSourceApplicationsDefinitionPath, fmt.Sprintf("%s.#", ApplicationsFieldApplications), "1"),
+					resource.TestCheckResourceAttr(dataSourceApplicationsDefinitionPath, fmt.Sprintf("%s.0.%s", ApplicationsFieldApplications, ApplicationsFieldApplicationLabel), "shop"),
+					resource.TestCheckResourceAttr(dataSourceApplicationsDefinitionPath, fmt.Sprintf("%s.0.%s", ApplicationsFieldApplications, ApplicationsFieldApplicationBoundaryScope), "INBOUND"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceApplicationsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewApplicationsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 5)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(DiscoveryFieldNameFilter)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(DiscoveryFieldLabel)
+	require.Equal(t, schema.TypeInt, schemaData[DiscoveryFieldWindowSize].Type)
+	require.True(t, schemaData[DiscoveryFieldWindowSize].Optional)
+	require.Equal(t, schema.TypeSet, schemaData[DiscoveryFieldIDs].Type)
+	require.True(t, schemaData[DiscoveryFieldIDs].Computed)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(ApplicationsFieldApplications)
+
+	applicationSchemaAssert := testutils.NewTerraformSchemaAssert(schemaData[ApplicationsFieldApplications].Elem.(*schema.Resource).Schema, t)
+	applicationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(ApplicationsFieldApplicationID)
+	applicationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(ApplicationsFieldApplicationLabel)
+	applicationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(ApplicationsFieldApplicationBoundaryScope)
+}
+
+func (r *dataSourceApplicationsUnitTest) shouldReturnAllApplicationsSortedByLabelWhenNoFilterIsProvided(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Application](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		applications := r.createApplications()
+		applicationsAPI := mocks.NewMockDiscoveryResource[*restapi.Application](ctrl)
+		applicationsAPI.EXPECT().GetAll(restapi.DiscoveryQuery{}).Times(1).Return(&applications, nil)
+		mockInstanaApi.EXPECT().Applications().Return(applicationsAPI).Times(1)
+
+		sut := NewApplicationsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+		require.Len(t, resourceData.Get(DiscoveryFieldIDs).(*schema.Set).List(), 3)
+		require.Equal(t, []interface{}{
+			map[string]interface{}{ApplicationsFieldApplicationID: "application-id-1", ApplicationsFieldApplicationLabel: "shop", ApplicationsFieldApplicationBoundaryScope: "INBOUND"},
+			map[string]interface{}{ApplicationsFieldApplicationID: "application-id-3", ApplicationsFieldApplicationLabel: "shop", ApplicationsFieldApplicationBoundaryScope: "DEFAULT"},
+			map[string]interface{}{ApplicationsFieldApplicationID: "application-id-2", ApplicationsFieldApplicationLabel: "shop-backend", ApplicationsFieldApplicationBoundaryScope: "ALL"},
+		}, resourceData.Get(ApplicationsFieldApplications))
+	})
+}
+
+func (r *dataSourceApplicationsUnitTest) shouldPassNameFilterAndWindowSizeToApiAndFilterApplicationsByLabel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Application](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		applications := r.createApplications()
+		applicationsAPI := mocks.NewMockDiscoveryResource[*restapi.Application](ctrl)
+		applicationsAPI.EXPECT().GetAll(restapi.DiscoveryQuery{NameFilter: "shop", WindowSize: 86400000}).Times(1).Return(&applications, nil)
+		mockInstanaApi.EXPECT().Applications().Return(applicationsAPI).Times(1)
+
+		sut := NewApplicationsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			DiscoveryFieldNameFilter: "shop",
+			DiscoveryFieldLabel:      "shop-backend",
+			DiscoveryFieldWindowSize: 86400000,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, []interface{}{"application-id-2"}, resourceData.Get(DiscoveryFieldIDs).(*schema.Set).List())
+		require.Len(t, resourceData.Get(ApplicationsFieldApplications), 1)
+	})
+}
+
+func (r *dataSourceApplicationsUnitTest) shouldFailToReadApplicationsWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Application](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		applicationsAPI := mocks.NewMockDiscoveryResource[*restapi.Application](ctrl)
+		applicationsAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().Applications().Return(applicationsAPI).Times(1)
+
+		sut := NewApplicationsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
+
+func (r *dataSourceApplicationsUnitTest) createApplications() []*restapi.Application {
+	return []*restapi.Application{
+		{ID: "application-id-2", Label: "shop-backend", BoundaryScope: "ALL"},
+		{ID: "application-id-3", Label: "shop", BoundaryScope: "DEFAULT"},
+		{ID: "application-id-1", Label: "shop", BoundaryScope: "INBOUND"},
+	}
+}
@@ -0,0 +1,142 @@
+package instana
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewCatalogMetricsDataSource creates a new DataSource for the metrics of a catalog
+func NewCatalogMetricsDataSource() DataSource {
+	return &catalogMetricsDataSource{}
+}
+
+const (
+	//CatalogMetricsFieldMetricIDs constant value for the computed schema field metric_ids
+	CatalogMetricsFieldMetricIDs = "metric_ids"
+	//CatalogMetricsFieldMetrics constant value for the computed schema field metrics
+	CatalogMetricsFieldMetrics = "metrics"
+	//CatalogMetricsFieldMetricID constant value for the computed schema field metrics.metric_id
+	CatalogMetricsFieldMetricID = "metric_id"
+	//CatalogMetricsFieldMetricLabel constant value for the computed schema field metrics.label
+	CatalogMetricsFieldMetricLabel = "label"
+	//CatalogMetricsFieldMetricDescription constant value for the computed schema field metrics.description
+	CatalogMetricsFieldMetricDescription = "description"
+	//CatalogMetricsFieldMetricFormatter constant value for the computed schema field metrics.formatter
+	CatalogMetricsFieldMetricFormatter = "formatter"
+	//CatalogMetricsFieldMetricAggregations constant value for the computed schema field metrics.aggregations
+	CatalogMetricsFieldMetricAggregations = "aggregations"
+	//CatalogMetricsFieldMetricDefaultAggregation constant value for the computed schema field metrics.default_aggregation
+	CatalogMetricsFieldMetricDefaultAggregation = "default_aggregation"
+	//DataSourceCatalogMetrics the name of the terraform-provider-instana data source for the metrics of a catalog
+	DataSourceCatalogMetrics = "instana_catalog_metrics"
+)
+
+type catalogMetricsDataSource struct{}
+
+// CreateResource creates the resource handle for the metrics of a catalog
+func (ds *catalogMetricsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			CatalogFieldCatalog: newCatalogSchema(),
+			CatalogMetricsFieldMetricIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of all metrics of the catalog",
+			},
+			CatalogMetricsFieldMetrics: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The metrics of the catalog",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						CatalogMetricsFieldMetricID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the metric as used in metric_name fields of alert rules",
+						},
+						CatalogMetricsFieldMetricLabel: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The label of the metric",
+						},
+						CatalogMetricsFieldMetricDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the metric",
+						},
+						CatalogMetricsFieldMetricFormatter: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The formatter of the values of the metric",
+						},
+						CatalogMetricsFieldMetricAggregations: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The aggregations supported by the metric",
+						},
+						CatalogMetricsFieldMetricDefaultAggregation: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The default aggregation of the metric, if any",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *catalogMetricsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	catalog := d.Get(CatalogFieldCatalog).(string)
+	data, err := getCatalogMetricsResource(instanaAPI, catalog).GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, catalog, *data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *catalogMetricsDataSource) updateState(d *schema.ResourceData, catalog string, data []*restapi.CatalogMetric) error {
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].MetricID < data[j].MetricID
+	})
+
+	metricIDs := make([]string, len(data))
+	metrics := make([]interface{}, len(data))
+	for i, metric := range data {
+		metricIDs[i] = metric.MetricID
+		defaultAggregation := ""
+		if metric.DefaultAggregation != nil {
+			defaultAggregation = *metric.DefaultAggregation
+		}
+		metrics[i] = map[string]interface{}{
+			CatalogMetricsFieldMetricID:                 metric.MetricID,
+			CatalogMetricsFieldMetricLabel:              metric.Label,
+			CatalogMetricsFieldMetricDescription:        metric.Description,
+			CatalogMetricsFieldMetricFormatter:          metric.Formatter,
+			CatalogMetricsFieldMetricAggregations:       metric.Aggregations,
+			CatalogMetricsFieldMetricDefaultAggregation: defaultAggregation,
+		}
+	}
+
+	d.SetId(catalog)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		CatalogMetricsFieldMetricIDs: metricIDs,
+		CatalogMetricsFieldMetrics:   metrics,
+	})
+}
@@ -0,0 +1,190 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCatalogMetricsDataSource(t *testing.T) {
+	unitTest := &dataSourceCatalogMetricsUnitTest{}
+	t.Run("integration test read of catalog metrics", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should read metrics of application catalog sorted by metric id", unitTest.shouldReadMetricsOfApplicationCatalogSortedByMetricID)
+	t.Run("should read metrics of website catalog", unitTest.createTestShouldReadMetricsOfCatalog(CatalogWebsite, func(api *mocks.MockInstanaAPI) *gomock.Call { return api.EXPECT().WebsiteCatalogMetrics() }))
+	t.Run("should read metrics of mobile app catalog", unitTest.createTestShouldReadMetricsOfCatalog(CatalogMobileApp, func(api *mocks.MockInstanaAPI) *gomock.Call { return api.EXPECT().MobileAppCatalogMetrics() }))
+	t.Run("should fail to read catalog metrics when api call fails", unitTest.shouldFailToReadCatalogMetricsWhenApiCallFails)
+}
+
+const dataSourceCatalogMetricsDefinitionPath = "data.instana_catalog_metrics.example"
+
+type dataSourceCatalogMetricsUnitTest struct{}
+
+func (r *dataSourceCatalogMetricsUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+[
+	{
+		"metricId" : "latency",
+		"label" : "Latency",
+		"formatter" : "MILLIS",
+		"description" : "Latency of calls",
+		"aggregations" : [ "MEAN", "P90" ],
+		"defaultAggregation" : "MEAN"
+	},
+	{
+		"metricId" : "calls",
+		"label" : "Call count",
+		"formatter" : "NUMBER",
+		"description" : "Number of received calls",
+		"aggregations" : [ "PER_SECOND", "SUM" ],
+		"defaultAggregation" : null
+	}
+]
+`
+	httpServer := createMockHttpServerForDataSource(restapi.ApplicationCatalogMetricsResourcePath, newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceCatalogMetricsDefinition := `
+data "instana_catalog_metrics" "example" {
+  catalog = "application"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceCatalogMetricsDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceCatalogMetricsDefinitionPath, "id", CatalogApplication),
+					resource.TestCheckResourceAttr(dataSourceCatalogMetricsDefinitionPath, fmt.Sprintf("%s.#", CatalogMetricsFieldMetricIDs), "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceCatalogMetricsDefinitionPath, fmt.Sprintf("%s.*", CatalogMetricsFieldMetricIDs), "calls"),
+					resource.TestCheckTypeSetElemAttr(dataSourceCatalogMetricsDefinitionPath, fmt.Sprintf("%s.*", CatalogMetricsFieldMetricIDs), "latency"),
+					resource.TestCheckResourceAttr(dataSourceCatalogMetricsDefinitionPath, fmt.Sprintf("%s.#", CatalogMetricsFieldMetrics), "2"),
+					resource.TestCheckResourceAttr(dataSourceCatalogMetricsDefinitionPath, fmt.Sprintf("%s.0.%s", CatalogMetricsFieldMetrics, CatalogMetricsFieldMetricID), "calls"),
+					resource.TestCheckResourceAttr(dataSourceCatalogMetricsDefinitionPath, fmt.Sprintf("%s.0.%s", CatalogMetricsFieldMetrics, CatalogMetricsFieldMetricDefaultAggregation), ""),
+					resource.TestCheckResourceAttr(dataSourceCatalogMetricsDefinitionPath, fmt.Sprintf("%s.0.%s.#", CatalogMetricsFieldMetrics, CatalogMetricsFieldMetricAggregations), "2"),
+					resource.TestCheckResourceAttr(dataSourceCatalogMetricsDefinitionPath, fmt.Sprintf("%s.1.%s", CatalogMetricsFieldMetrics, CatalogMetricsFieldMetricID), "latency"),
+					resource.TestCheckResourceAttr(dataSourceCatalogMetricsDefinitionPath, fmt.Sprintf("%s.1.%s", CatalogMetricsFieldMetrics, CatalogMetricsFieldMetricDefaultAggregation), "MEAN"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceCatalogMetricsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewCatalogMetricsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 3)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(CatalogFieldCatalog)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(CatalogMetricsFieldMetrics)
+	require.Equal(t, schema.TypeSet, schemaData[CatalogMetricsFieldMetricIDs].Type)
+	require.True(t, schemaData[CatalogMetricsFieldMetricIDs].Computed)
+
+	metricSchemaAssert := testutils.NewTerraformSchemaAssert(schemaData[CatalogMetricsFieldMetrics].Elem.(*schema.Resource).Schema, t)
+	metricSchemaAssert.AssertSchemaIsComputedAndOfTypeString(CatalogMetricsFieldMetricID)
+	metricSchemaAssert.AssertSchemaIsComputedAndOfTypeString(CatalogMetricsFieldMetricLabel)
+	metricSchemaAssert.AssertSchemaIsComputedAndOfTypeString(CatalogMetricsFieldMetricDescription)
+	metricSchemaAssert.AssertSchemaIsComputedAndOfTypeString(CatalogMetricsFieldMetricFormatter)
+	metricSchemaAssert.AssertSchemaIsComputedAndOfTypeListOfStrings(CatalogMetricsFieldMetricAggregations)
+	metricSchemaAssert.AssertSchemaIsComputedAndOfTypeString(CatalogMetricsFieldMetricDefaultAggregation)
+}
+
+func (r *dataSourceCatalogMetricsUnitTest) shouldReadMetricsOfApplicationCatalogSortedByMetricID(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.CatalogMetric](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		defaultAggregation := "MEAN"
+		metrics := []*restapi.CatalogMetric{
+			{MetricID: "latency", Label: "Latency", Description: "Latency of calls", Formatter: "MILLIS", Aggregations: []string{"MEAN", "P90"}, DefaultAggregation: &defaultAggregation},
+			{MetricID: "calls", Label: "Call count", Description: "Number of received calls", Formatter: "NUMBER", Aggregations: []string{"PER_SECOND", "SUM"}},
+		}
+		metricsAPI := mocks.NewMockReadOnlyRestResource[*restapi.CatalogMetric](ctrl)
+		metricsAPI.EXPECT().GetAll().Times(1).Return(&metrics, nil)
+		mockInstanaApi.EXPECT().ApplicationCatalogMetrics().Return(metricsAPI).Times(1)
+
+		sut := NewCatalogMetricsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			CatalogFieldCatalog: CatalogApplication,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, CatalogApplication, resourceData.Id())
+		require.ElementsMatch(t, []interface{}{"latency", "calls"}, resourceData.Get(CatalogMetricsFieldMetricIDs).(*schema.Set).List())
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				CatalogMetricsFieldMetricID:                 "calls",
+				CatalogMetricsFieldMetricLabel:              "Call count",
+				CatalogMetricsFieldMetricDescription:        "Number of received calls",
+				CatalogMetricsFieldMetricFormatter:          "NUMBER",
+				CatalogMetricsFieldMetricAggregations:       []interface{}{"PER_SECOND", "SUM"},
+				CatalogMetricsFieldMetricDefaultAggregation: "",
+			},
+			map[string]interface{}{
+				CatalogMetricsFieldMetricID:                 "latency",
+				CatalogMetricsFieldMetricLabel:              "Latency",
+				CatalogMetricsFieldMetricDescription:        "Latency of calls",
+				CatalogMetricsFieldMetricFormatter:          "MILLIS",
+				CatalogMetricsFieldMetricAggregations:       []interface{}{"MEAN", "P90"},
+				CatalogMetricsFieldMetricDefaultAggregation: "MEAN",
+			},
+		}, resourceData.Get(CatalogMetricsFieldMetrics))
+	})
+}
+
+func (r *dataSourceCatalogMetricsUnitTest) createTestShouldReadMetricsOfCatalog(catalog string, expectCatalog func(api *mocks.MockInstanaAPI) *gomock.Call) func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.CatalogMetric](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+			metrics := []*restapi.CatalogMetric{{MetricID: "pageLoads", Formatter: "NUMBER", Aggregations: []string{"SUM"}}}
+			metricsAPI := mocks.NewMockReadOnlyRestResource[*restapi.CatalogMetric](ctrl)
+			metricsAPI.EXPECT().GetAll().Times(1).Return(&metrics, nil)
+			expectCatalog(mockInstanaApi).Return(metricsAPI).Times(1)
+
+			sut := NewCatalogMetricsDataSource().CreateResource()
+			resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+				CatalogFieldCatalog: catalog,
+			})
+
+			diag := sut.ReadContext(nil, resourceData, meta)
+
+			require.Nil(t, diag)
+			require.Equal(t, catalog, resourceData.Id())
+			require.Equal(t, []interface{}{"pageLoads"}, resourceData.Get(CatalogMetricsFieldMetricIDs).(*schema.Set).List())
+		})
+	}
+}
+
+func (r *dataSourceCatalogMetricsUnitTest) shouldFailToReadCatalogMetricsWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.CatalogMetric](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		metricsAPI := mocks.NewMockReadOnlyRestResource[*restapi.CatalogMetric](ctrl)
+		metricsAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().ApplicationCatalogMetrics().Return(metricsAPI).Times(1)
+
+		sut := NewCatalogMetricsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			CatalogFieldCatalog: CatalogApplication,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
@@ -0,0 +1,137 @@
+package instana
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewCatalogTagsDataSource creates a new DataSource for the tags of a catalog
+func NewCatalogTagsDataSource() DataSource {
+	return &catalogTagsDataSource{}
+}
+
+const (
+	//CatalogTagsFieldNames constant value for the computed schema field names
+	CatalogTagsFieldNames = "names"
+	//CatalogTagsFieldTags constant value for the computed schema field tags
+	CatalogTagsFieldTags = "tags"
+	//CatalogTagsFieldTagName constant value for the computed schema field tags.name
+	CatalogTagsFieldTagName = "name"
+	//CatalogTagsFieldTagLabel constant value for the computed schema field tags.label
+	CatalogTagsFieldTagLabel = "label"
+	//CatalogTagsFieldTagDescription constant value for the computed schema field tags.description
+	CatalogTagsFieldTagDescription = "description"
+	//CatalogTagsFieldTagType constant value for the computed schema field tags.type
+	CatalogTagsFieldTagType = "type"
+	//CatalogTagsFieldTagCanApplyToSource constant value for the computed schema field tags.can_apply_to_source
+	CatalogTagsFieldTagCanApplyToSource = "can_apply_to_source"
+	//CatalogTagsFieldTagCanApplyToDestination constant value for the computed schema field tags.can_apply_to_destination
+	CatalogTagsFieldTagCanApplyToDestination = "can_apply_to_destination"
+	//DataSourceCatalogTags the name of the terraform-provider-instana data source for the tags of a catalog
+	DataSourceCatalogTags = "instana_catalog_tags"
+)
+
+type catalogTagsDataSource struct{}
+
+// CreateResource creates the resource handle for the tags of a catalog
+func (ds *catalogTagsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			CatalogFieldCatalog: newCatalogSchema(),
+			CatalogTagsFieldNames: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of all tags of the catalog",
+			},
+			CatalogTagsFieldTags: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The tags of the catalog",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						CatalogTagsFieldTagName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the tag as used in tag filter expressions",
+						},
+						CatalogTagsFieldTagLabel: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The label of the tag",
+						},
+						CatalogTagsFieldTagDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the tag",
+						},
+						CatalogTagsFieldTagType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the values of the tag",
+						},
+						CatalogTagsFieldTagCanApplyToSource: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates if the tag can be applied to the source of a call",
+						},
+						CatalogTagsFieldTagCanApplyToDestination: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates if the tag can be applied to the destination of a call",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *catalogTagsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	catalog := d.Get(CatalogFieldCatalog).(string)
+	data, err := getCatalogTagsResource(instanaAPI, catalog).GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, catalog, *data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *catalogTagsDataSource) updateState(d *schema.ResourceData, catalog string, data []*restapi.CatalogTag) error {
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Name < data[j].Name
+	})
+
+	names := make([]string, len(data))
+	tags := make([]interface{}, len(data))
+	for i, tag := range data {
+		names[i] = tag.Name
+		tags[i] = map[string]interface{}{
+			CatalogTagsFieldTagName:                  tag.Name,
+			CatalogTagsFieldTagLabel:                 tag.Label,
+			CatalogTagsFieldTagDescription:           tag.Description,
+			CatalogTagsFieldTagType:                  tag.Type,
+			CatalogTagsFieldTagCanApplyToSource:      tag.CanApplyToSource,
+			CatalogTagsFieldTagCanApplyToDestination: tag.CanApplyToDestination,
+		}
+	}
+
+	d.SetId(catalog)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		CatalogTagsFieldNames: names,
+		CatalogTagsFieldTags:  tags,
+	})
+}
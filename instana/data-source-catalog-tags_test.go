@@ -0,0 +1,190 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCatalogTagsDataSource(t *testing.T) {
+	unitTest := &dataSourceCatalogTagsUnitTest{}
+	t.Run("integration test read of catalog tags", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should read tags of application catalog sorted by name", unitTest.shouldReadTagsOfApplicationCatalogSortedByName)
+	t.Run("should read tags of website catalog", unitTest.createTestShouldReadTagsOfCatalog(CatalogWebsite, func(api *mocks.MockInstanaAPI) *gomock.Call { return api.EXPECT().WebsiteCatalogTags() }))
+	t.Run("should read tags of mobile app catalog", unitTest.createTestShouldReadTagsOfCatalog(CatalogMobileApp, func(api *mocks.MockInstanaAPI) *gomock.Call { return api.EXPECT().MobileAppCatalogTags() }))
+	t.Run("should fail to read catalog tags when api call fails", unitTest.shouldFailToReadCatalogTagsWhenApiCallFails)
+}
+
+const dataSourceCatalogTagsDefinitionPath = "data.instana_catalog_tags.example"
+
+type dataSourceCatalogTagsUnitTest struct{}
+
+func (r *dataSourceCatalogTagsUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+[
+	{
+		"name" : "service.name",
+		"label" : "Service Name",
+		"description" : "The name of the service",
+		"type" : "STRING",
+		"canApplyToSource" : true,
+		"canApplyToDestination" : true,
+		"idTag" : false
+	},
+	{
+		"name" : "call.http.status",
+		"label" : "HTTP Status",
+		"type" : "NUMBER",
+		"canApplyToSource" : false,
+		"canApplyToDestination" : true,
+		"idTag" : false
+	}
+]
+`
+	httpServer := createMockHttpServerForDataSource(restapi.ApplicationCatalogTagsResourcePath, newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceCatalogTagsDefinition := `
+data "instana_catalog_tags" "example" {
+  catalog = "application"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceCatalogTagsDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceCatalogTagsDefinitionPath, "id", CatalogApplication),
+					resource.TestCheckResourceAttr(dataSourceCatalogTagsDefinitionPath, fmt.Sprintf("%s.#", CatalogTagsFieldNames), "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceCatalogTagsDefinitionPath, fmt.Sprintf("%s.*", CatalogTagsFieldNames), "service.name"),
+					resource.TestCheckTypeSetElemAttr(dataSourceCatalogTagsDefinitionPath, fmt.Sprintf("%s.*", CatalogTagsFieldNames), "call.http.status"),
+					resource.TestCheckResourceAttr(dataSourceCatalogTagsDefinitionPath, fmt.Sprintf("%s.#", CatalogTagsFieldTags), "2"),
+					resource.TestCheckResourceAttr(dataSourceCatalogTagsDefinitionPath, fmt.Sprintf("%s.0.%s", CatalogTagsFieldTags, CatalogTagsFieldTagName), "call.http.status"),
+					resource.TestCheckResourceAttr(dataSourceCatalogTagsDefinitionPath, fmt.Sprintf("%s.0.%s", CatalogTagsFieldTags, CatalogTagsFieldTagType), "NUMBER"),
+					resource.TestCheckResourceAttr(dataSourceCatalogTagsDefinitionPath, fmt.Sprintf("%s.0.%s", CatalogTagsFieldTags, CatalogTagsFieldTagCanApplyToSource), "false"),
+					resource.TestCheckResourceAttr(dataSourceCatalogTagsDefinitionPath, fmt.Sprintf("%s.1.%s", CatalogTagsFieldTags, CatalogTagsFieldTagName), "service.name"),
+					resource.TestCheckResourceAttr(dataSourceCatalogTagsDefinitionPath, fmt.Sprintf("%s.1.%s", CatalogTagsFieldTags, CatalogTagsFieldTagLabel), "Service Name"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceCatalogTagsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewCatalogTagsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 3)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(CatalogFieldCatalog)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(CatalogTagsFieldTags)
+	require.Equal(t, schema.TypeSet, schemaData[CatalogTagsFieldNames].Type)
+	require.True(t, schemaData[CatalogTagsFieldNames].Computed)
+
+	tagSchemaAssert := testutils.NewTerraformSchemaAssert(schemaData[CatalogTagsFieldTags].Elem.(*schema.Resource).Schema, t)
+	tagSchemaAssert.AssertSchemaIsComputedAndOfTypeString(CatalogTagsFieldTagName)
+	tagSchemaAssert.AssertSchemaIsComputedAndOfTypeString(CatalogTagsFieldTagLabel)
+	tagSchemaAssert.AssertSchemaIsComputedAndOfTypeString(CatalogTagsFieldTagDescription)
+	tagSchemaAssert.AssertSchemaIsComputedAndOfTypeString(CatalogTagsFieldTagType)
+	tagSchemaAssert.AssertSchemaIsComputedAndOfTypeBool(CatalogTagsFieldTagCanApplyToSource)
+	tagSchemaAssert.AssertSchemaIsComputedAndOfTypeBool(CatalogTagsFieldTagCanApplyToDestination)
+}
+
+func (r *dataSourceCatalogTagsUnitTest) shouldReadTagsOfApplicationCatalogSortedByName(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.CatalogTag](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		tags := []*restapi.CatalogTag{
+			{Name: "service.name", Label: "Service Name", Description: "The name of the service", Type: "STRING", CanApplyToSource: true, CanApplyToDestination: true},
+			{Name: "call.http.status", Label: "HTTP Status", Type: "NUMBER", CanApplyToDestination: true},
+		}
+		tagsAPI := mocks.NewMockReadOnlyRestResource[*restapi.CatalogTag](ctrl)
+		tagsAPI.EXPECT().GetAll().Times(1).Return(&tags, nil)
+		mockInstanaApi.EXPECT().ApplicationCatalogTags().Return(tagsAPI).Times(1)
+
+		sut := NewCatalogTagsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			CatalogFieldCatalog: CatalogApplication,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, CatalogApplication, resourceData.Id())
+		require.ElementsMatch(t, []interface{}{"service.name", "call.http.status"}, resourceData.Get(CatalogTagsFieldNames).(*schema.Set).List())
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				CatalogTagsFieldTagName:                  "call.http.status",
+				CatalogTagsFieldTagLabel:                 "HTTP Status",
+				CatalogTagsFieldTagDescription:           "",
+				CatalogTagsFieldTagType:                  "NUMBER",
+				CatalogTagsFieldTagCanApplyToSource:      false,
+				CatalogTagsFieldTagCanApplyToDestination: true,
+			},
+			map[string]interface{}{
+				CatalogTagsFieldTagName:                  "service.name",
+				CatalogTagsFieldTagLabel:                 "Service Name",
+				CatalogTagsFieldTagDescription:           "The name of the service",
+				CatalogTagsFieldTagType:                  "STRING",
+				CatalogTagsFieldTagCanApplyToSource:      true,
+				CatalogTagsFieldTagCanApplyToDestination: true,
+			},
+		}, resourceData.Get(CatalogTagsFieldTags))
+	})
+}
+
+func (r *dataSourceCatalogTagsUnitTest) createTestShouldReadTagsOfCatalog(catalog string, expectCatalog func(api *mocks.MockInstanaAPI) *gomock.Call) func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.CatalogTag](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+			tags := []*restapi.CatalogTag{{Name: "beacon.page.name", Type: "STRING"}}
+			tagsAPI := mocks.NewMockReadOnlyRestResource[*restapi.CatalogTag](ctrl)
+			tagsAPI.EXPECT().GetAll().Times(1).Return(&tags, nil)
+			expectCatalog(mockInstanaApi).Return(tagsAPI).Times(1)
+
+			sut := NewCatalogTagsDataSource().CreateResource()
+			resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+				CatalogFieldCatalog: catalog,
+			})
+
+			diag := sut.ReadContext(nil, resourceData, meta)
+
+			require.Nil(t, diag)
+			require.Equal(t, catalog, resourceData.Id())
+			require.Equal(t, []interface{}{"beacon.page.name"}, resourceData.Get(CatalogTagsFieldNames).(*schema.Set).List())
+		})
+	}
+}
+
+func (r *dataSourceCatalogTagsUnitTest) shouldFailToReadCatalogTagsWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.CatalogTag](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		tagsAPI := mocks.NewMockReadOnlyRestResource[*restapi.CatalogTag](ctrl)
+		tagsAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().ApplicationCatalogTags().Return(tagsAPI).Times(1)
+
+		sut := NewCatalogTagsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			CatalogFieldCatalog: CatalogApplication,
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
@@ -0,0 +1,53 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	//CatalogFieldCatalog constant value for the schema field catalog of the catalog data sources
+	CatalogFieldCatalog = "catalog"
+
+	//CatalogApplication constant value for the catalog of application monitoring
+	CatalogApplication = "application"
+	//CatalogWebsite constant value for the catalog of website monitoring
+	CatalogWebsite = "website"
+	//CatalogMobileApp constant value for the catalog of mobile app monitoring
+	CatalogMobileApp = "mobile_app"
+)
+
+// SupportedCatalogs list of all catalogs supported by the catalog data sources
+var SupportedCatalogs = []string{CatalogApplication, CatalogWebsite, CatalogMobileApp}
+
+func newCatalogSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The catalog which should be read. Supported values are application, website and mobile_app",
+		ValidateFunc: validation.StringInSlice(SupportedCatalogs, false),
+	}
+}
+
+func getCatalogTagsResource(api restapi.InstanaAPI, catalog string) restapi.ReadOnlyRestResource[*restapi.CatalogTag] {
+	switch catalog {
+	case CatalogWebsite:
+		return api.WebsiteCatalogTags()
+	case CatalogMobileApp:
+		return api.MobileAppCatalogTags()
+	default:
+		return api.ApplicationCatalogTags()
+	}
+}
+
+func getCatalogMetricsResource(api restapi.InstanaAPI, catalog string) restapi.ReadOnlyRestResource[*restapi.CatalogMetric] {
+	switch catalog {
+	case CatalogWebsite:
+		return api.WebsiteCatalogMetrics()
+	case CatalogMobileApp:
+		return api.MobileAppCatalogMetrics()
+	default:
+		return api.ApplicationCatalogMetrics()
+	}
+}
@@ -0,0 +1,13 @@
+package instana
+
+import "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+
+// DataSourceCustomDashboard the name of the terraform-provider-instana data source to read custom dashboards by title
+const DataSourceCustomDashboard = "instana_custom_dashboard"
+
+// NewCustomDashboardDataSource creates a new DataSource for custom dashboards
+func NewCustomDashboardDataSource() DataSource {
+	return newResourceLookupDataSource[*restapi.CustomDashboard](NewCustomDashboardResourceHandle(), CustomDashboardFieldTitle, "custom dashboard", func(obj *restapi.CustomDashboard) string {
+		return obj.Title
+	}, true)
+}
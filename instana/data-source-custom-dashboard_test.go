@@ -0,0 +1,122 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCustomDashboardDataSource(t *testing.T) {
+	unitTest := &dataSourceCustomDashboardUnitTest{}
+	t.Run("integration test read of custom dashboard", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read custom dashboard by title", unitTest.shouldSuccessfullyReadCustomDashboardByTitle)
+}
+
+const dataSourceCustomDashboardDefinitionPath = "data.instana_custom_dashboard.example"
+
+const dataSourceCustomDashboardPreviewServerResponse = `
+[
+	{ "id" : "dashboard-id-1", "title" : "dashboard-1", "annotations" : [] },
+	{ "id" : "dashboard-id-2", "title" : "dashboard-2", "annotations" : [ "SHARED" ] }
+]
+`
+
+const dataSourceCustomDashboardServerResponse = `
+{
+	"id" : "dashboard-id-2",
+	"title" : "dashboard-2",
+	"accessRules" : [ { "accessType" : "READ", "relationType" : "GLOBAL", "relatedId" : "" } ],
+	"widgets" : [ { "id" : "widget-id", "title" : "Latency", "type" : "chart" } ],
+	"writable" : true
+}
+`
+
+type dataSourceCustomDashboardUnitTest struct{}
+
+func (r *dataSourceCustomDashboardUnitTest) integrationTestRead(t *testing.T) {
+	httpServer := createMockHttpServerForDataSource(restapi.CustomDashboardsResourcePath, newStringContentResponseProvider(dataSourceCustomDashboardPreviewServerResponse))
+	httpServer.AddRoute(http.MethodGet, restapi.CustomDashboardsResourcePath+"/dashboard-id-2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		httpServer.WriteJSONResponse(w, []byte(dataSourceCustomDashboardServerResponse))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceCustomDashboardDefinition := `
+data "instana_custom_dashboard" "example" {
+  title = "dashboard-2"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceCustomDashboardDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceCustomDashboardDefinitionPath, "id", "dashboard-id-2"),
+					resource.TestCheckResourceAttr(dataSourceCustomDashboardDefinitionPath, CustomDashboardFieldTitle, "dashboard-2"),
+					resource.TestCheckResourceAttr(dataSourceCustomDashboardDefinitionPath, fmt.Sprintf("%s.#", CustomDashboardFieldAccessRule), "1"),
+					resource.TestCheckResourceAttr(dataSourceCustomDashboardDefinitionPath, fmt.Sprintf("%s.0.%s", CustomDashboardFieldAccessRule, CustomDashboardFieldAccessRuleAccessType), string(restapi.AccessTypeRead)),
+					resource.TestCheckResourceAttr(dataSourceCustomDashboardDefinitionPath, fmt.Sprintf("%s.0.%s", CustomDashboardFieldAccessRule, CustomDashboardFieldAccessRuleRelationType), string(restapi.RelationTypeGlobal)),
+					resource.TestCheckResourceAttr(dataSourceCustomDashboardDefinitionPath, CustomDashboardFieldWidgets, NormalizeJSONString(`[{"id":"widget-id","title":"Latency","type":"chart"}]`)),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceCustomDashboardUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewCustomDashboardDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 3)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(CustomDashboardFieldTitle)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(CustomDashboardFieldWidgets)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(CustomDashboardFieldAccessRule)
+}
+
+func (r *dataSourceCustomDashboardUnitTest) shouldSuccessfullyReadCustomDashboardByTitle(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.CustomDashboard](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		previews := []*restapi.CustomDashboard{
+			{ID: "dashboard-id-1", Title: "dashboard-1"},
+			{ID: "dashboard-id-2", Title: "dashboard-2"},
+		}
+		dashboard := &restapi.CustomDashboard{
+			ID:          "dashboard-id-2",
+			Title:       "dashboard-2",
+			AccessRules: []restapi.AccessRule{{AccessType: restapi.AccessTypeRead, RelationType: restapi.RelationTypeGlobal}},
+			Widgets:     json.RawMessage(`[{"id":"widget-id"}]`),
+		}
+
+		dashboardsAPI := mocks.NewMockRestResource[*restapi.CustomDashboard](ctrl)
+		dashboardsAPI.EXPECT().GetAll().Times(1).Return(&previews, nil)
+		dashboardsAPI.EXPECT().GetOne("dashboard-id-2").Times(1).Return(dashboard, nil)
+		mockInstanaApi.EXPECT().CustomDashboards().Return(dashboardsAPI).Times(1)
+
+		sut := NewCustomDashboardDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			CustomDashboardFieldTitle: "dashboard-2",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "dashboard-id-2", resourceData.Id())
+		require.Equal(t, "dashboard-2", resourceData.Get(CustomDashboardFieldTitle))
+		require.Equal(t, `[{"id":"widget-id"}]`, resourceData.Get(CustomDashboardFieldWidgets))
+		require.Equal(t, string(restapi.AccessTypeRead), resourceData.Get(fmt.Sprintf("%s.0.%s", CustomDashboardFieldAccessRule, CustomDashboardFieldAccessRuleAccessType)))
+	})
+}
@@ -0,0 +1,13 @@
+package instana
+
+import "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+
+// DataSourceCustomEventSpecification the name of the terraform-provider-instana data source to read custom event specifications by name
+const DataSourceCustomEventSpecification = "instana_custom_event_specification"
+
+// NewCustomEventSpecificationDataSource creates a new DataSource for custom event specifications
+func NewCustomEventSpecificationDataSource() DataSource {
+	return newResourceLookupDataSource[*restapi.CustomEventSpecification](NewCustomEventSpecificationResourceHandle(), CustomEventSpecificationFieldName, "custom event specification", func(obj *restapi.CustomEventSpecification) string {
+		return obj.Name
+	}, false)
+}
@@ -0,0 +1,137 @@
+package instana_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCustomEventSpecificationDataSource(t *testing.T) {
+	unitTest := &dataSourceCustomEventSpecificationUnitTest{}
+	t.Run("integration test read of custom event specification", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read custom event specification by name", unitTest.shouldSuccessfullyReadCustomEventSpecificationByName)
+}
+
+const dataSourceCustomEventSpecificationDefinitionPath = "data.instana_custom_event_specification.example"
+
+const dataSourceCustomEventSpecificationServerResponse = `
+[
+	{
+		"id" : "event-id-1",
+		"name" : "event-1",
+		"entityType" : "host",
+		"enabled" : true,
+		"triggering" : false,
+		"ruleLogicalOperator": "AND",
+		"rules" : [{ "ruleType" : "system", "severity" : 5, "systemRuleId" : "system-rule-id-1" }]
+	},
+	{
+		"id" : "event-id-2",
+		"name" : "event-2",
+		"entityType" : "any",
+		"query" : "query",
+		"enabled" : false,
+		"triggering" : true,
+		"description" : "description",
+		"expirationTime" : 60000,
+		"ruleLogicalOperator": "AND",
+		"rules" : [{ "ruleType" : "system", "severity" : 10, "systemRuleId" : "system-rule-id-2" }]
+	}
+]
+`
+
+type dataSourceCustomEventSpecificationUnitTest struct{}
+
+func (r *dataSourceCustomEventSpecificationUnitTest) integrationTestRead(t *testing.T) {
+	httpServer := createMockHttpServerForDataSource(restapi.CustomEventSpecificationResourcePath, newStringContentResponseProvider(dataSourceCustomEventSpecificationServerResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceCustomEventSpecificationDefinition := `
+data "instana_custom_event_specification" "example" {
+  name = "event-2"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceCustomEventSpecificationDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, "id", "event-id-2"),
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, CustomEventSpecificationFieldName, "event-2"),
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, CustomEventSpecificationFieldEntityType, "any"),
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, CustomEventSpecificationFieldQuery, "query"),
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, CustomEventSpecificationFieldEnabled, "false"),
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, CustomEventSpecificationFieldTriggering, "true"),
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, CustomEventSpecificationFieldDescription, "description"),
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, CustomEventSpecificationFieldExpirationTime, "60000"),
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, fmt.Sprintf("%s.0.%s.0.%s", CustomEventSpecificationFieldRules, CustomEventSpecificationFieldSystemRule, CustomEventSpecificationRuleFieldSeverity), restapi.SeverityCritical.GetTerraformRepresentation()),
+					resource.TestCheckResourceAttr(dataSourceCustomEventSpecificationDefinitionPath, fmt.Sprintf("%s.0.%s.0.%s", CustomEventSpecificationFieldRules, CustomEventSpecificationFieldSystemRule, CustomEventSpecificationSystemRuleFieldSystemRuleId), "system-rule-id-2"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceCustomEventSpecificationUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewCustomEventSpecificationDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 9)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(CustomEventSpecificationFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(CustomEventSpecificationFieldEntityType)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(CustomEventSpecificationFieldQuery)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeBool(CustomEventSpecificationFieldTriggering)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(CustomEventSpecificationFieldDescription)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(CustomEventSpecificationFieldExpirationTime)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeBool(CustomEventSpecificationFieldEnabled)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(CustomEventSpecificationFieldRuleLogicalOperator)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(CustomEventSpecificationFieldRules)
+}
+
+func (r *dataSourceCustomEventSpecificationUnitTest) shouldSuccessfullyReadCustomEventSpecificationByName(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.CustomEventSpecification](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		systemRuleID := "system-rule-id"
+		customEventSpecifications := []*restapi.CustomEventSpecification{
+			{ID: "event-id-1", Name: "event-1", EntityType: "host", RuleLogicalOperator: "AND"},
+			{
+				ID:                  "event-id-2",
+				Name:                "event-2",
+				EntityType:          "any",
+				Enabled:             true,
+				RuleLogicalOperator: "AND",
+				Rules:               []restapi.RuleSpecification{{DType: restapi.SystemRuleType, Severity: restapi.SeverityWarning.GetAPIRepresentation(), SystemRuleID: &systemRuleID}},
+			},
+		}
+
+		customEventSpecificationsAPI := mocks.NewMockRestResource[*restapi.CustomEventSpecification](ctrl)
+		customEventSpecificationsAPI.EXPECT().GetAll().Times(1).Return(&customEventSpecifications, nil)
+		mockInstanaApi.EXPECT().CustomEventSpecifications().Return(customEventSpecificationsAPI).Times(1)
+
+		sut := NewCustomEventSpecificationDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			CustomEventSpecificationFieldName: "event-2",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "event-id-2", resourceData.Id())
+		require.Equal(t, "event-2", resourceData.Get(CustomEventSpecificationFieldName))
+		require.Equal(t, "any", resourceData.Get(CustomEventSpecificationFieldEntityType))
+		require.True(t, resourceData.Get(CustomEventSpecificationFieldEnabled).(bool))
+		require.Equal(t, systemRuleID, resourceData.Get(fmt.Sprintf("%s.0.%s.0.%s", CustomEventSpecificationFieldRules, CustomEventSpecificationFieldSystemRule, CustomEventSpecificationSystemRuleFieldSystemRuleId)))
+	})
+}
@@ -0,0 +1,89 @@
+package instana
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	//DiscoveryFieldNameFilter constant value for the schema field name_filter of the discovery data sources
+	DiscoveryFieldNameFilter = "name_filter"
+	//DiscoveryFieldLabel constant value for the schema field label of the discovery data sources
+	DiscoveryFieldLabel = "label"
+	//DiscoveryFieldWindowSize constant value for the schema field window_size of the discovery data sources
+	DiscoveryFieldWindowSize = "window_size"
+	//DiscoveryFieldIDs constant value for the computed schema field ids of the discovery data sources
+	DiscoveryFieldIDs = "ids"
+)
+
+// createDiscoverySchema creates the schema of the filters and the ids which are shared by all discovery data sources
+// and adds the object type specific fields
+func createDiscoverySchema(objectType string, fields map[string]*schema.Schema) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{
+		DiscoveryFieldNameFilter: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Restricts the " + objectType + "s to those whose name contains the given value. The filter is applied by the Instana API",
+		},
+		DiscoveryFieldLabel: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Restricts the " + objectType + "s to those whose name is exactly the given value",
+		},
+		DiscoveryFieldWindowSize: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "The size of the time window in milliseconds in which the " + objectType + "s must have been seen. The default of the Instana API is used when not set",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		DiscoveryFieldIDs: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The IDs of the matching " + objectType + "s",
+		},
+	}
+	for k, v := range fields {
+		result[k] = v
+	}
+	return result
+}
+
+func readDiscoveryQuery(d *schema.ResourceData) restapi.DiscoveryQuery {
+	return restapi.DiscoveryQuery{
+		NameFilter: d.Get(DiscoveryFieldNameFilter).(string),
+		WindowSize: int64(d.Get(DiscoveryFieldWindowSize).(int)),
+	}
+}
+
+// filterDiscoveredObjects filters the discovered objects by the optional label and the given additional filter and
+// sorts the result by label and ID
+func filterDiscoveredObjects[T restapi.InstanaDataObject](d *schema.ResourceData, data *[]T, labelOf func(T) string, filter func(T) bool) []T {
+	label := d.Get(DiscoveryFieldLabel).(string)
+
+	result := make([]T, 0)
+	for _, obj := range *data {
+		if len(label) > 0 && labelOf(obj) != label {
+			continue
+		}
+		if filter(obj) {
+			result = append(result, obj)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if labelOf(result[i]) == labelOf(result[j]) {
+			return result[i].GetIDForResourcePath() < result[j].GetIDForResourcePath()
+		}
+		return labelOf(result[i]) < labelOf(result[j])
+	})
+	return result
+}
+
+func createDiscoveryID(ids []string) string {
+	return strconv.Itoa(schema.HashString(strings.Join(ids, ",")))
+}
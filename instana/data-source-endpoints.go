@@ -0,0 +1,127 @@
+package instana
+
+import (
+	"context"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewEndpointsDataSource creates a new DataSource for the endpoints discovered by application monitoring
+func NewEndpointsDataSource() DataSource {
+	return &endpointsDataSource{}
+}
+
+const (
+	//EndpointsFieldServiceID constant value for the schema field service_id
+	EndpointsFieldServiceID = "service_id"
+	//EndpointsFieldEndpoints constant value for the computed schema field endpoints
+	EndpointsFieldEndpoints = "endpoints"
+	//EndpointsFieldEndpointID constant value for the computed schema field endpoints.id
+	EndpointsFieldEndpointID = "id"
+	//EndpointsFieldEndpointLabel constant value for the computed schema field endpoints.label
+	EndpointsFieldEndpointLabel = "label"
+	//EndpointsFieldEndpointServiceID constant value for the computed schema field endpoints.service_id
+	EndpointsFieldEndpointServiceID = "service_id"
+	//EndpointsFieldEndpointType constant value for the computed schema field endpoints.type
+	EndpointsFieldEndpointType = "type"
+	//EndpointsFieldEndpointTechnologies constant value for the computed schema field endpoints.technologies
+	EndpointsFieldEndpointTechnologies = "technologies"
+	//DataSourceEndpoints the name of the terraform-provider-instana data source for endpoints
+	DataSourceEndpoints = "instana_endpoints"
+)
+
+type endpointsDataSource struct{}
+
+// CreateResource creates the resource handle for endpoints
+func (ds *endpointsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: createDiscoverySchema("endpoint", map[string]*schema.Schema{
+			EndpointsFieldServiceID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Restricts the endpoints to those of the service with the given ID",
+			},
+			EndpointsFieldEndpoints: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching endpoints",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						EndpointsFieldEndpointID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the endpoint",
+						},
+						EndpointsFieldEndpointLabel: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the endpoint",
+						},
+						EndpointsFieldEndpointServiceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the service of the endpoint",
+						},
+						EndpointsFieldEndpointType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the endpoint",
+						},
+						EndpointsFieldEndpointTechnologies: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The technologies of the endpoint",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (ds *endpointsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	data, err := instanaAPI.Endpoints().GetAll(readDiscoveryQuery(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceID := d.Get(EndpointsFieldServiceID).(string)
+	endpoints := filterDiscoveredObjects(d, data, func(e *restapi.Endpoint) string { return e.Label }, func(e *restapi.Endpoint) bool {
+		return len(serviceID) == 0 || e.ServiceID == serviceID
+	})
+
+	err = ds.updateState(d, endpoints)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *endpointsDataSource) updateState(d *schema.ResourceData, data []*restapi.Endpoint) error {
+	ids := make([]string, len(data))
+	endpoints := make([]interface{}, len(data))
+	for i, endpoint := range data {
+		ids[i] = endpoint.ID
+		endpoints[i] = map[string]interface{}{
+			EndpointsFieldEndpointID:           endpoint.ID,
+			EndpointsFieldEndpointLabel:        endpoint.Label,
+			EndpointsFieldEndpointServiceID:    endpoint.ServiceID,
+			EndpointsFieldEndpointType:         endpoint.Type,
+			EndpointsFieldEndpointTechnologies: endpoint.Technologies,
+		}
+	}
+
+	d.SetId(createDiscoveryID(ids))
+	return tfutils.UpdateState(d, map[string]interface{}{
+		DiscoveryFieldIDs:       ids,
+		EndpointsFieldEndpoints: endpoints,
+	})
+}
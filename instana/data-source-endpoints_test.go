@@ -0,0 +1,142 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEndpointsDataSource(t *testing.T) {
+	unitTest := &dataSourceEndpointsUnitTest{}
+	t.Run("integration test read of endpoints", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read endpoints by service id and label", unitTest.shouldSuccessfullyReadEndpointsByServiceIDAndLabel)
+	t.Run("should fail to read endpoints when api call fails", unitTest.shouldFailToReadEndpointsWhenApiCallFails)
+}
+
+const dataSourceEndpointsDefinitionPath = "data.instana_endpoints.example"
+
+type dataSourceEndpointsUnitTest struct{}
+
+func (r *dataSourceEndpointsUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+{
+	"items" : [
+		{ "id" : "endpoint-id-1", "label" : "GET /health", "serviceId" : "service-id-1", "type" : "HTTP", "technologies" : [ "java" ], "entityType" : "ENDPOINT", "synthetic" : false },
+		{ "id" : "endpoint-id-2", "label" : "GET /health", "serviceId" : "service-id-2", "type" : "HTTP", "technologies" : [ "nodeJsRuntimePlatform" ], "entityType" : "ENDPOINT", "synthetic" : false }
+	],
+	"page" : 1,
+	"pageSize" : 200,
+	"totalHits" : 2
+}
+`
+	httpServer := createMockHttpServerForDataSource(restapi.EndpointsResourcePath, newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceEndpointsDefinition := `
+data "instana_endpoints" "example" {
+  service_id = "service-id-2"
+  label      = "GET /health"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceEndpointsDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceEndpointsDefinitionPath, fmt.Sprintf("%s.#", DiscoveryFieldIDs), "1"),
+					resource.TestCheckResourceAttr(dataSourceEndpointsDefinitionPath, fmt.Sprintf("%s.0", DiscoveryFieldIDs), "endpoint-id-2"),
+					resource.TestCheckResourceAttr(dataSourceEndpointsDefinitionPath, fmt.Sprintf("%s.0.%s", EndpointsFieldEndpoints, EndpointsFieldEndpointServiceID), "service-id-2"),
+					resource.TestCheckResourceAttr(dataSourceEndpointsDefinitionPath, fmt.Sprintf("%s.0.%s", EndpointsFieldEndpoints, EndpointsFieldEndpointType), "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceEndpointsDefinitionPath, fmt.Sprintf("%s.0.%s.0", EndpointsFieldEndpoints, EndpointsFieldEndpointTechnologies), "nodeJsRuntimePlatform"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceEndpointsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewEndpointsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 6)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(DiscoveryFieldNameFilter)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(DiscoveryFieldLabel)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(EndpointsFieldServiceID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(EndpointsFieldEndpoints)
+
+	endpointSchemaAssert := testutils.NewTerraformSchemaAssert(schemaData[EndpointsFieldEndpoints].Elem.(*schema.Resource).Schema, t)
+	endpointSchemaAssert.AssertSchemaIsComputedAndOfTypeString(EndpointsFieldEndpointID)
+	endpointSchemaAssert.AssertSchemaIsComputedAndOfTypeString(EndpointsFieldEndpointLabel)
+	endpointSchemaAssert.AssertSchemaIsComputedAndOfTypeString(EndpointsFieldEndpointServiceID)
+	endpointSchemaAssert.AssertSchemaIsComputedAndOfTypeString(EndpointsFieldEndpointType)
+	endpointSchemaAssert.AssertSchemaIsComputedAndOfTypeListOfStrings(EndpointsFieldEndpointTechnologies)
+}
+
+func (r *dataSourceEndpointsUnitTest) shouldSuccessfullyReadEndpointsByServiceIDAndLabel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Endpoint](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		endpoints := []*restapi.Endpoint{
+			{ID: "endpoint-id-1", Label: "GET /health", ServiceID: "service-id-1", Type: "HTTP", Technologies: []string{"java"}},
+			{ID: "endpoint-id-2", Label: "GET /health", ServiceID: "service-id-2", Type: "HTTP", Technologies: []string{"java"}},
+			{ID: "endpoint-id-3", Label: "GET /orders", ServiceID: "service-id-2", Type: "HTTP", Technologies: []string{"java"}},
+		}
+		endpointsAPI := mocks.NewMockDiscoveryResource[*restapi.Endpoint](ctrl)
+		endpointsAPI.EXPECT().GetAll(restapi.DiscoveryQuery{NameFilter: "health"}).Times(1).Return(&endpoints, nil)
+		mockInstanaApi.EXPECT().Endpoints().Return(endpointsAPI).Times(1)
+
+		sut := NewEndpointsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			DiscoveryFieldNameFilter: "health",
+			DiscoveryFieldLabel:      "GET /health",
+			EndpointsFieldServiceID:  "service-id-2",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+		require.Equal(t, []interface{}{"endpoint-id-2"}, resourceData.Get(DiscoveryFieldIDs).(*schema.Set).List())
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				EndpointsFieldEndpointID:           "endpoint-id-2",
+				EndpointsFieldEndpointLabel:        "GET /health",
+				EndpointsFieldEndpointServiceID:    "service-id-2",
+				EndpointsFieldEndpointType:         "HTTP",
+				EndpointsFieldEndpointTechnologies: []interface{}{"java"},
+			},
+		}, resourceData.Get(EndpointsFieldEndpoints))
+	})
+}
+
+func (r *dataSourceEndpointsUnitTest) shouldFailToReadEndpointsWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Endpoint](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		endpointsAPI := mocks.NewMockDiscoveryResource[*restapi.Endpoint](ctrl)
+		endpointsAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().Endpoints().Return(endpointsAPI).Times(1)
+
+		sut := NewEndpointsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
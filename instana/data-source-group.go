@@ -0,0 +1,13 @@
+package instana
+
+import "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+
+// DataSourceGroup the name of the terraform-provider-instana data source to read RBAC groups by name
+const DataSourceGroup = "instana_rbac_group"
+
+// NewGroupDataSource creates a new DataSource for RBAC groups
+func NewGroupDataSource() DataSource {
+	return newResourceLookupDataSource[*restapi.Group](NewGroupResourceHandle(), GroupFieldName, "rbac group", func(obj *restapi.Group) string {
+		return obj.Name
+	}, false)
+}
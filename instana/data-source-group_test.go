@@ -0,0 +1,130 @@
+package instana_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGroupDataSource(t *testing.T) {
+	unitTest := &dataSourceGroupUnitTest{}
+	t.Run("integration test read of rbac group", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read rbac group by name", unitTest.shouldSuccessfullyReadGroupByName)
+}
+
+const dataSourceGroupDefinitionPath = "data.instana_rbac_group.example"
+
+const dataSourceGroupServerResponse = `
+[
+	{
+		"id" : "group-id-1",
+		"name" : "group-1",
+		"members": [],
+		"permissionSet": {
+			"permissions": [],
+			"applicationIds": [],
+			"kubernetesClusterUUIDs": [],
+			"kubernetesNamespaceUIDs": [],
+			"websiteIds": [],
+			"mobileAppIds": []
+		}
+	},
+	{
+		"id" : "group-id-2",
+		"name" : "group-2",
+		"members": [
+			{ "userId" : "user-id-1", "email" : "john.doe@example.com" }
+		],
+		"permissionSet": {
+			"permissions": [ "CAN_CONFIGURE_APPLICATIONS" ],
+			"applicationIds": [ { "scopeId" : "application-id", "scopeRoleId" : "-100" } ],
+			"kubernetesClusterUUIDs": [],
+			"kubernetesNamespaceUIDs": [],
+			"websiteIds": [],
+			"mobileAppIds": []
+		}
+	}
+]
+`
+
+type dataSourceGroupUnitTest struct{}
+
+func (r *dataSourceGroupUnitTest) integrationTestRead(t *testing.T) {
+	httpServer := createMockHttpServerForDataSource(restapi.GroupsResourcePath, newStringContentResponseProvider(dataSourceGroupServerResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceGroupDefinition := `
+data "instana_rbac_group" "example" {
+  name = "group-2"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceGroupDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceGroupDefinitionPath, "id", "group-id-2"),
+					resource.TestCheckResourceAttr(dataSourceGroupDefinitionPath, GroupFieldName, "group-2"),
+					resource.TestCheckResourceAttr(dataSourceGroupDefinitionPath, fmt.Sprintf("%s.#", GroupFieldMembers), "1"),
+					resource.TestCheckResourceAttr(dataSourceGroupDefinitionPath, fmt.Sprintf("%s.0.%s.0", GroupFieldPermissionSet, GroupFieldPermissionSetApplicationIDs), "application-id"),
+					resource.TestCheckResourceAttr(dataSourceGroupDefinitionPath, fmt.Sprintf("%s.0.%s.0", GroupFieldPermissionSet, GroupFieldPermissionSetPermissions), "CAN_CONFIGURE_APPLICATIONS"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceGroupUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewGroupDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 3)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(GroupFieldPermissionSet)
+	require.Equal(t, schema.TypeSet, schemaData[GroupFieldMembers].Type)
+	require.True(t, schemaData[GroupFieldMembers].Computed)
+
+	memberSchemaAssert := testutils.NewTerraformSchemaAssert(schemaData[GroupFieldMembers].Elem.(*schema.Resource).Schema, t)
+	memberSchemaAssert.AssertSchemaIsComputedAndOfTypeString(GroupFieldMemberUserID)
+	memberSchemaAssert.AssertSchemaIsComputedAndOfTypeString(GroupFieldMemberEmail)
+}
+
+func (r *dataSourceGroupUnitTest) shouldSuccessfullyReadGroupByName(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		email := "john.doe@example.com"
+		groups := []*restapi.Group{
+			{ID: "group-id-1", Name: "group-1"},
+			{ID: "group-id-2", Name: "group-2", Members: []restapi.APIMember{{UserID: "user-id-1", Email: &email}}},
+		}
+
+		groupsAPI := mocks.NewMockRestResource[*restapi.Group](ctrl)
+		groupsAPI.EXPECT().GetAll().Times(1).Return(&groups, nil)
+		mockInstanaApi.EXPECT().Groups().Return(groupsAPI).Times(1)
+
+		sut := NewGroupDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			GroupFieldName: "group-2",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "group-id-2", resourceData.Id())
+		require.Equal(t, "group-2", resourceData.Get(GroupFieldName))
+		members := resourceData.Get(GroupFieldMembers).(*schema.Set).List()
+		require.Equal(t, []interface{}{map[string]interface{}{GroupFieldMemberUserID: "user-id-1", GroupFieldMemberEmail: email}}, members)
+	})
+}
@@ -0,0 +1,35 @@
+package instana
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newNameFilter creates a new filter for names from the optional regular expression and prefix fields of the given
+// resource data. A name matches the filter when it matches both, the regular expression and the prefix, if provided.
+func newNameFilter(d *schema.ResourceData, regexField string, prefixField string) (*nameFilter, error) {
+	filter := &nameFilter{prefix: d.Get(prefixField).(string)}
+	if value, ok := d.GetOk(regexField); ok {
+		regex, err := regexp.Compile(value.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression provided for %s: %w", regexField, err)
+		}
+		filter.regex = regex
+	}
+	return filter, nil
+}
+
+type nameFilter struct {
+	regex  *regexp.Regexp
+	prefix string
+}
+
+func (f *nameFilter) matches(name string) bool {
+	if !strings.HasPrefix(name, f.prefix) {
+		return false
+	}
+	return f.regex == nil || f.regex.MatchString(name)
+}
@@ -0,0 +1,141 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newResourceLookupDataSource creates a new DataSource which looks up a single object of a managed resource type by its
+// name. The schema of the data source is derived from the schema of the resource and the state is mapped by the
+// ResourceHandle of the resource so that the data source provides the same attributes as the resource. When loadDetails
+// is set, the object is read again by its ID as the Instana API only returns a preview of the objects when all objects
+// are requested.
+func newResourceLookupDataSource[T restapi.InstanaDataObject](resourceHandle ResourceHandle[T], lookupField string, objectType string, nameOf func(T) string, loadDetails bool) DataSource {
+	return &resourceLookupDataSource[T]{
+		resourceHandle: resourceHandle,
+		lookupField:    lookupField,
+		objectType:     objectType,
+		nameOf:         nameOf,
+		loadDetails:    loadDetails,
+	}
+}
+
+type resourceLookupDataSource[T restapi.InstanaDataObject] struct {
+	resourceHandle ResourceHandle[T]
+	lookupField    string
+	objectType     string
+	nameOf         func(T) string
+	loadDetails    bool
+}
+
+// CreateResource creates the resource handle for the lookup data source
+func (ds *resourceLookupDataSource[T]) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema:      convertResourceSchemaToDataSourceSchema(ds.resourceHandle.MetaData().Schema, ds.lookupField),
+	}
+}
+
+func (ds *resourceLookupDataSource[T]) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	name := d.Get(ds.lookupField).(string)
+
+	restResource := ds.resourceHandle.GetRestResource(instanaAPI)
+	data, err := restResource.GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	obj, err := ds.find(name, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if ds.loadDetails {
+		obj, err = restResource.GetOne(obj.GetIDForResourcePath())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = ds.resourceHandle.UpdateState(d, obj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *resourceLookupDataSource[T]) find(name string, data *[]T) (T, error) {
+	var result T
+	found := false
+	for _, obj := range *data {
+		if ds.nameOf(obj) == name {
+			if found {
+				return result, fmt.Errorf("multiple %ss found with %s %s", ds.objectType, ds.lookupField, name)
+			}
+			result = obj
+			found = true
+		}
+	}
+	if !found {
+		return result, fmt.Errorf("no %s found with %s %s", ds.objectType, ds.lookupField, name)
+	}
+	return result, nil
+}
+
+// convertResourceSchemaToDataSourceSchema converts the schema of a resource into the schema of a data source. The key
+// field is copied including its configuration and marked as required. All other fields are converted into computed
+// fields with the minimal configuration required for a computed data source field.
+func convertResourceSchemaToDataSourceSchema(schemaMap map[string]*schema.Schema, keyField string) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema)
+
+	for k, v := range schemaMap {
+		if k == keyField {
+			//for the key we assume a simple type. Here we copy the schema including all configuration and make sure
+			//the field is required
+			s := *v
+			s.Required = true
+			s.Optional = false
+			s.Computed = false
+			s.Default = nil
+			s.ForceNew = false
+			result[k] = &s
+		} else {
+			//For all other fields we create a new schema, mark it as computed and then set the minimal data required
+			//for the computed datasource field
+			s := &schema.Schema{}
+			s.Description = v.Description
+			s.Deprecated = v.Deprecated
+			s.Sensitive = v.Sensitive
+			s.Type = v.Type
+			s.Required = false
+			s.Optional = false
+			s.Computed = true
+
+			if v.Type == schema.TypeList || v.Type == schema.TypeSet || v.Type == schema.TypeMap {
+				if reflect.TypeOf(v.Elem) == reflect.TypeOf(&schema.Resource{}) {
+					nestedSchema := v.Elem.(*schema.Resource).Schema
+					convertedNestedSchema := convertResourceSchemaToDataSourceSchema(nestedSchema, "")
+					s.Elem = &schema.Resource{
+						Schema: convertedNestedSchema,
+					}
+				} else if reflect.TypeOf(v.Elem) == reflect.TypeOf(&schema.Schema{}) {
+					nestedSchema := *v.Elem.(*schema.Schema)
+					s.Elem = &nestedSchema
+				} else {
+					s.Elem = v.Elem
+				}
+			}
+			result[k] = s
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,136 @@
+package instana_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResourceLookupDataSource(t *testing.T) {
+	unitTest := &dataSourceResourceLookupUnitTest{}
+	t.Run("schema should be derived from resource schema", unitTest.schemaShouldBeDerivedFromResourceSchema)
+	t.Run("should fail to read when no object with the name exists", unitTest.shouldFailToReadWhenNoObjectWithTheNameExists)
+	t.Run("should fail to read when multiple objects with the name exist", unitTest.shouldFailToReadWhenMultipleObjectsWithTheNameExist)
+	t.Run("should fail to read when api call fails", unitTest.shouldFailToReadWhenApiCallFails)
+	t.Run("should fail to read when loading of details fails", unitTest.shouldFailToReadWhenLoadingOfDetailsFails)
+}
+
+type dataSourceResourceLookupUnitTest struct{}
+
+func (r *dataSourceResourceLookupUnitTest) schemaShouldBeDerivedFromResourceSchema(t *testing.T) {
+	resourceSchema := NewSliConfigResourceHandle().MetaData().Schema
+	schemaData := NewSliConfigDataSource().CreateResource().Schema
+
+	require.Len(t, schemaData, len(resourceSchema))
+	require.True(t, schemaData[SliConfigFieldName].Required)
+	require.False(t, schemaData[SliConfigFieldName].Optional)
+	require.False(t, schemaData[SliConfigFieldName].Computed)
+	require.False(t, schemaData[SliConfigFieldName].ForceNew)
+	for k, v := range schemaData {
+		if k != SliConfigFieldName {
+			r.requireSchemaIsComputedOnly(t, k, v)
+		}
+	}
+}
+
+func (r *dataSourceResourceLookupUnitTest) requireSchemaIsComputedOnly(t *testing.T, key string, s *schema.Schema) {
+	require.Truef(t, s.Computed, "%s should be computed", key)
+	require.Falsef(t, s.Optional, "%s should not be optional", key)
+	require.Falsef(t, s.Required, "%s should not be required", key)
+	require.Nilf(t, s.Default, "%s should not have a default", key)
+	require.Emptyf(t, s.ConflictsWith, "%s should not have conflicts", key)
+	require.Emptyf(t, s.ExactlyOneOf, "%s should not have exactly one of constraints", key)
+	if nested, ok := s.Elem.(*schema.Resource); ok {
+		for k, v := range nested.Schema {
+			r.requireSchemaIsComputedOnly(t, key+"."+k, v)
+		}
+	}
+}
+
+func (r *dataSourceResourceLookupUnitTest) shouldFailToReadWhenNoObjectWithTheNameExists(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		groups := []*restapi.Group{{ID: "group-id-1", Name: "group-1"}}
+
+		groupsAPI := mocks.NewMockRestResource[*restapi.Group](ctrl)
+		groupsAPI.EXPECT().GetAll().Times(1).Return(&groups, nil)
+		mockInstanaApi.EXPECT().Groups().Return(groupsAPI).Times(1)
+
+		sut := NewGroupDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{GroupFieldName: "group-2"})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "no rbac group found with name group-2")
+	})
+}
+
+func (r *dataSourceResourceLookupUnitTest) shouldFailToReadWhenMultipleObjectsWithTheNameExist(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		groups := []*restapi.Group{{ID: "group-id-1", Name: "group"}, {ID: "group-id-2", Name: "group"}}
+
+		groupsAPI := mocks.NewMockRestResource[*restapi.Group](ctrl)
+		groupsAPI.EXPECT().GetAll().Times(1).Return(&groups, nil)
+		mockInstanaApi.EXPECT().Groups().Return(groupsAPI).Times(1)
+
+		sut := NewGroupDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{GroupFieldName: "group"})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "multiple rbac groups found with name group")
+	})
+}
+
+func (r *dataSourceResourceLookupUnitTest) shouldFailToReadWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		groupsAPI := mocks.NewMockRestResource[*restapi.Group](ctrl)
+		groupsAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().Groups().Return(groupsAPI).Times(1)
+
+		sut := NewGroupDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{GroupFieldName: "group"})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
+
+func (r *dataSourceResourceLookupUnitTest) shouldFailToReadWhenLoadingOfDetailsFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.CustomDashboard](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+		dashboards := []*restapi.CustomDashboard{{ID: "dashboard-id", Title: "dashboard"}}
+
+		dashboardsAPI := mocks.NewMockRestResource[*restapi.CustomDashboard](ctrl)
+		dashboardsAPI.EXPECT().GetAll().Times(1).Return(&dashboards, nil)
+		dashboardsAPI.EXPECT().GetOne("dashboard-id").Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().CustomDashboards().Return(dashboardsAPI).Times(1)
+
+		sut := NewCustomDashboardDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{CustomDashboardFieldTitle: "dashboard"})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
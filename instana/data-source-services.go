@@ -0,0 +1,110 @@
+package instana
+
+import (
+	"context"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewServicesDataSource creates a new DataSource for the services discovered by application monitoring
+func NewServicesDataSource() DataSource {
+	return &servicesDataSource{}
+}
+
+const (
+	//ServicesFieldServices constant value for the computed schema field services
+	ServicesFieldServices = "services"
+	//ServicesFieldServiceID constant value for the computed schema field services.id
+	ServicesFieldServiceID = "id"
+	//ServicesFieldServiceLabel constant value for the computed schema field services.label
+	ServicesFieldServiceLabel = "label"
+	//ServicesFieldServiceTypes constant value for the computed schema field services.types
+	ServicesFieldServiceTypes = "types"
+	//ServicesFieldServiceTechnologies constant value for the computed schema field services.technologies
+	ServicesFieldServiceTechnologies = "technologies"
+	//DataSourceServices the name of the terraform-provider-instana data source for services
+	DataSourceServices = "instana_services"
+)
+
+type servicesDataSource struct{}
+
+// CreateResource creates the resource handle for services
+func (ds *servicesDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: createDiscoverySchema("service", map[string]*schema.Schema{
+			ServicesFieldServices: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching services",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ServicesFieldServiceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the service",
+						},
+						ServicesFieldServiceLabel: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the service",
+						},
+						ServicesFieldServiceTypes: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The types of the service",
+						},
+						ServicesFieldServiceTechnologies: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The technologies of the service",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (ds *servicesDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	data, err := instanaAPI.Services().GetAll(readDiscoveryQuery(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	services := filterDiscoveredObjects(d, data, func(s *restapi.Service) string { return s.Label }, func(_ *restapi.Service) bool { return true })
+
+	err = ds.updateState(d, services)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *servicesDataSource) updateState(d *schema.ResourceData, data []*restapi.Service) error {
+	ids := make([]string, len(data))
+	services := make([]interface{}, len(data))
+	for i, service := range data {
+		ids[i] = service.ID
+		services[i] = map[string]interface{}{
+			ServicesFieldServiceID:           service.ID,
+			ServicesFieldServiceLabel:        service.Label,
+			ServicesFieldServiceTypes:        service.Types,
+			ServicesFieldServiceTechnologies: service.Technologies,
+		}
+	}
+
+	d.SetId(createDiscoveryID(ids))
+	return tfutils.UpdateState(d, map[string]interface{}{
+		DiscoveryFieldIDs:     ids,
+		ServicesFieldServices: services,
+	})
+}
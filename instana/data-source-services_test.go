@@ -0,0 +1,136 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServicesDataSource(t *testing.T) {
+	unitTest := &dataSourceServicesUnitTest{}
+	t.Run("integration test read of services", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read services by label", unitTest.shouldSuccessfullyReadServicesByLabel)
+	t.Run("should fail to read services when api call fails", unitTest.shouldFailToReadServicesWhenApiCallFails)
+}
+
+const dataSourceServicesDefinitionPath = "data.instana_services.example"
+
+type dataSourceServicesUnitTest struct{}
+
+func (r *dataSourceServicesUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+{
+	"items" : [
+		{ "id" : "service-id-1", "label" : "shop", "entityType" : "SERVICE", "types" : [ "HTTP" ], "technologies" : [ "springbootApplicationContainer" ] },
+		{ "id" : "service-id-2", "label" : "shop-db", "entityType" : "SERVICE", "types" : [ "DATABASE" ], "technologies" : [ "postgreSqlDatabase" ] }
+	],
+	"page" : 1,
+	"pageSize" : 200,
+	"totalHits" : 2
+}
+`
+	httpServer := createMockHttpServerForDataSource(restapi.ServicesResourcePath, newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceServicesDefinition := `
+data "instana_services" "example" {
+  label = "shop"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceServicesDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceServicesDefinitionPath, fmt.Sprintf("%s.#", DiscoveryFieldIDs), "1"),
+					resource.TestCheckResourceAttr(dataSourceServicesDefinitionPath, fmt.Sprintf("%s.0", DiscoveryFieldIDs), "service-id-1"),
+					resource.TestCheckResourceAttr(dataSourceServicesDefinitionPath, fmt.Sprintf("%s.0.%s", ServicesFieldServices, ServicesFieldServiceLabel), "shop"),
+					resource.TestCheckResourceAttr(dataSourceServicesDefinitionPath, fmt.Sprintf("%s.0.%s.0", ServicesFieldServices, ServicesFieldServiceTypes), "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceServicesDefinitionPath, fmt.Sprintf("%s.0.%s.0", ServicesFieldServices, ServicesFieldServiceTechnologies), "springbootApplicationContainer"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceServicesUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewServicesDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 5)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(DiscoveryFieldNameFilter)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(DiscoveryFieldLabel)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(ServicesFieldServices)
+
+	serviceSchemaAssert := testutils.NewTerraformSchemaAssert(schemaData[ServicesFieldServices].Elem.(*schema.Resource).Schema, t)
+	serviceSchemaAssert.AssertSchemaIsComputedAndOfTypeString(ServicesFieldServiceID)
+	serviceSchemaAssert.AssertSchemaIsComputedAndOfTypeString(ServicesFieldServiceLabel)
+	serviceSchemaAssert.AssertSchemaIsComputedAndOfTypeListOfStrings(ServicesFieldServiceTypes)
+	serviceSchemaAssert.AssertSchemaIsComputedAndOfTypeListOfStrings(ServicesFieldServiceTechnologies)
+}
+
+func (r *dataSourceServicesUnitTest) shouldSuccessfullyReadServicesByLabel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Service](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		services := []*restapi.Service{
+			{ID: "service-id-1", Label: "shop", Types: []string{"HTTP"}, Technologies: []string{"java"}},
+			{ID: "service-id-2", Label: "shop-db", Types: []string{"DATABASE"}, Technologies: []string{"postgreSqlDatabase"}},
+		}
+		servicesAPI := mocks.NewMockDiscoveryResource[*restapi.Service](ctrl)
+		servicesAPI.EXPECT().GetAll(restapi.DiscoveryQuery{NameFilter: "shop"}).Times(1).Return(&services, nil)
+		mockInstanaApi.EXPECT().Services().Return(servicesAPI).Times(1)
+
+		sut := NewServicesDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			DiscoveryFieldNameFilter: "shop",
+			DiscoveryFieldLabel:      "shop",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+		require.Equal(t, []interface{}{"service-id-1"}, resourceData.Get(DiscoveryFieldIDs).(*schema.Set).List())
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				ServicesFieldServiceID:           "service-id-1",
+				ServicesFieldServiceLabel:        "shop",
+				ServicesFieldServiceTypes:        []interface{}{"HTTP"},
+				ServicesFieldServiceTechnologies: []interface{}{"java"},
+			},
+		}, resourceData.Get(ServicesFieldServices))
+	})
+}
+
+func (r *dataSourceServicesUnitTest) shouldFailToReadServicesWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Service](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		servicesAPI := mocks.NewMockDiscoveryResource[*restapi.Service](ctrl)
+		servicesAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().Services().Return(servicesAPI).Times(1)
+
+		sut := NewServicesDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
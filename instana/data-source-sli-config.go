@@ -0,0 +1,13 @@
+package instana
+
+import "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+
+// DataSourceSliConfig the name of the terraform-provider-instana data source to read SLI configs by name
+const DataSourceSliConfig = "instana_sli_config"
+
+// NewSliConfigDataSource creates a new DataSource for SLI configs
+func NewSliConfigDataSource() DataSource {
+	return newResourceLookupDataSource[*restapi.SliConfig](NewSliConfigResourceHandle(), SliConfigFieldName, "sli config", func(obj *restapi.SliConfig) string {
+		return obj.Name
+	}, false)
+}
@@ -0,0 +1,119 @@
+package instana_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSliConfigDataSource(t *testing.T) {
+	unitTest := &dataSourceSliConfigUnitTest{}
+	t.Run("integration test read of sli config", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read sli config by name", unitTest.shouldSuccessfullyReadSliConfigByName)
+}
+
+const dataSourceSliConfigDefinitionPath = "data.instana_sli_config.example"
+
+const dataSourceSliConfigServerResponse = `
+[
+	{
+		"id" : "sli-id-1",
+		"sliName" : "sli-1",
+		"initialEvaluationTimestamp": 0,
+		"sliEntity": { "sliType" : "application", "applicationId" : "application-id-1", "boundaryScope" : "ALL" }
+	},
+	{
+		"id" : "sli-id-2",
+		"sliName" : "sli-2",
+		"initialEvaluationTimestamp": 0,
+		"metricConfiguration": { "metricName" : "latency", "metricAggregation" : "SUM", "threshold" : 1.0 },
+		"sliEntity": {
+			"sliType" : "application",
+			"applicationId" : "application-id-2",
+			"serviceId" : "service-id",
+			"endpointId" : "endpoint-id",
+			"boundaryScope" : "ALL"
+		}
+	}
+]
+`
+
+type dataSourceSliConfigUnitTest struct{}
+
+func (r *dataSourceSliConfigUnitTest) integrationTestRead(t *testing.T) {
+	httpServer := createMockHttpServerForDataSource(restapi.SliConfigResourcePath, newStringContentResponseProvider(dataSourceSliConfigServerResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceSliConfigDefinition := `
+data "instana_sli_config" "example" {
+  name = "sli-2"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceSliConfigDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceSliConfigDefinitionPath, "id", "sli-id-2"),
+					resource.TestCheckResourceAttr(dataSourceSliConfigDefinitionPath, SliConfigFieldName, "sli-2"),
+					resource.TestCheckResourceAttr(dataSourceSliConfigDefinitionPath, fmt.Sprintf("%s.0.%s", SliConfigFieldMetricConfiguration, SliConfigFieldMetricName), "latency"),
+					resource.TestCheckResourceAttr(dataSourceSliConfigDefinitionPath, fmt.Sprintf("%s.0.%s", SliConfigFieldMetricConfiguration, SliConfigFieldMetricAggregation), "SUM"),
+					resource.TestCheckResourceAttr(dataSourceSliConfigDefinitionPath, fmt.Sprintf("%s.0.%s.0.%s", SliConfigFieldSliEntity, SliConfigFieldSliEntityApplicationTimeBased, SliConfigFieldApplicationID), "application-id-2"),
+					resource.TestCheckResourceAttr(dataSourceSliConfigDefinitionPath, fmt.Sprintf("%s.0.%s.0.%s", SliConfigFieldSliEntity, SliConfigFieldSliEntityApplicationTimeBased, SliConfigFieldServiceID), "service-id"),
+					resource.TestCheckResourceAttr(dataSourceSliConfigDefinitionPath, fmt.Sprintf("%s.0.%s.0.%s", SliConfigFieldSliEntity, SliConfigFieldSliEntityApplicationTimeBased, SliConfigFieldEndpointID), "endpoint-id"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceSliConfigUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewSliConfigDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 4)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SliConfigFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(SliConfigFieldInitialEvaluationTimestamp)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(SliConfigFieldMetricConfiguration)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(SliConfigFieldSliEntity)
+}
+
+func (r *dataSourceSliConfigUnitTest) shouldSuccessfullyReadSliConfigByName(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SliConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		applicationID := "application-id"
+		boundaryScope := "ALL"
+		sliConfigs := []*restapi.SliConfig{
+			{ID: "sli-id-1", Name: "sli-1", SliEntity: restapi.SliEntity{Type: "application", ApplicationID: &applicationID, BoundaryScope: &boundaryScope}},
+			{ID: "sli-id-2", Name: "sli-2", SliEntity: restapi.SliEntity{Type: "application", ApplicationID: &applicationID, BoundaryScope: &boundaryScope}},
+		}
+
+		sliConfigsAPI := mocks.NewMockRestResource[*restapi.SliConfig](ctrl)
+		sliConfigsAPI.EXPECT().GetAll().Times(1).Return(&sliConfigs, nil)
+		mockInstanaApi.EXPECT().SliConfigs().Return(sliConfigsAPI).Times(1)
+
+		sut := NewSliConfigDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			SliConfigFieldName: "sli-2",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "sli-id-2", resourceData.Id())
+		require.Equal(t, "sli-2", resourceData.Get(SliConfigFieldName))
+		require.Equal(t, applicationID, resourceData.Get(fmt.Sprintf("%s.0.%s.0.%s", SliConfigFieldSliEntity, SliConfigFieldSliEntityApplicationTimeBased, SliConfigFieldApplicationID)))
+	})
+}
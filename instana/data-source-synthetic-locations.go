@@ -0,0 +1,229 @@
+package instana
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewSyntheticLocationsDataSource creates a new DataSource for multiple Synthetic Locations
+func NewSyntheticLocationsDataSource() DataSource {
+	return &syntheticLocationsDataSource{}
+}
+
+const (
+	//SyntheticLocationsFieldLabelRegex constant value for the schema field label_regex
+	SyntheticLocationsFieldLabelRegex = "label_regex"
+	//SyntheticLocationsFieldLabelPrefix constant value for the schema field label_prefix
+	SyntheticLocationsFieldLabelPrefix = "label_prefix"
+	//SyntheticLocationsFieldLocationType constant value for the schema field location_type
+	SyntheticLocationsFieldLocationType = "location_type"
+	//SyntheticLocationsFieldCountryNames constant value for the schema field country_names
+	SyntheticLocationsFieldCountryNames = "country_names"
+	//SyntheticLocationsFieldSyntheticType constant value for the schema field synthetic_type
+	SyntheticLocationsFieldSyntheticType = "synthetic_type"
+	//SyntheticLocationsFieldIDs constant value for the computed schema field ids
+	SyntheticLocationsFieldIDs = "ids"
+	//SyntheticLocationsFieldLocations constant value for the computed schema field locations
+	SyntheticLocationsFieldLocations = "locations"
+	//SyntheticLocationsFieldLocationID constant value for the computed schema field locations.id
+	SyntheticLocationsFieldLocationID = "id"
+	//SyntheticLocationsFieldLocationLabel constant value for the computed schema field locations.label
+	SyntheticLocationsFieldLocationLabel = "label"
+	//SyntheticLocationsFieldLocationDisplayLabel constant value for the computed schema field locations.display_label
+	SyntheticLocationsFieldLocationDisplayLabel = "display_label"
+	//SyntheticLocationsFieldLocationDescription constant value for the computed schema field locations.description
+	SyntheticLocationsFieldLocationDescription = "description"
+	//SyntheticLocationsFieldLocationCountryName constant value for the computed schema field locations.country_name
+	SyntheticLocationsFieldLocationCountryName = "country_name"
+	//SyntheticLocationsFieldLocationCityName constant value for the computed schema field locations.city_name
+	SyntheticLocationsFieldLocationCityName = "city_name"
+	//DataSourceSyntheticLocations the name of the terraform-provider-instana data source for multiple synthetic locations
+	DataSourceSyntheticLocations = "instana_synthetic_locations"
+)
+
+type syntheticLocationsDataSource struct{}
+
+// CreateResource creates the resource handle for multiple Synthetic Locations
+func (ds *syntheticLocationsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			SyntheticLocationsFieldLabelRegex: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Regular expression the label of the Synthetic locations must match",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			SyntheticLocationsFieldLabelPrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefix the label of the Synthetic locations must start with",
+			},
+			SyntheticLocationsFieldLocationType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Indicates if only public or private locations should be returned",
+				ValidateFunc: validation.StringInSlice([]string{"Public", "Private"}, true),
+			},
+			SyntheticLocationsFieldCountryNames: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Description: "The names of the countries the Synthetic locations must be located in. The names are compared case-insensitive",
+			},
+			SyntheticLocationsFieldSyntheticType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The type of Synthetic tests the locations must support",
+				ValidateFunc: validation.StringInSlice([]string{SyntheticCheckTypeHttpAction, SyntheticCheckTypeHttpScript, SyntheticCheckTypeBrowserScript, SyntheticCheckTypeWebpageAction, SyntheticCheckTypeWebpageScript, SyntheticCheckTypeDNSAction}, false),
+			},
+			SyntheticLocationsFieldIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the matching Synthetic locations",
+			},
+			SyntheticLocationsFieldLocations: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching Synthetic locations",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						SyntheticLocationsFieldLocationID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the Synthetic location",
+						},
+						SyntheticLocationsFieldLocationLabel: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The label of the Synthetic location",
+						},
+						SyntheticLocationsFieldLocationDisplayLabel: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display label of the Synthetic location",
+						},
+						SyntheticLocationsFieldLocationDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the Synthetic location",
+						},
+						SyntheticLocationsFieldLocationType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Indicates if the location is public or private",
+						},
+						SyntheticLocationsFieldLocationCountryName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the country the Synthetic location is located in",
+						},
+						SyntheticLocationsFieldLocationCityName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the city the Synthetic location is located in",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *syntheticLocationsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	labelFilter, err := newNameFilter(d, SyntheticLocationsFieldLabelRegex, SyntheticLocationsFieldLabelPrefix)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data, err := instanaAPI.SyntheticLocation().GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	syntheticLocations := ds.filterSyntheticLocations(d, labelFilter, data)
+
+	err = ds.updateState(d, syntheticLocations)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *syntheticLocationsDataSource) filterSyntheticLocations(d *schema.ResourceData, labelFilter *nameFilter, data *[]*restapi.SyntheticLocation) []*restapi.SyntheticLocation {
+	locationType := d.Get(SyntheticLocationsFieldLocationType).(string)
+	countryNames := ReadStringSetParameterFromResource(d, SyntheticLocationsFieldCountryNames)
+	syntheticType := d.Get(SyntheticLocationsFieldSyntheticType).(string)
+
+	result := make([]*restapi.SyntheticLocation, 0)
+	for _, location := range *data {
+		if !labelFilter.matches(location.Label) {
+			continue
+		}
+		if len(locationType) > 0 && !strings.EqualFold(location.LocationType, locationType) {
+			continue
+		}
+		if len(countryNames) > 0 && !ds.containsIgnoringCase(countryNames, location.GeoPoint.CountryName) {
+			continue
+		}
+		if len(syntheticType) > 0 && !slices.Contains(location.PlaybackCapabilities.SyntheticType, syntheticType) {
+			continue
+		}
+		result = append(result, location)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Label == result[j].Label {
+			return result[i].ID < result[j].ID
+		}
+		return result[i].Label < result[j].Label
+	})
+	return result
+}
+
+func (ds *syntheticLocationsDataSource) containsIgnoringCase(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ds *syntheticLocationsDataSource) updateState(d *schema.ResourceData, syntheticLocations []*restapi.SyntheticLocation) error {
+	ids := make([]string, len(syntheticLocations))
+	locations := make([]interface{}, len(syntheticLocations))
+	for i, location := range syntheticLocations {
+		ids[i] = location.ID
+		locations[i] = map[string]interface{}{
+			SyntheticLocationsFieldLocationID:           location.ID,
+			SyntheticLocationsFieldLocationLabel:        location.Label,
+			SyntheticLocationsFieldLocationDisplayLabel: location.DisplayLabel,
+			SyntheticLocationsFieldLocationDescription:  location.Description,
+			SyntheticLocationsFieldLocationType:         location.LocationType,
+			SyntheticLocationsFieldLocationCountryName:  location.GeoPoint.CountryName,
+			SyntheticLocationsFieldLocationCityName:     location.GeoPoint.CityName,
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	return tfutils.UpdateState(d, map[string]interface{}{
+		SyntheticLocationsFieldIDs:       ids,
+		SyntheticLocationsFieldLocations: locations,
+	})
+}
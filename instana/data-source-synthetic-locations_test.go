@@ -0,0 +1,244 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSyntheticLocationsDataSource(t *testing.T) {
+	unitTest := &dataSourceSyntheticLocationsUnitTest{}
+	t.Run("integration test read of synthetic locations", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should return all synthetic locations sorted by label when no filter is provided", unitTest.shouldReturnAllSyntheticLocationsSortedByLabelWhenNoFilterIsProvided)
+	t.Run("should filter synthetic locations by label regex and prefix", unitTest.shouldFilterSyntheticLocationsByLabelRegexAndPrefix)
+	t.Run("should filter synthetic locations by location type and country names case-insensitive", unitTest.shouldFilterSyntheticLocationsByLocationTypeAndCountryNamesCaseInsensitive)
+	t.Run("should filter synthetic locations by synthetic type", unitTest.shouldFilterSyntheticLocationsBySyntheticType)
+	t.Run("should fail to read synthetic locations when regex is invalid", unitTest.shouldFailToReadSyntheticLocationsWhenRegexIsInvalid)
+	t.Run("should fail to read synthetic locations when api call fails", unitTest.shouldFailToReadSyntheticLocationsWhenApiCallFails)
+}
+
+const dataSourceSyntheticLocationsDefinitionPath = "data.instana_synthetic_locations.example"
+
+type dataSourceSyntheticLocationsUnitTest struct{}
+
+func (r *dataSourceSyntheticLocationsUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+[
+	{
+		"id" : "location-id-1",
+		"label" : "eu-frankfurt",
+		"displayLabel" : "Frankfurt",
+		"description" : "Public location in Frankfurt",
+		"locationType" : "Public",
+		"geoPoint" : { "cityName" : "Frankfurt", "countryName" : "Germany", "latitude" : 50.1, "longitude" : 8.7 },
+		"playbackCapabilities" : { "browserType" : [ "chrome" ], "syntheticType" : [ "HTTPAction", "BrowserScript" ] }
+	},
+	{
+		"id" : "location-id-2",
+		"label" : "us-east",
+		"displayLabel" : "Virginia",
+		"locationType" : "Public",
+		"geoPoint" : { "cityName" : "Ashburn", "countryName" : "United States", "latitude" : 39.0, "longitude" : -77.5 },
+		"playbackCapabilities" : { "browserType" : [], "syntheticType" : [ "HTTPAction" ] }
+	}
+]
+`
+	httpServer := createMockHttpServerForDataSource(restapi.SyntheticLocationResourcePath, newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceSyntheticLocationsDefinition := `
+data "instana_synthetic_locations" "example" {
+  label_prefix  = "eu-"
+  location_type = "Public"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceSyntheticLocationsDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceSyntheticLocationsDefinitionPath, fmt.Sprintf("%s.#", SyntheticLocationsFieldIDs), "1"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticLocationsDefinitionPath, fmt.Sprintf("%s.0", SyntheticLocationsFieldIDs), "location-id-1"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticLocationsDefinitionPath, fmt.Sprintf("%s.#", SyntheticLocationsFieldLocations), "1"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticLocationsDefinitionPath, fmt.Sprintf("%s.0.%s", SyntheticLocationsFieldLocations, SyntheticLocationsFieldLocationLabel), "eu-frankfurt"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticLocationsDefinitionPath, fmt.Sprintf("%s.0.%s", SyntheticLocationsFieldLocations, SyntheticLocationsFieldLocationDisplayLabel), "Frankfurt"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticLocationsDefinitionPath, fmt.Sprintf("%s.0.%s", SyntheticLocationsFieldLocations, SyntheticLocationsFieldLocationCountryName), "Germany"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticLocationsDefinitionPath, fmt.Sprintf("%s.0.%s", SyntheticLocationsFieldLocations, SyntheticLocationsFieldLocationCityName), "Frankfurt"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewSyntheticLocationsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 7)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticLocationsFieldLabelRegex)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticLocationsFieldLabelPrefix)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticLocationsFieldLocationType)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(SyntheticLocationsFieldCountryNames)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticLocationsFieldSyntheticType)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(SyntheticLocationsFieldLocations)
+	require.Equal(t, schema.TypeSet, schemaData[SyntheticLocationsFieldIDs].Type)
+	require.True(t, schemaData[SyntheticLocationsFieldIDs].Computed)
+
+	locationSchemaAssert := testutils.NewTerraformSchemaAssert(schemaData[SyntheticLocationsFieldLocations].Elem.(*schema.Resource).Schema, t)
+	locationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticLocationsFieldLocationID)
+	locationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticLocationsFieldLocationLabel)
+	locationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticLocationsFieldLocationDisplayLabel)
+	locationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticLocationsFieldLocationDescription)
+	locationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticLocationsFieldLocationType)
+	locationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticLocationsFieldLocationCountryName)
+	locationSchemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticLocationsFieldLocationCityName)
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) shouldReturnAllSyntheticLocationsSortedByLabelWhenNoFilterIsProvided(t *testing.T) {
+	resourceData := r.executeRead(t, map[string]interface{}{})
+
+	require.Equal(t, []interface{}{"location-id-3", "location-id-1", "location-id-2"}, r.readLocationIDs(resourceData))
+	require.Len(t, resourceData.Get(SyntheticLocationsFieldIDs).(*schema.Set).List(), 3)
+	require.Equal(t, map[string]interface{}{
+		SyntheticLocationsFieldLocationID:           "location-id-3",
+		SyntheticLocationsFieldLocationLabel:        "eu-amsterdam",
+		SyntheticLocationsFieldLocationDisplayLabel: "Amsterdam",
+		SyntheticLocationsFieldLocationDescription:  "private location",
+		SyntheticLocationsFieldLocationType:         "Private",
+		SyntheticLocationsFieldLocationCountryName:  "Netherlands",
+		SyntheticLocationsFieldLocationCityName:     "Amsterdam",
+	}, resourceData.Get(SyntheticLocationsFieldLocations).([]interface{})[0])
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) shouldFilterSyntheticLocationsByLabelRegexAndPrefix(t *testing.T) {
+	resourceData := r.executeRead(t, map[string]interface{}{
+		SyntheticLocationsFieldLabelRegex:  "frankfurt|east",
+		SyntheticLocationsFieldLabelPrefix: "eu-",
+	})
+
+	require.Equal(t, []interface{}{"location-id-1"}, r.readLocationIDs(resourceData))
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) shouldFilterSyntheticLocationsByLocationTypeAndCountryNamesCaseInsensitive(t *testing.T) {
+	resourceData := r.executeRead(t, map[string]interface{}{
+		SyntheticLocationsFieldLocationType: "public",
+		SyntheticLocationsFieldCountryNames: []interface{}{"germany", "Netherlands"},
+	})
+
+	require.Equal(t, []interface{}{"location-id-1"}, r.readLocationIDs(resourceData))
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) shouldFilterSyntheticLocationsBySyntheticType(t *testing.T) {
+	resourceData := r.executeRead(t, map[string]interface{}{
+		SyntheticLocationsFieldSyntheticType: SyntheticCheckTypeBrowserScript,
+	})
+
+	require.Equal(t, []interface{}{"location-id-3", "location-id-1"}, r.readLocationIDs(resourceData))
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) shouldFailToReadSyntheticLocationsWhenRegexIsInvalid(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticLocation](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		sut := NewSyntheticLocationsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			SyntheticLocationsFieldLabelRegex: "eu-(",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "invalid regular expression provided for label_regex")
+	})
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) shouldFailToReadSyntheticLocationsWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticLocation](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		syntheticLocationAPI := mocks.NewMockReadOnlyRestResource[*restapi.SyntheticLocation](ctrl)
+		syntheticLocationAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().SyntheticLocation().Return(syntheticLocationAPI).Times(1)
+
+		sut := NewSyntheticLocationsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) executeRead(t *testing.T, config map[string]interface{}) *schema.ResourceData {
+	var resourceData *schema.ResourceData
+	testHelper := NewTestHelper[*restapi.SyntheticLocation](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		syntheticLocations := r.createSyntheticLocations()
+		syntheticLocationAPI := mocks.NewMockReadOnlyRestResource[*restapi.SyntheticLocation](ctrl)
+		syntheticLocationAPI.EXPECT().GetAll().Times(1).Return(&syntheticLocations, nil)
+		mockInstanaApi.EXPECT().SyntheticLocation().Return(syntheticLocationAPI).Times(1)
+
+		sut := NewSyntheticLocationsDataSource().CreateResource()
+		resourceData = schema.TestResourceDataRaw(t, sut.Schema, config)
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+	})
+	return resourceData
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) readLocationIDs(resourceData *schema.ResourceData) []interface{} {
+	result := make([]interface{}, 0)
+	for _, location := range resourceData.Get(SyntheticLocationsFieldLocations).([]interface{}) {
+		result = append(result, location.(map[string]interface{})[SyntheticLocationsFieldLocationID])
+	}
+	return result
+}
+
+func (r *dataSourceSyntheticLocationsUnitTest) createSyntheticLocations() []*restapi.SyntheticLocation {
+	return []*restapi.SyntheticLocation{
+		{
+			ID:                   "location-id-1",
+			Label:                "eu-frankfurt",
+			DisplayLabel:         "Frankfurt",
+			LocationType:         "Public",
+			GeoPoint:             restapi.SyntheticGeoPoint{CityName: "Frankfurt", CountryName: "Germany"},
+			PlaybackCapabilities: restapi.SyntheticPlaybackCapabilities{SyntheticType: []string{SyntheticCheckTypeHttpAction, SyntheticCheckTypeBrowserScript}},
+		},
+		{
+			ID:                   "location-id-2",
+			Label:                "us-east",
+			DisplayLabel:         "Virginia",
+			LocationType:         "Public",
+			GeoPoint:             restapi.SyntheticGeoPoint{CityName: "Ashburn", CountryName: "United States"},
+			PlaybackCapabilities: restapi.SyntheticPlaybackCapabilities{SyntheticType: []string{SyntheticCheckTypeHttpAction}},
+		},
+		{
+			ID:                   "location-id-3",
+			Label:                "eu-amsterdam",
+			DisplayLabel:         "Amsterdam",
+			Description:          "private location",
+			LocationType:         "Private",
+			GeoPoint:             restapi.SyntheticGeoPoint{CityName: "Amsterdam", CountryName: "Netherlands"},
+			PlaybackCapabilities: restapi.SyntheticPlaybackCapabilities{SyntheticType: []string{SyntheticCheckTypeBrowserScript}},
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package instana
+
+import "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+
+// DataSourceSyntheticTest the name of the terraform-provider-instana data source to read synthetic tests by label
+const DataSourceSyntheticTest = "instana_synthetic_test"
+
+// NewSyntheticTestDataSource creates a new DataSource for synthetic tests
+func NewSyntheticTestDataSource() DataSource {
+	return newResourceLookupDataSource[*restapi.SyntheticTest](NewSyntheticTestResourceHandle(), SyntheticTestFieldLabel, "synthetic test", func(obj *restapi.SyntheticTest) string {
+		return obj.Label
+	}, false)
+}
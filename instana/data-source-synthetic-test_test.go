@@ -0,0 +1,153 @@
+package instana_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSyntheticTestDataSource(t *testing.T) {
+	unitTest := &dataSourceSyntheticTestUnitTest{}
+	t.Run("integration test read of synthetic test", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read synthetic test by label", unitTest.shouldSuccessfullyReadSyntheticTestByLabel)
+	t.Run("should fail to read synthetic test when type is not supported", unitTest.shouldFailToReadSyntheticTestWhenTypeIsNotSupported)
+}
+
+const dataSourceSyntheticTestDefinitionPath = "data.instana_synthetic_test.example"
+
+const dataSourceSyntheticTestServerResponse = `
+[
+	{
+		"id" : "synthetic-test-id-1",
+		"label" : "synthetic-test-1",
+		"active" : false,
+		"locations" : [ "location-id" ],
+		"playbackMode" : "Simultaneous",
+		"configuration" : { "syntheticType" : "HTTPAction", "url" : "https://example.com/1", "operation" : "GET" }
+	},
+	{
+		"id" : "synthetic-test-id-2",
+		"label" : "synthetic-test-2",
+		"active" : true,
+		"locations" : [ "location-id" ],
+		"testFrequency" : 10,
+		"playbackMode" : "Staggered",
+		"configuration" : { "syntheticType" : "HTTPAction", "url" : "https://example.com/2", "operation" : "POST", "markSyntheticCall" : true },
+		"customProperties" : { "key1" : "val1" }
+	}
+]
+`
+
+type dataSourceSyntheticTestUnitTest struct{}
+
+func (r *dataSourceSyntheticTestUnitTest) integrationTestRead(t *testing.T) {
+	httpServer := createMockHttpServerForDataSource(restapi.SyntheticTestResourcePath, newStringContentResponseProvider(dataSourceSyntheticTestServerResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceSyntheticTestDefinition := `
+data "instana_synthetic_test" "example" {
+  label = "synthetic-test-2"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceSyntheticTestDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceSyntheticTestDefinitionPath, "id", "synthetic-test-id-2"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticTestDefinitionPath, SyntheticTestFieldLabel, "synthetic-test-2"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticTestDefinitionPath, SyntheticTestFieldActive, "true"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticTestDefinitionPath, SyntheticTestFieldTestFrequency, "10"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticTestDefinitionPath, SyntheticTestFieldPlaybackMode, "Staggered"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticTestDefinitionPath, fmt.Sprintf("%s.key1", SyntheticTestFieldCustomProperties), "val1"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticTestDefinitionPath, fmt.Sprintf("%s.0.%s", SyntheticTestFieldConfigHttpAction, SyntheticTestFieldConfigUrl), "https://example.com/2"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticTestDefinitionPath, fmt.Sprintf("%s.0.%s", SyntheticTestFieldConfigHttpAction, SyntheticTestFieldConfigOperation), "POST"),
+					resource.TestCheckResourceAttr(dataSourceSyntheticTestDefinitionPath, fmt.Sprintf("%s.0.%s", SyntheticTestFieldConfigHttpAction, SyntheticTestFieldConfigMarkSyntheticCall), "true"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceSyntheticTestUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewSyntheticTestDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 14)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldLabel)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticTestFieldDescription)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeBool(SyntheticTestFieldActive)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticTestFieldApplicationID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(SyntheticTestFieldPlaybackMode)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(SyntheticTestFieldTestFrequency)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(SyntheticTestFieldConfigHttpAction)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(SyntheticTestFieldConfigHttpScript)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(SyntheticTestFieldConfigBrowserScript)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(SyntheticTestFieldConfigWebpageAction)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(SyntheticTestFieldConfigWebpageScript)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(SyntheticTestFieldConfigDNS)
+}
+
+func (r *dataSourceSyntheticTestUnitTest) shouldSuccessfullyReadSyntheticTestByLabel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		url := "https://example.com"
+		syntheticTests := []*restapi.SyntheticTest{
+			{ID: "synthetic-test-id-1", Label: "synthetic-test-1", Configuration: restapi.SyntheticTestConfig{SyntheticType: SyntheticCheckTypeHttpAction, URL: &url}},
+			{ID: "synthetic-test-id-2", Label: "synthetic-test-2", Active: true, Locations: []string{"location-id"}, Configuration: restapi.SyntheticTestConfig{SyntheticType: SyntheticCheckTypeHttpAction, URL: &url}},
+		}
+
+		syntheticTestAPI := mocks.NewMockRestResource[*restapi.SyntheticTest](ctrl)
+		syntheticTestAPI.EXPECT().GetAll().Times(1).Return(&syntheticTests, nil)
+		mockInstanaApi.EXPECT().SyntheticTest().Return(syntheticTestAPI).Times(1)
+
+		sut := NewSyntheticTestDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			SyntheticTestFieldLabel: "synthetic-test-2",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "synthetic-test-id-2", resourceData.Id())
+		require.Equal(t, "synthetic-test-2", resourceData.Get(SyntheticTestFieldLabel))
+		require.True(t, resourceData.Get(SyntheticTestFieldActive).(bool))
+		require.Equal(t, url, resourceData.Get(fmt.Sprintf("%s.0.%s", SyntheticTestFieldConfigHttpAction, SyntheticTestFieldConfigUrl)))
+	})
+}
+
+func (r *dataSourceSyntheticTestUnitTest) shouldFailToReadSyntheticTestWhenTypeIsNotSupported(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		syntheticTests := []*restapi.SyntheticTest{
+			{ID: "synthetic-test-id", Label: "synthetic-test", Configuration: restapi.SyntheticTestConfig{SyntheticType: "invalid"}},
+		}
+
+		syntheticTestAPI := mocks.NewMockRestResource[*restapi.SyntheticTest](ctrl)
+		syntheticTestAPI.EXPECT().GetAll().Times(1).Return(&syntheticTests, nil)
+		mockInstanaApi.EXPECT().SyntheticTest().Return(syntheticTestAPI).Times(1)
+
+		sut := NewSyntheticTestDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			SyntheticTestFieldLabel: "synthetic-test",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "unsupported synthetic test of type invalid received")
+	})
+}
@@ -0,0 +1,89 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewUserDataSource creates a new DataSource for users
+func NewUserDataSource() DataSource {
+	return &userDataSource{}
+}
+
+const (
+	//UserFieldEmail constant value for the schema field email
+	UserFieldEmail = "email"
+	//UserFieldFullName constant value for the computed schema field full_name
+	UserFieldFullName = "full_name"
+	//DataSourceUser the name of the terraform-provider-instana data source for users
+	DataSourceUser = "instana_user"
+)
+
+type userDataSource struct{}
+
+// CreateResource creates the resource handle for users
+func (ds *userDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			UserFieldEmail: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The email address of the user. The email address is compared case-insensitive",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			UserFieldFullName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full name of the user",
+			},
+		},
+	}
+}
+
+func (ds *userDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	email := d.Get(UserFieldEmail).(string)
+
+	users, err := instanaAPI.Users().GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, err := ds.findUserByEmail(email, *users)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *userDataSource) findUserByEmail(email string, users []*restapi.User) (*restapi.User, error) {
+	for _, u := range users {
+		if strings.EqualFold(u.Email, email) {
+			return u, nil
+		}
+	}
+	return nil, fmt.Errorf("no user found with email %s", email)
+}
+
+func (ds *userDataSource) updateState(d *schema.ResourceData, user *restapi.User) error {
+	d.SetId(user.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		UserFieldEmail:    user.Email,
+		UserFieldFullName: user.FullName,
+	})
+}
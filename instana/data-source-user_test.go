@@ -0,0 +1,139 @@
+package instana_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserDataSource(t *testing.T) {
+	unitTest := &dataSourceUserUnitTest{}
+	t.Run("integration test read of user", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read user by email case-insensitive", unitTest.shouldSuccessfullyReadUserByEmailCaseInsensitive)
+	t.Run("should fail to read user when no user with the email exists", unitTest.shouldFailToReadUserWhenNoUserWithTheEmailExists)
+	t.Run("should fail to read user when api call fails", unitTest.shouldFailToReadUserWhenApiCallFails)
+}
+
+const dataSourceUserDefinitionPath = "data.instana_user.example"
+
+type dataSourceUserUnitTest struct{}
+
+func (r *dataSourceUserUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+[
+	{ "id": "user-id-1", "email": "john.doe@example.com", "fullName": "John Doe", "lastLoggedIn": 1636434847190, "groupCount": 1, "tfaEnabled": false },
+	{ "id": "user-id-2", "email": "jane.doe@example.com", "fullName": "Jane Doe", "lastLoggedIn": 1636434847190, "groupCount": 2, "tfaEnabled": true }
+]
+`
+	httpServer := createMockHttpServerForDataSource(restapi.UsersResourcePath, newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceUserDefinition := `
+data "instana_user" "example" {
+  email = "jane.doe@example.com"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceUserDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceUserDefinitionPath, "id", "user-id-2"),
+					resource.TestCheckResourceAttr(dataSourceUserDefinitionPath, UserFieldEmail, "jane.doe@example.com"),
+					resource.TestCheckResourceAttr(dataSourceUserDefinitionPath, UserFieldFullName, "Jane Doe"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceUserUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewUserDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 2)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(UserFieldEmail)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserFieldFullName)
+}
+
+func (r *dataSourceUserUnitTest) shouldSuccessfullyReadUserByEmailCaseInsensitive(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		users := []*restapi.User{
+			{ID: "user-id-1", Email: "john.doe@example.com", FullName: "John Doe"},
+			{ID: "user-id-2", Email: "jane.doe@example.com", FullName: "Jane Doe"},
+		}
+
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll().Times(1).Return(&users, nil)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+
+		sut := NewUserDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			UserFieldEmail: "Jane.Doe@example.com",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "user-id-2", resourceData.Id())
+		require.Equal(t, "jane.doe@example.com", resourceData.Get(UserFieldEmail))
+		require.Equal(t, "Jane Doe", resourceData.Get(UserFieldFullName))
+	})
+}
+
+func (r *dataSourceUserUnitTest) shouldFailToReadUserWhenNoUserWithTheEmailExists(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		users := []*restapi.User{{ID: "user-id-1", Email: "john.doe@example.com", FullName: "John Doe"}}
+
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll().Times(1).Return(&users, nil)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+
+		sut := NewUserDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			UserFieldEmail: "jane.doe@example.com",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "no user found with email jane.doe@example.com")
+	})
+}
+
+func (r *dataSourceUserUnitTest) shouldFailToReadUserWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+
+		sut := NewUserDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			UserFieldEmail: "jane.doe@example.com",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
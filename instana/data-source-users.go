@@ -0,0 +1,160 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewUsersDataSource creates a new DataSource for multiple users
+func NewUsersDataSource() DataSource {
+	return &usersDataSource{}
+}
+
+const (
+	//UsersFieldEmails constant value for the schema field emails
+	UsersFieldEmails = "emails"
+	//UsersFieldUsers constant value for the computed schema field users
+	UsersFieldUsers = "users"
+	//UsersFieldUserID constant value for the computed schema field users.id
+	UsersFieldUserID = "id"
+	//UsersFieldUserEmail constant value for the computed schema field users.email
+	UsersFieldUserEmail = "email"
+	//UsersFieldUserFullName constant value for the computed schema field users.full_name
+	UsersFieldUserFullName = "full_name"
+	//UsersFieldInvitedEmails constant value for the computed schema field invited_emails
+	UsersFieldInvitedEmails = "invited_emails"
+	//DataSourceUsers the name of the terraform-provider-instana data source for multiple users
+	DataSourceUsers = "instana_users"
+
+	usersDataSourceAllUsersID = "all"
+)
+
+type usersDataSource struct{}
+
+// CreateResource creates the resource handle for multiple users
+func (ds *usersDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			UsersFieldEmails: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Description: "The email addresses of the users which should be resolved. The email addresses are compared case-insensitive. All users are returned when no email address is provided",
+			},
+			UsersFieldUsers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resolved users",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						UsersFieldUserID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the user",
+						},
+						UsersFieldUserEmail: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address of the user",
+						},
+						UsersFieldUserFullName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full name of the user",
+						},
+					},
+				},
+			},
+			UsersFieldInvitedEmails: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The email addresses with a pending invitation which are not yet resolvable to a user",
+			},
+		},
+	}
+}
+
+func (ds *usersDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	emails := ReadStringSetParameterFromResource(d, UsersFieldEmails)
+
+	overview, err := instanaAPI.UsersOverview().Get()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	users, invitedEmails, err := ds.resolveUsers(emails, overview)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, emails, users, invitedEmails)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *usersDataSource) resolveUsers(emails []string, overview *restapi.UsersOverview) ([]*restapi.User, []string, error) {
+	if len(emails) == 0 {
+		invitedEmails := make([]string, len(overview.Invitations))
+		for i, invitation := range overview.Invitations {
+			invitedEmails[i] = invitation.Email
+		}
+		return overview.Users, invitedEmails, nil
+	}
+
+	sort.Strings(emails)
+	users := make([]*restapi.User, 0)
+	invitedEmails := make([]string, 0)
+	unknownEmails := make([]string, 0)
+	for _, email := range emails {
+		if user := overview.FindUserByEmail(email); user != nil {
+			users = append(users, user)
+		} else if invitation := overview.FindInvitationByEmail(email); invitation != nil {
+			invitedEmails = append(invitedEmails, invitation.Email)
+		} else {
+			unknownEmails = append(unknownEmails, email)
+		}
+	}
+	if len(unknownEmails) > 0 {
+		return nil, nil, fmt.Errorf("no user found with email %s", strings.Join(unknownEmails, ", "))
+	}
+	return users, invitedEmails, nil
+}
+
+func (ds *usersDataSource) updateState(d *schema.ResourceData, emails []string, users []*restapi.User, invitedEmails []string) error {
+	usersState := make([]interface{}, len(users))
+	for i, user := range users {
+		usersState[i] = map[string]interface{}{
+			UsersFieldUserID:       user.ID,
+			UsersFieldUserEmail:    user.Email,
+			UsersFieldUserFullName: user.FullName,
+		}
+	}
+
+	id := usersDataSourceAllUsersID
+	if len(emails) > 0 {
+		id = strings.ToLower(strings.Join(emails, ","))
+	}
+	d.SetId(id)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		UsersFieldUsers:         usersState,
+		UsersFieldInvitedEmails: invitedEmails,
+	})
+}
@@ -0,0 +1,182 @@
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsersDataSource(t *testing.T) {
+	unitTest := &dataSourceUsersUnitTest{}
+	t.Run("integration test read of users", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read all users when no email is provided", unitTest.shouldSuccessfullyReadAllUsersWhenNoEmailIsProvided)
+	t.Run("should successfully resolve users and pending invitations by email", unitTest.shouldSuccessfullyResolveUsersAndPendingInvitationsByEmail)
+	t.Run("should fail to read users when email cannot be resolved", unitTest.shouldFailToReadUsersWhenEmailCannotBeResolved)
+	t.Run("should fail to read users when api call fails", unitTest.shouldFailToReadUsersWhenApiCallFails)
+}
+
+const dataSourceUsersDefinitionPath = "data.instana_users.example"
+
+type dataSourceUsersUnitTest struct{}
+
+func (r *dataSourceUsersUnitTest) integrationTestRead(t *testing.T) {
+	serverResponse := `
+{
+	"users": [
+		{ "id": "user-id-1", "email": "john.doe@example.com", "fullName": "John Doe", "lastLoggedIn": 1699313025975 },
+		{ "id": "user-id-2", "email": "jane.doe@example.com", "fullName": "Jane Doe", "lastLoggedIn": 1699313025975 }
+	],
+	"invitations": [
+		{ "id": "invitation-id", "email": "new.hire@example.com", "groupId": "group-id" }
+	]
+}
+`
+	httpServer := createMockHttpServerForDataSource(restapi.UsersOverviewResourcePath, newStringContentResponseProvider(serverResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceUsersDefinition := `
+data "instana_users" "example" {
+  emails = ["jane.doe@example.com", "new.hire@example.com"]
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceUsersDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceUsersDefinitionPath, "id", "jane.doe@example.com,new.hire@example.com"),
+					resource.TestCheckResourceAttr(dataSourceUsersDefinitionPath, fmt.Sprintf("%s.#", UsersFieldUsers), "1"),
+					resource.TestCheckResourceAttr(dataSourceUsersDefinitionPath, fmt.Sprintf("%s.0.%s", UsersFieldUsers, UsersFieldUserID), "user-id-2"),
+					resource.TestCheckResourceAttr(dataSourceUsersDefinitionPath, fmt.Sprintf("%s.0.%s", UsersFieldUsers, UsersFieldUserEmail), "jane.doe@example.com"),
+					resource.TestCheckResourceAttr(dataSourceUsersDefinitionPath, fmt.Sprintf("%s.0.%s", UsersFieldUsers, UsersFieldUserFullName), "Jane Doe"),
+					resource.TestCheckResourceAttr(dataSourceUsersDefinitionPath, fmt.Sprintf("%s.#", UsersFieldInvitedEmails), "1"),
+					resource.TestCheckResourceAttr(dataSourceUsersDefinitionPath, fmt.Sprintf("%s.0", UsersFieldInvitedEmails), "new.hire@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceUsersUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewUsersDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 3)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(UsersFieldEmails)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(UsersFieldUsers)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfStrings(UsersFieldInvitedEmails)
+
+	userSchema := schemaData[UsersFieldUsers].Elem.(*schema.Resource).Schema
+	require.Len(t, userSchema, 3)
+	userSchemaAssert := testutils.NewTerraformSchemaAssert(userSchema, t)
+	userSchemaAssert.AssertSchemaIsComputedAndOfTypeString(UsersFieldUserID)
+	userSchemaAssert.AssertSchemaIsComputedAndOfTypeString(UsersFieldUserEmail)
+	userSchemaAssert.AssertSchemaIsComputedAndOfTypeString(UsersFieldUserFullName)
+}
+
+func (r *dataSourceUsersUnitTest) shouldSuccessfullyReadAllUsersWhenNoEmailIsProvided(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		r.expectUsersOverview(ctrl, mockInstanaApi, r.createUsersOverview(), nil)
+
+		sut := NewUsersDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "all", resourceData.Id())
+		require.Equal(t, []interface{}{
+			map[string]interface{}{UsersFieldUserID: "user-id-1", UsersFieldUserEmail: "john.doe@example.com", UsersFieldUserFullName: "John Doe"},
+			map[string]interface{}{UsersFieldUserID: "user-id-2", UsersFieldUserEmail: "jane.doe@example.com", UsersFieldUserFullName: "Jane Doe"},
+		}, resourceData.Get(UsersFieldUsers))
+		require.Equal(t, []interface{}{"new.hire@example.com"}, resourceData.Get(UsersFieldInvitedEmails))
+	})
+}
+
+func (r *dataSourceUsersUnitTest) shouldSuccessfullyResolveUsersAndPendingInvitationsByEmail(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		r.expectUsersOverview(ctrl, mockInstanaApi, r.createUsersOverview(), nil)
+
+		sut := NewUsersDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			UsersFieldEmails: []interface{}{"New.Hire@example.com", "Jane.Doe@example.com"},
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "jane.doe@example.com,new.hire@example.com", resourceData.Id())
+		require.Equal(t, []interface{}{
+			map[string]interface{}{UsersFieldUserID: "user-id-2", UsersFieldUserEmail: "jane.doe@example.com", UsersFieldUserFullName: "Jane Doe"},
+		}, resourceData.Get(UsersFieldUsers))
+		require.Equal(t, []interface{}{"new.hire@example.com"}, resourceData.Get(UsersFieldInvitedEmails))
+	})
+}
+
+func (r *dataSourceUsersUnitTest) shouldFailToReadUsersWhenEmailCannotBeResolved(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		r.expectUsersOverview(ctrl, mockInstanaApi, r.createUsersOverview(), nil)
+
+		sut := NewUsersDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			UsersFieldEmails: []interface{}{"john.doe@example.com", "unknown@example.com"},
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "no user found with email unknown@example.com")
+	})
+}
+
+func (r *dataSourceUsersUnitTest) shouldFailToReadUsersWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+		r.expectUsersOverview(ctrl, mockInstanaApi, nil, expectedError)
+
+		sut := NewUsersDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
+
+func (r *dataSourceUsersUnitTest) expectUsersOverview(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI, overview *restapi.UsersOverview, err error) {
+	overviewAPI := mocks.NewMockUsersOverviewResource(ctrl)
+	overviewAPI.EXPECT().Get().Times(1).Return(overview, err)
+	mockInstanaApi.EXPECT().UsersOverview().Return(overviewAPI).Times(1)
+}
+
+func (r *dataSourceUsersUnitTest) createUsersOverview() *restapi.UsersOverview {
+	return &restapi.UsersOverview{
+		Users: []*restapi.User{
+			{ID: "user-id-1", Email: "john.doe@example.com", FullName: "John Doe"},
+			{ID: "user-id-2", Email: "jane.doe@example.com", FullName: "Jane Doe"},
+		},
+		Invitations: []*restapi.PendingInvitation{
+			{ID: "invitation-id", Email: "new.hire@example.com", GroupID: "group-id"},
+		},
+	}
+}
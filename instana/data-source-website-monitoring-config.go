@@ -0,0 +1,13 @@
+package instana
+
+import "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+
+// DataSourceWebsiteMonitoringConfig the name of the terraform-provider-instana data source to read website monitoring configs by name
+const DataSourceWebsiteMonitoringConfig = "instana_website_monitoring_config"
+
+// NewWebsiteMonitoringConfigDataSource creates a new DataSource for website monitoring configs
+func NewWebsiteMonitoringConfigDataSource() DataSource {
+	return newResourceLookupDataSource[*restapi.WebsiteMonitoringConfig](NewWebsiteMonitoringConfigResourceHandle(), WebsiteMonitoringConfigFieldName, "website monitoring config", func(obj *restapi.WebsiteMonitoringConfig) string {
+		return obj.Name
+	}, false)
+}
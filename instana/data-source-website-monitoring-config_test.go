@@ -0,0 +1,93 @@
+package instana_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebsiteMonitoringConfigDataSource(t *testing.T) {
+	unitTest := &dataSourceWebsiteMonitoringConfigUnitTest{}
+	t.Run("integration test read of website monitoring config", unitTest.integrationTestRead)
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read website monitoring config by name", unitTest.shouldSuccessfullyReadWebsiteMonitoringConfigByName)
+}
+
+const dataSourceWebsiteMonitoringConfigDefinitionPath = "data.instana_website_monitoring_config.example"
+
+const dataSourceWebsiteMonitoringConfigServerResponse = `
+[
+	{ "id" : "website-id-1", "name" : "website-1", "appName" : "app-name-1" },
+	{ "id" : "website-id-2", "name" : "website-2", "appName" : "app-name-2" }
+]
+`
+
+type dataSourceWebsiteMonitoringConfigUnitTest struct{}
+
+func (r *dataSourceWebsiteMonitoringConfigUnitTest) integrationTestRead(t *testing.T) {
+	httpServer := createMockHttpServerForDataSource(restapi.WebsiteMonitoringConfigResourcePath, newStringContentResponseProvider(dataSourceWebsiteMonitoringConfigServerResponse))
+	httpServer.Start()
+	defer httpServer.Close()
+
+	dataSourceWebsiteMonitoringConfigDefinition := `
+data "instana_website_monitoring_config" "example" {
+  name = "website-2"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(dataSourceWebsiteMonitoringConfigDefinition, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceWebsiteMonitoringConfigDefinitionPath, "id", "website-id-2"),
+					resource.TestCheckResourceAttr(dataSourceWebsiteMonitoringConfigDefinitionPath, WebsiteMonitoringConfigFieldName, "website-2"),
+					resource.TestCheckResourceAttr(dataSourceWebsiteMonitoringConfigDefinitionPath, WebsiteMonitoringConfigFieldAppName, "app-name-2"),
+				),
+			},
+		},
+	})
+}
+
+func (r *dataSourceWebsiteMonitoringConfigUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewWebsiteMonitoringConfigDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 2)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteMonitoringConfigFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(WebsiteMonitoringConfigFieldAppName)
+}
+
+func (r *dataSourceWebsiteMonitoringConfigUnitTest) shouldSuccessfullyReadWebsiteMonitoringConfigByName(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		websiteMonitoringConfigs := []*restapi.WebsiteMonitoringConfig{
+			{ID: "website-id-1", Name: "website-1", AppName: "app-name-1"},
+			{ID: "website-id-2", Name: "website-2", AppName: "app-name-2"},
+		}
+
+		websiteMonitoringConfigAPI := mocks.NewMockRestResource[*restapi.WebsiteMonitoringConfig](ctrl)
+		websiteMonitoringConfigAPI.EXPECT().GetAll().Times(1).Return(&websiteMonitoringConfigs, nil)
+		mockInstanaApi.EXPECT().WebsiteMonitoringConfig().Return(websiteMonitoringConfigAPI).Times(1)
+
+		sut := NewWebsiteMonitoringConfigDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			WebsiteMonitoringConfigFieldName: "website-2",
+		})
+
+		diag := sut.ReadContext(nil, resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "website-id-2", resourceData.Id())
+		require.Equal(t, "website-2", resourceData.Get(WebsiteMonitoringConfigFieldName))
+		require.Equal(t, "app-name-2", resourceData.Get(WebsiteMonitoringConfigFieldAppName))
+	})
+}
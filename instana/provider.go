@@ -74,6 +74,27 @@ func providerResources() map[string]*schema.Resource {
 	bindResourceHandle(resources, NewGroupResourceHandle())
 	bindResourceHandle(resources, NewCustomDashboardResourceHandle())
 	bindResourceHandle(resources, NewSyntheticTestResourceHandle())
+	bindResourceHandle(resources, NewInfraAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewGlobalSyntheticAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewMaintenanceWindowResourceHandle())
+	bindResourceHandle(resources, NewWebsiteIPMaskingConfigResourceHandle())
+	bindResourceHandle(resources, NewWebsiteGeoLocationConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppMonitoringConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppIPMaskingConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppGeoLocationConfigResourceHandle())
+	bindResourceHandle(resources, NewApplicationServiceConfigResourceHandle())
+	bindResourceHandle(resources, NewManualServiceResourceHandle())
+	bindResourceHandle(resources, NewHttpEndpointConfigResourceHandle())
+	bindResourceHandle(resources, NewRBACMappingResourceHandle())
+	bindResourceHandle(resources, NewRBACIdentityProviderConfigResourceHandle())
+	bindResourceHandle(resources, NewSyntheticCredentialResourceHandle())
+	bindResourceHandle(resources, NewApdexConfigResourceHandle())
+	bindResourceHandle(resources, NewReleaseResourceHandle())
+	bindResourceHandle(resources, NewGlobalCustomPayloadConfigResourceHandle())
+	bindResourceHandle(resources, NewBuiltinEventStateResourceHandle())
+	bindResourceHandle(resources, NewAlertConfigVersionRestoreResourceHandle())
+	bindResourceHandle(resources, NewUserInvitationResourceHandle())
 	return resources
 }
 
@@ -95,6 +116,24 @@ func providerDataSources() map[string]*schema.Resource {
 	dataSources := make(map[string]*schema.Resource)
 	dataSources[DataSourceBuiltinEvent] = NewBuiltinEventDataSource().CreateResource()
 	dataSources[DataSourceSyntheticLocation] = NewSyntheticLocationDataSource().CreateResource()
+	dataSources[DataSourceSyntheticLocations] = NewSyntheticLocationsDataSource().CreateResource()
 	dataSources[DataSourceAlertingChannel] = NewAlertingChannelDataSource().CreateResource()
+	dataSources[DataSourceAlertingChannels] = NewAlertingChannelsDataSource().CreateResource()
+	dataSources[DataSourceApdexReport] = NewApdexReportDataSource().CreateResource()
+	dataSources[DataSourceAlertConfigVersions] = NewAlertConfigVersionsDataSource().CreateResource()
+	dataSources[DataSourceUser] = NewUserDataSource().CreateResource()
+	dataSources[DataSourceUsers] = NewUsersDataSource().CreateResource()
+	dataSources[DataSourceApplicationConfig] = NewApplicationConfigDataSource().CreateResource()
+	dataSources[DataSourceWebsiteMonitoringConfig] = NewWebsiteMonitoringConfigDataSource().CreateResource()
+	dataSources[DataSourceGroup] = NewGroupDataSource().CreateResource()
+	dataSources[DataSourceCustomEventSpecification] = NewCustomEventSpecificationDataSource().CreateResource()
+	dataSources[DataSourceSliConfig] = NewSliConfigDataSource().CreateResource()
+	dataSources[DataSourceCustomDashboard] = NewCustomDashboardDataSource().CreateResource()
+	dataSources[DataSourceSyntheticTest] = NewSyntheticTestDataSource().CreateResource()
+	dataSources[DataSourceCatalogTags] = NewCatalogTagsDataSource().CreateResource()
+	dataSources[DataSourceCatalogMetrics] = NewCatalogMetricsDataSource().CreateResource()
+	dataSources[DataSourceApplications] = NewApplicationsDataSource().CreateResource()
+	dataSources[DataSourceServices] = NewServicesDataSource().CreateResource()
+	dataSources[DataSourceEndpoints] = NewEndpointsDataSource().CreateResource()
 	return dataSources
 }
@@ -29,7 +29,7 @@ func TestProviderShouldContainValidSchemaDefinition(t *testing.T) {
 func TestProviderShouldContainValidResourceDefinitions(t *testing.T) {
 	config := Provider()
 
-	assert.Equal(t, 13, len(config.ResourcesMap))
+	assert.Equal(t, 34, len(config.ResourcesMap))
 
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAPIToken])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationConfig])
@@ -44,15 +44,54 @@ func TestProviderShouldContainValidResourceDefinitions(t *testing.T) {
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaCustomEventSpecification])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAlertingChannel])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAlertingConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaInfraAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGlobalSyntheticAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMaintenanceWindow])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteIPMaskingConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteGeoLocationConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppMonitoringConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppIPMaskingConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppGeoLocationConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationServiceConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaManualService])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaHttpEndpointConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaRBACMapping])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaRBACIdentityProviderConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSyntheticCredential])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApdexConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaRelease])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGlobalCustomPayloadConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaBuiltinEventState])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAlertConfigVersionRestore])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaUserInvitation])
 }
 
 func TestProviderShouldContainValidDataSourceDefinitions(t *testing.T) {
 	config := Provider()
 
-	assert.Equal(t, 3, len(config.DataSourcesMap))
+	assert.Equal(t, 21, len(config.DataSourcesMap))
 
 	assert.NotNil(t, config.DataSourcesMap[DataSourceBuiltinEvent])
 	assert.NotNil(t, config.DataSourcesMap[DataSourceSyntheticLocation])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceSyntheticLocations])
 	assert.NotNil(t, config.DataSourcesMap[DataSourceAlertingChannel])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceAlertingChannels])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceApdexReport])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceAlertConfigVersions])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceUser])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceUsers])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceApplicationConfig])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceWebsiteMonitoringConfig])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceGroup])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceCustomEventSpecification])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceSliConfig])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceCustomDashboard])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceSyntheticTest])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceCatalogTags])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceCatalogMetrics])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceApplications])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceServices])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceEndpoints])
 
 }
@@ -0,0 +1,91 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaAlertConfigVersionRestore the name of the terraform-provider-instana resource to restore versions of alert configs
+const ResourceInstanaAlertConfigVersionRestore = "instana_alert_config_version_restore"
+
+const (
+	//AlertConfigVersionRestoreFieldAlertConfigType constant value for the schema field alert_config_type
+	AlertConfigVersionRestoreFieldAlertConfigType = "alert_config_type"
+	//AlertConfigVersionRestoreFieldAlertConfigID constant value for the schema field alert_config_id
+	AlertConfigVersionRestoreFieldAlertConfigID = "alert_config_id"
+	//AlertConfigVersionRestoreFieldRestoreVersion constant value for the schema field restore_version
+	AlertConfigVersionRestoreFieldRestoreVersion = "restore_version"
+)
+
+// NewAlertConfigVersionRestoreResourceHandle creates the resource handle for the restore of versions of alert configs
+func NewAlertConfigVersionRestoreResourceHandle() ResourceHandle[*restapi.AlertConfigVersionRestore] {
+	return &alertConfigVersionRestoreResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaAlertConfigVersionRestore,
+			Schema: map[string]*schema.Schema{
+				AlertConfigVersionRestoreFieldAlertConfigType: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(restapi.SupportedAlertConfigTypes.ToStringSlice(), false),
+					Description:  "The type of the alert config (application, global_application or website)",
+				},
+				AlertConfigVersionRestoreFieldAlertConfigID: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The ID of the alert config",
+				},
+				AlertConfigVersionRestoreFieldRestoreVersion: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The version of the alert config which should be restored. The version is identified by its creation timestamp in milliseconds since epoch",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CreateOnly:       true,
+		},
+	}
+}
+
+type alertConfigVersionRestoreResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *alertConfigVersionRestoreResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *alertConfigVersionRestoreResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *alertConfigVersionRestoreResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.AlertConfigVersionRestore] {
+	return api.AlertConfigVersionRestores()
+}
+
+func (r *alertConfigVersionRestoreResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *alertConfigVersionRestoreResource) UpdateState(d *schema.ResourceData, restore *restapi.AlertConfigVersionRestore) error {
+	d.SetId(restore.GetIDForResourcePath())
+	return tfutils.UpdateState(d, map[string]interface{}{
+		AlertConfigVersionRestoreFieldAlertConfigType: string(restore.AlertConfigType),
+		AlertConfigVersionRestoreFieldAlertConfigID:   restore.AlertConfigID,
+		AlertConfigVersionRestoreFieldRestoreVersion:  restore.Version,
+	})
+}
+
+func (r *alertConfigVersionRestoreResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.AlertConfigVersionRestore, error) {
+	return &restapi.AlertConfigVersionRestore{
+		AlertConfigType: restapi.AlertConfigType(d.Get(AlertConfigVersionRestoreFieldAlertConfigType).(string)),
+		AlertConfigID:   d.Get(AlertConfigVersionRestoreFieldAlertConfigID).(string),
+		Version:         int64(d.Get(AlertConfigVersionRestoreFieldRestoreVersion).(int)),
+	}, nil
+}
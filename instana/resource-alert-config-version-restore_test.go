@@ -0,0 +1,162 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestAlertConfigVersionRestore(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaAlertConfigVersionRestore + ".example"
+	inst := &alertConfigVersionRestoreTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewAlertConfigVersionRestoreResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type alertConfigVersionRestoreTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.AlertConfigVersionRestore]
+}
+
+const alertConfigVersionRestoreAlertConfigID = "alert-config-id"
+
+var alertConfigVersionRestoreTerraformTemplate = `
+resource "instana_alert_config_version_restore" "example" {
+	alert_config_type = "application"
+	alert_config_id   = "alert-config-id"
+	restore_version   = %d
+}
+`
+
+func (test *alertConfigVersionRestoreTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaAlertConfigVersionRestore), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaAlertConfigVersionRestore), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaAlertConfigVersionRestore), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaAlertConfigVersionRestore), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should be create only", ResourceInstanaAlertConfigVersionRestore), test.createTestResourceShouldBeCreateOnly())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaAlertConfigVersionRestore), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaAlertConfigVersionRestore), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *alertConfigVersionRestoreTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		restoredVersions := make([]string, 0)
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, restapi.ApplicationAlertConfigsResourcePath+"/{id}/restore/{created}", func(w http.ResponseWriter, r *http.Request) {
+			restoredVersions = append(restoredVersions, mux.Vars(r)["created"])
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.ApplicationAlertConfigsResourcePath+"/{id}/versions", func(w http.ResponseWriter, r *http.Request) {
+			if mux.Vars(r)["id"] != alertConfigVersionRestoreAlertConfigID {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`[ { "id": "alert-config-id", "created": 1000 }, { "id": "alert-config-id", "created": 2000 } ]`))
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 1000),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, "application:alert-config-id:1000"),
+				test.createIntegrationTestStep(httpServer.GetPort(), 2000),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, "application:alert-config-id:2000"),
+			},
+		})
+
+		require.Equal(t, []string{"1000", "2000"}, restoredVersions)
+	}
+}
+
+func (test *alertConfigVersionRestoreTest) createIntegrationTestStep(httpPort int, version int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(alertConfigVersionRestoreTerraformTemplate, version), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", fmt.Sprintf("application:alert-config-id:%d", version)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, AlertConfigVersionRestoreFieldAlertConfigType, string(restapi.AlertConfigTypeApplication)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, AlertConfigVersionRestoreFieldAlertConfigID, alertConfigVersionRestoreAlertConfigID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, AlertConfigVersionRestoreFieldRestoreVersion, fmt.Sprintf("%d", version)),
+		),
+	}
+}
+
+func (test *alertConfigVersionRestoreTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *alertConfigVersionRestoreTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *alertConfigVersionRestoreTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_alert_config_version_restore", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *alertConfigVersionRestoreTest) createTestResourceShouldBeCreateOnly() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.True(t, test.resourceHandle.MetaData().CreateOnly)
+		require.Nil(t, NewTerraformResource(test.resourceHandle).ToSchemaResource().UpdateContext)
+	}
+}
+
+func (test *alertConfigVersionRestoreTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		restore := &restapi.AlertConfigVersionRestore{
+			AlertConfigType: restapi.AlertConfigTypeWebsite,
+			AlertConfigID:   alertConfigVersionRestoreAlertConfigID,
+			Version:         1000,
+		}
+		testHelper := NewTestHelper[*restapi.AlertConfigVersionRestore](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, restore)
+
+		require.NoError(t, err)
+		require.Equal(t, "website:alert-config-id:1000", resourceData.Id())
+		require.Equal(t, string(restapi.AlertConfigTypeWebsite), resourceData.Get(AlertConfigVersionRestoreFieldAlertConfigType))
+		require.Equal(t, alertConfigVersionRestoreAlertConfigID, resourceData.Get(AlertConfigVersionRestoreFieldAlertConfigID))
+		require.Equal(t, 1000, resourceData.Get(AlertConfigVersionRestoreFieldRestoreVersion))
+	}
+}
+
+func (test *alertConfigVersionRestoreTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.AlertConfigVersionRestore](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, AlertConfigVersionRestoreFieldAlertConfigType, string(restapi.AlertConfigTypeGlobalApplication))
+		setValueOnResourceData(t, resourceData, AlertConfigVersionRestoreFieldAlertConfigID, alertConfigVersionRestoreAlertConfigID)
+		setValueOnResourceData(t, resourceData, AlertConfigVersionRestoreFieldRestoreVersion, 1000)
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.AlertConfigVersionRestore{
+			AlertConfigType: restapi.AlertConfigTypeGlobalApplication,
+			AlertConfigID:   alertConfigVersionRestoreAlertConfigID,
+			Version:         1000,
+		}, result)
+	}
+}
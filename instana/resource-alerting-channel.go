@@ -16,6 +16,8 @@ const (
 
 	//AlertingChannelFieldName constant value for the schema field name
 	AlertingChannelFieldName = "name"
+	//AlertingChannelFieldVerifyOnApply constant value for the schema field verify_on_apply
+	AlertingChannelFieldVerifyOnApply = "verify_on_apply"
 
 	//AlertingChannelFieldChannelEmail const for schema field of the email channel
 	AlertingChannelFieldChannelEmail = "email"
@@ -72,6 +74,45 @@ const (
 	AlertingChannelFieldChannelGoogleChat = "google_chat"
 	//AlertingChannelWebhookBasedFieldWebhookURL const for the webhookUrl field of the alerting channel
 	AlertingChannelWebhookBasedFieldWebhookURL = "webhook_url"
+
+	//AlertingChannelFieldChannelServiceNow const for schema field of the ServiceNow channel
+	AlertingChannelFieldChannelServiceNow = "service_now"
+	//AlertingChannelServiceNowFieldServiceNowURL const for the serviceNowUrl field of the ServiceNow alerting channel
+	AlertingChannelServiceNowFieldServiceNowURL = "service_now_url"
+	//AlertingChannelServiceNowFieldUsername const for the username field of the ServiceNow alerting channel
+	AlertingChannelServiceNowFieldUsername = "username"
+	//AlertingChannelServiceNowFieldPassword const for the password field of the ServiceNow alerting channel
+	AlertingChannelServiceNowFieldPassword = "password"
+
+	//AlertingChannelFieldChannelSalesforce const for schema field of the Salesforce channel
+	AlertingChannelFieldChannelSalesforce = "salesforce"
+	//AlertingChannelSalesforceFieldSalesforceURL const for the salesforceUrl field of the Salesforce alerting channel
+	AlertingChannelSalesforceFieldSalesforceURL = "salesforce_url"
+	//AlertingChannelSalesforceFieldClientID const for the clientId field of the Salesforce alerting channel
+	AlertingChannelSalesforceFieldClientID = "client_id"
+	//AlertingChannelSalesforceFieldClientSecret const for the clientSecret field of the Salesforce alerting channel
+	AlertingChannelSalesforceFieldClientSecret = "client_secret"
+	//AlertingChannelSalesforceFieldBearerToken const for the bearerToken field of the Salesforce alerting channel
+	AlertingChannelSalesforceFieldBearerToken = "bearer_token"
+
+	//AlertingChannelFieldChannelPrometheusWebhook const for schema field of the Prometheus Webhook channel
+	AlertingChannelFieldChannelPrometheusWebhook = "prometheus_webhook"
+	//AlertingChannelPrometheusWebhookFieldReceiver const for the receiver field of the Prometheus Webhook alerting channel
+	AlertingChannelPrometheusWebhookFieldReceiver = "receiver"
+
+	//AlertingChannelFieldChannelWatsonAIOpsWebhook const for schema field of the Watson AIOps Webhook channel
+	AlertingChannelFieldChannelWatsonAIOpsWebhook = "watson_aiops_webhook"
+	//AlertingChannelFieldChannelWebexTeamsWebhook const for schema field of the Webex Teams Webhook channel
+	AlertingChannelFieldChannelWebexTeamsWebhook = "webex_teams_webhook"
+
+	//AlertingChannelFieldChannelZChatOps const for schema field of the ZChatOps channel
+	AlertingChannelFieldChannelZChatOps = "z_chatops"
+	//AlertingChannelZChatOpsFieldIncidentsURL const for the zchatOpsIncidentsUrl field of the ZChatOps alerting channel
+	AlertingChannelZChatOpsFieldIncidentsURL = "incidents_url"
+	//AlertingChannelZChatOpsFieldBearerAuthToken const for the bearerAuthToken field of the ZChatOps alerting channel
+	AlertingChannelZChatOpsFieldBearerAuthToken = "bearer_auth_token"
+	//AlertingChannelZChatOpsFieldChannel const for the channel field of the ZChatOps alerting channel
+	AlertingChannelZChatOpsFieldChannel = "channel"
 )
 
 var AlertingChannelTypeFields = []string{
@@ -84,6 +125,12 @@ var AlertingChannelTypeFields = []string{
 	AlertingChannelFieldChannelWebhook,
 	AlertingChannelFieldChannelOffice365,
 	AlertingChannelFieldChannelGoogleChat,
+	AlertingChannelFieldChannelServiceNow,
+	AlertingChannelFieldChannelSalesforce,
+	AlertingChannelFieldChannelPrometheusWebhook,
+	AlertingChannelFieldChannelWatsonAIOpsWebhook,
+	AlertingChannelFieldChannelWebexTeamsWebhook,
+	AlertingChannelFieldChannelZChatOps,
 }
 
 // NewAlertingChannelResourceHandle creates the resource handle for Alerting Channels
@@ -98,6 +145,12 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 					Required:    true,
 					Description: "Configures the name of the alerting channel",
 				},
+				AlertingChannelFieldVerifyOnApply: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Optional flag to indicate whether a test notification is sent through the alerting channel after create and update. The apply fails when the test notification cannot be delivered. The default is false",
+				},
 				AlertingChannelFieldChannelEmail: {
 					Type:         schema.TypeList,
 					Optional:     true,
@@ -302,6 +355,160 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 						},
 					},
 				},
+				AlertingChannelFieldChannelServiceNow: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the ServiceNow channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelServiceNowFieldServiceNowURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The URL of the ServiceNow instance of the ServiceNow alerting channel",
+							},
+							AlertingChannelServiceNowFieldUsername: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The username of the ServiceNow alerting channel",
+							},
+							AlertingChannelServiceNowFieldPassword: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "The password of the ServiceNow alerting channel. The configured value is kept when the Instana API does not return the password",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelSalesforce: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the Salesforce channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelSalesforceFieldSalesforceURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The URL of the Salesforce instance of the Salesforce alerting channel",
+							},
+							AlertingChannelSalesforceFieldClientID: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The client id of the Salesforce alerting channel",
+							},
+							AlertingChannelSalesforceFieldClientSecret: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "The client secret of the Salesforce alerting channel. The configured value is kept when the Instana API does not return the client secret",
+							},
+							AlertingChannelSalesforceFieldBearerToken: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The optional bearer token of the Salesforce alerting channel. The configured value is kept when the Instana API does not return the bearer token",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelPrometheusWebhook: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the Prometheus Webhook channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelWebhookBasedFieldWebhookURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The webhook URL of the Prometheus Webhook alerting channel",
+							},
+							AlertingChannelPrometheusWebhookFieldReceiver: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The optional receiver of the Prometheus Webhook alerting channel",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelWatsonAIOpsWebhook: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the Watson AIOps Webhook channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelWebhookBasedFieldWebhookURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The webhook URL of the Watson AIOps Webhook alerting channel",
+							},
+							AlertingChannelWebhookFieldHTTPHeaders: {
+								Type: schema.TypeMap,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+								Optional:    true,
+								Description: "The optional map of HTTP headers of the Watson AIOps Webhook alerting channel",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelWebexTeamsWebhook: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the Webex Teams Webhook channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelWebhookBasedFieldWebhookURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The webhook URL of the Webex Teams Webhook alerting channel",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelZChatOps: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the ZChatOps channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelZChatOpsFieldIncidentsURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The ZChatOps incidents URL of the ZChatOps alerting channel",
+							},
+							AlertingChannelZChatOpsFieldBearerAuthToken: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "The bearer authentication token of the ZChatOps alerting channel. The configured value is kept when the Instana API does not return the token",
+							},
+							AlertingChannelZChatOpsFieldChannel: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The optional ZChatOps channel of the ZChatOps alerting channel",
+							},
+						},
+					},
+				},
 			},
 			SchemaVersion: 0,
 		},
@@ -329,16 +536,18 @@ func (r *alertingChannelResource) SetComputedFields(_ *schema.ResourceData) erro
 }
 
 func (r *alertingChannelResource) UpdateState(d *schema.ResourceData, alertingChannel *restapi.AlertingChannel) error {
-	data, err := r.mapChannelToState(alertingChannel)
+	data, err := r.mapChannelToState(d, alertingChannel)
 	if err != nil {
 		return err
 	}
+	//the flag is not provided by the Instana API. Keep the configured value and fall back to the default for imported resources
+	data[AlertingChannelFieldVerifyOnApply] = d.Get(AlertingChannelFieldVerifyOnApply).(bool)
 
 	d.SetId(alertingChannel.ID)
 	return tfutils.UpdateState(d, data)
 }
 
-func (r *alertingChannelResource) mapChannelToState(channel *restapi.AlertingChannel) (map[string]interface{}, error) {
+func (r *alertingChannelResource) mapChannelToState(d *schema.ResourceData, channel *restapi.AlertingChannel) (map[string]interface{}, error) {
 	if channel.Kind == restapi.EmailChannelType {
 		return r.mapEmailChannelToState(channel), nil
 	}
@@ -366,6 +575,24 @@ func (r *alertingChannelResource) mapChannelToState(channel *restapi.AlertingCha
 	if channel.Kind == restapi.GoogleChatChannelType {
 		return r.mapGoogleChatChannelToState(channel), nil
 	}
+	if channel.Kind == restapi.ServiceNowChannelType {
+		return r.mapServiceNowChannelToState(d, channel), nil
+	}
+	if channel.Kind == restapi.SalesforceChannelType {
+		return r.mapSalesforceChannelToState(d, channel), nil
+	}
+	if channel.Kind == restapi.PrometheusWebhookChannelType {
+		return r.mapPrometheusWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WatsonAIOpsWebhookChannelType {
+		return r.mapWatsonAIOpsWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WebexTeamsWebhookChannelType {
+		return r.mapWebexTeamsWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.ZChatOpsChannelType {
+		return r.mapZChatOpsChannelToState(d, channel), nil
+	}
 	return nil, fmt.Errorf("received unsupported alerting channel of type %s", channel.Kind)
 }
 
@@ -499,7 +726,105 @@ func (r *alertingChannelResource) mapGoogleChatChannelToState(channel *restapi.A
 	}
 }
 
+func (r *alertingChannelResource) mapServiceNowChannelToState(d *schema.ResourceData, channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelServiceNow: []interface{}{
+			map[string]interface{}{
+				AlertingChannelServiceNowFieldServiceNowURL: channel.ServiceNowURL,
+				AlertingChannelServiceNowFieldUsername:      channel.Username,
+				AlertingChannelServiceNowFieldPassword:      r.getSecretOrConfiguredValue(d, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldPassword, channel.Password),
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapSalesforceChannelToState(d *schema.ResourceData, channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelSalesforce: []interface{}{
+			map[string]interface{}{
+				AlertingChannelSalesforceFieldSalesforceURL: channel.SalesforceURL,
+				AlertingChannelSalesforceFieldClientID:      channel.ClientID,
+				AlertingChannelSalesforceFieldClientSecret:  r.getSecretOrConfiguredValue(d, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldClientSecret, channel.ClientSecret),
+				AlertingChannelSalesforceFieldBearerToken:   r.getSecretOrConfiguredValue(d, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldBearerToken, channel.BearerToken),
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapPrometheusWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelPrometheusWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL:    channel.WebhookURL,
+				AlertingChannelPrometheusWebhookFieldReceiver: channel.Receiver,
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapWatsonAIOpsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	headers := r.createHTTPHeaderMapFromList(channel.Headers)
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWatsonAIOpsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+				AlertingChannelWebhookFieldHTTPHeaders:     headers,
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapWebexTeamsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWebexTeamsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapZChatOpsChannelToState(d *schema.ResourceData, channel *restapi.AlertingChannel) map[string]interface{} {
+	var zChatOpsChannel *string
+	if len(channel.Channels) > 0 {
+		zChatOpsChannel = &channel.Channels[0]
+	}
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelZChatOps: []interface{}{
+			map[string]interface{}{
+				AlertingChannelZChatOpsFieldIncidentsURL:    channel.ZChatOpsIncidentsURL,
+				AlertingChannelZChatOpsFieldBearerAuthToken: r.getSecretOrConfiguredValue(d, AlertingChannelFieldChannelZChatOps, AlertingChannelZChatOpsFieldBearerAuthToken, channel.BearerAuthToken),
+				AlertingChannelZChatOpsFieldChannel:         zChatOpsChannel,
+			},
+		},
+	}
+}
+
+// getSecretOrConfiguredValue returns the secret provided by the Instana API or the configured value when the secret is not
+// returned by the API. This avoids permanent diffs for secrets which are write-only in Instana.
+func (r *alertingChannelResource) getSecretOrConfiguredValue(d *schema.ResourceData, channelField string, secretField string, secret *string) string {
+	if secret != nil && len(*secret) > 0 {
+		return *secret
+	}
+	return d.Get(fmt.Sprintf("%s.0.%s", channelField, secretField)).(string)
+}
+
 func (r *alertingChannelResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.AlertingChannel, error) {
+	channel, err := r.mapStateToChannelObject(d)
+	if err != nil {
+		return nil, err
+	}
+	channel.VerifyOnApply = d.Get(AlertingChannelFieldVerifyOnApply).(bool)
+	return channel, nil
+}
+
+func (r *alertingChannelResource) mapStateToChannelObject(d *schema.ResourceData) (*restapi.AlertingChannel, error) {
 	if channel, ok := d.GetOk(AlertingChannelFieldChannelEmail); ok && len(channel.([]interface{})) == 1 {
 		return r.mapStateToEmailObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
 	}
@@ -527,6 +852,24 @@ func (r *alertingChannelResource) MapStateToDataObject(d *schema.ResourceData) (
 	if channel, ok := d.GetOk(AlertingChannelFieldChannelGoogleChat); ok && len(channel.([]interface{})) == 1 {
 		return r.mapStateToWebhookBasedObject(restapi.GoogleChatChannelType, d, channel.([]interface{})[0].(map[string]interface{})), nil
 	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelServiceNow); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToServiceNowObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelSalesforce); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToSalesforceObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelPrometheusWebhook); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToPrometheusWebhookObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelWatsonAIOpsWebhook); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToWatsonAIOpsWebhookObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelWebexTeamsWebhook); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToWebhookBasedObject(restapi.WebexTeamsWebhookChannelType, d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelZChatOps); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToZChatOpsObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
 	return nil, fmt.Errorf("no supported alerting channel defined")
 }
 
@@ -638,3 +981,82 @@ func (r *alertingChannelResource) mapStateToWebhookBasedObject(channelType resta
 		WebhookURL: &webhookURL,
 	}
 }
+
+func (r *alertingChannelResource) mapStateToServiceNowObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	serviceNowURL := channelState[AlertingChannelServiceNowFieldServiceNowURL].(string)
+	username := channelState[AlertingChannelServiceNowFieldUsername].(string)
+	password := channelState[AlertingChannelServiceNowFieldPassword].(string)
+	return &restapi.AlertingChannel{
+		ID:            d.Id(),
+		Name:          d.Get(AlertingChannelFieldName).(string),
+		Kind:          restapi.ServiceNowChannelType,
+		ServiceNowURL: &serviceNowURL,
+		Username:      &username,
+		Password:      &password,
+	}
+}
+
+func (r *alertingChannelResource) mapStateToSalesforceObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	salesforceURL := channelState[AlertingChannelSalesforceFieldSalesforceURL].(string)
+	clientID := channelState[AlertingChannelSalesforceFieldClientID].(string)
+	clientSecret := channelState[AlertingChannelSalesforceFieldClientSecret].(string)
+	var bearerToken *string
+	if value, ok := channelState[AlertingChannelSalesforceFieldBearerToken]; ok && len(value.(string)) > 0 {
+		token := value.(string)
+		bearerToken = &token
+	}
+	return &restapi.AlertingChannel{
+		ID:            d.Id(),
+		Name:          d.Get(AlertingChannelFieldName).(string),
+		Kind:          restapi.SalesforceChannelType,
+		SalesforceURL: &salesforceURL,
+		ClientID:      &clientID,
+		ClientSecret:  &clientSecret,
+		BearerToken:   bearerToken,
+	}
+}
+
+func (r *alertingChannelResource) mapStateToPrometheusWebhookObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	webhookURL := channelState[AlertingChannelWebhookBasedFieldWebhookURL].(string)
+	var receiver *string
+	if value, ok := channelState[AlertingChannelPrometheusWebhookFieldReceiver]; ok && len(value.(string)) > 0 {
+		receiverValue := value.(string)
+		receiver = &receiverValue
+	}
+	return &restapi.AlertingChannel{
+		ID:         d.Id(),
+		Name:       d.Get(AlertingChannelFieldName).(string),
+		Kind:       restapi.PrometheusWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Receiver:   receiver,
+	}
+}
+
+func (r *alertingChannelResource) mapStateToWatsonAIOpsWebhookObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	webhookURL := channelState[AlertingChannelWebhookBasedFieldWebhookURL].(string)
+	headers := r.createHTTPHeaderListFromMap(channelState)
+	return &restapi.AlertingChannel{
+		ID:         d.Id(),
+		Name:       d.Get(AlertingChannelFieldName).(string),
+		Kind:       restapi.WatsonAIOpsWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Headers:    headers,
+	}
+}
+
+func (r *alertingChannelResource) mapStateToZChatOpsObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	incidentsURL := channelState[AlertingChannelZChatOpsFieldIncidentsURL].(string)
+	bearerAuthToken := channelState[AlertingChannelZChatOpsFieldBearerAuthToken].(string)
+	channels := make([]string, 0)
+	if value, ok := channelState[AlertingChannelZChatOpsFieldChannel]; ok && len(value.(string)) > 0 {
+		channels = append(channels, value.(string))
+	}
+	return &restapi.AlertingChannel{
+		ID:                   d.Id(),
+		Name:                 d.Get(AlertingChannelFieldName).(string),
+		Kind:                 restapi.ZChatOpsChannelType,
+		ZChatOpsIncidentsURL: &incidentsURL,
+		BearerAuthToken:      &bearerAuthToken,
+		Channels:             channels,
+	}
+}
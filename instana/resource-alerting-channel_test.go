@@ -3,7 +3,11 @@ package instana_test
 import (
 	"fmt"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gorilla/mux"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +28,14 @@ func TestAlertingChannelResource(t *testing.T) {
 	t.Run("CRUD integration test of with Webhook Channel", alertingChannelWebhookIntegrationTest().testCrud)
 	t.Run("CRUD integration test of with Office 365 Channel", alertingChannelOffice365IntegrationTest().testCrud)
 	t.Run("CRUD integration test of with Google Chat Channel", alertingChannelGoogleChatIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with ServiceNow Channel", alertingChannelServiceNowIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with Salesforce Channel", alertingChannelSalesforceIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with Prometheus Webhook Channel", alertingChannelPrometheusWebhookIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with Watson AIOps Webhook Channel", alertingChannelWatsonAIOpsWebhookIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with Webex Teams Webhook Channel", alertingChannelWebexTeamsWebhookIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with ZChatOps Channel", alertingChannelZChatOpsIntegrationTest().testCrud)
+	t.Run("CRUD integration test with verification on apply", alertingChannelVerifyOnApplyIntegrationTest)
+	t.Run("integration test of failing verification on apply", alertingChannelFailingVerifyOnApplyIntegrationTest)
 	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
 	t.Run("should have schema version 0", unitTest.shouldHaveSchemaVersion0)
 	t.Run("should have no state upgrader", unitTest.shouldHaveNoStateUpgraders)
@@ -37,6 +49,13 @@ func TestAlertingChannelResource(t *testing.T) {
 	t.Run("should map Webhook channel to state", unitTest.shouldMapWebhookChannelToState)
 	t.Run("should map Office 365 channel to state", unitTest.shouldMapOffice365ChannelToState)
 	t.Run("should map Google Chat channel to state", unitTest.shouldMapGoogleChatChannelToState)
+	t.Run("should map ServiceNow channel to state", unitTest.shouldMapServiceNowChannelToState)
+	t.Run("should keep configured password of ServiceNow channel when not provided by API", unitTest.shouldKeepConfiguredPasswordOfServiceNowChannelWhenNotProvidedByAPI)
+	t.Run("should map Salesforce channel to state", unitTest.shouldMapSalesforceChannelToState)
+	t.Run("should map Prometheus Webhook channel to state", unitTest.shouldMapPrometheusWebhookChannelToState)
+	t.Run("should map Watson AIOps Webhook channel to state", unitTest.shouldMapWatsonAIOpsWebhookChannelToState)
+	t.Run("should map Webex Teams Webhook channel to state", unitTest.shouldMapWebexTeamsWebhookChannelToState)
+	t.Run("should map ZChatOps channel to state", unitTest.shouldMapZChatOpsChannelToState)
 	t.Run("should fail to map when channel type is not valid", unitTest.shouldFailToMapChannelWhenTypeIsNotValid)
 	t.Run("should map state of Email channel to data model", unitTest.shouldMapStateOfEmailChannelToDataModel)
 	t.Run("should map state of OpsGenie channel to data model", unitTest.shouldMapStateOfOpsGenieChannelToDataModel)
@@ -48,7 +67,15 @@ func TestAlertingChannelResource(t *testing.T) {
 	t.Run("should map state of Webhook channel with headers to data model", unitTest.shouldMapStateOfWebhookChannelWithHeadersToDataModel)
 	t.Run("should map state of Office 365 channel to data model", unitTest.shouldMapStateOfOffice365ChannelToDataModel)
 	t.Run("should map state of Google Chat channel to data model", unitTest.shouldMapStateOfGoogleChatChannelToDataModel)
+	t.Run("should map state of ServiceNow channel to data model", unitTest.shouldMapStateOfServiceNowChannelToDataModel)
+	t.Run("should map state of Salesforce channel to data model", unitTest.shouldMapStateOfSalesforceChannelToDataModel)
+	t.Run("should map state of Salesforce channel without bearer token to data model", unitTest.shouldMapStateOfSalesforceChannelWithoutBearerTokenToDataModel)
+	t.Run("should map state of Prometheus Webhook channel to data model", unitTest.shouldMapStateOfPrometheusWebhookChannelToDataModel)
+	t.Run("should map state of Watson AIOps Webhook channel to data model", unitTest.shouldMapStateOfWatsonAIOpsWebhookChannelToDataModel)
+	t.Run("should map state of Webex Teams Webhook channel to data model", unitTest.shouldMapStateOfWebexTeamsWebhookChannelToDataModel)
+	t.Run("should map state of ZChatOps channel to data model", unitTest.shouldMapStateOfZChatOpsChannelToDataModel)
 	t.Run("should fail to map state when no channel is provided", unitTest.shouldFailToMapStateWhenNoChannelIsProvided)
+	t.Run("should map state with verify on apply to data model", unitTest.shouldMapStateWithVerifyOnApplyToDataModel)
 }
 
 const (
@@ -325,6 +352,299 @@ resource "instana_alerting_channel" "example" {
 	)
 }
 
+func alertingChannelServiceNowIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  service_now {
+    service_now_url = "service-now-url"
+    username        = "username"
+    password        = "password"
+  }
+}`
+
+	//the password is not returned by the API and therefore the configured value is expected to be kept in the state
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "SERVICE_NOW_WEBHOOK",
+	"serviceNowUrl": "service-now-url",
+	"username": "username"
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldServiceNowURL), "service-now-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldUsername), "username"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldPassword), "password"),
+		},
+	)
+}
+
+func alertingChannelSalesforceIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  salesforce {
+    salesforce_url = "salesforce-url"
+    client_id      = "client-id"
+    client_secret  = "client-secret"
+    bearer_token   = "bearer-token"
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "SALESFORCE",
+	"salesforceUrl": "salesforce-url",
+	"clientId": "client-id",
+	"clientSecret": "client-secret",
+	"bearerToken": "bearer-token"
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldSalesforceURL), "salesforce-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldClientID), "client-id"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldClientSecret), "client-secret"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSalesforce, AlertingChannelSalesforceFieldBearerToken), "bearer-token"),
+		},
+	)
+}
+
+func alertingChannelPrometheusWebhookIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  prometheus_webhook {
+    webhook_url = "webhook-url"
+    receiver    = "receiver"
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "PROMETHEUS_WEBHOOK",
+	"webhookUrl": "webhook-url",
+	"receiver": "receiver"
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelPrometheusWebhookFieldReceiver), "receiver"),
+		},
+	)
+}
+
+func alertingChannelWatsonAIOpsWebhookIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  watson_aiops_webhook {
+    webhook_url  = "webhook-url"
+    http_headers = {
+      key1 = "value1"
+    }
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "WATSON_AIOPS_WEBHOOK",
+	"webhookUrl": "webhook-url",
+	"headers": [ "key1: value1" ]
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookFieldHTTPHeaders), "key1"), "value1"),
+		},
+	)
+}
+
+func alertingChannelWebexTeamsWebhookIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  webex_teams_webhook {
+    webhook_url = "webhook-url"
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "WEBEX_TEAMS_WEBHOOK",
+	"webhookUrl": "webhook-url"
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebexTeamsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url"),
+		},
+	)
+}
+
+func alertingChannelZChatOpsIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  z_chatops {
+    incidents_url     = "incidents-url"
+    bearer_auth_token = "bearer-auth-token"
+    channel           = "channel"
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "Z_CHATOPS",
+	"zchatOpsIncidentsUrl": "incidents-url",
+	"bearerAuthToken": "bearer-auth-token",
+	"channels": [ "channel" ]
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelZChatOps, AlertingChannelZChatOpsFieldIncidentsURL), "incidents-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelZChatOps, AlertingChannelZChatOpsFieldBearerAuthToken), "bearer-auth-token"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelZChatOps, AlertingChannelZChatOpsFieldChannel), "channel"),
+		},
+	)
+}
+
+const alertingChannelVerifyOnApplyResourceTemplate = `
+resource "instana_alerting_channel" "example" {
+  name            = "name %d"
+  verify_on_apply = true
+  email {
+    emails = [ "EMAIL1" ]
+  }
+}`
+
+const alertingChannelVerifyOnApplyServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "EMAIL",
+	"emails": [ "EMAIL1" ]
+}`
+
+func createMockHttpServerForAlertingChannelVerification(testHandler http.HandlerFunc) (testutils.TestHTTPServer, map[string]bool) {
+	existingChannels := make(map[string]bool)
+	httpServer := testutils.NewTestHTTPServer()
+	//the test route must be registered before the routes of the alerting channels as the path would match the id path parameter
+	httpServer.AddRoute(http.MethodPut, restapi.AlertingChannelsTestResourcePath, testHandler)
+	responseHandler := func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+		callCount := getZeroBasedCallCount(httpServer, http.MethodPut, restapi.AlertingChannelsResourcePath+"/"+id)
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(formatResponseTemplate(alertingChannelVerifyOnApplyServerResponseTemplate, id, callCount)))
+	}
+	httpServer.AddRoute(http.MethodPut, restapi.AlertingChannelsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		existingChannels[mux.Vars(r)["id"]] = true
+		responseHandler(w, r)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.AlertingChannelsResourcePath+"/{id}", responseHandler)
+	httpServer.AddRoute(http.MethodDelete, restapi.AlertingChannelsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		delete(existingChannels, mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httpServer, existingChannels
+}
+
+func alertingChannelVerifyOnApplyIntegrationTest(t *testing.T) {
+	httpServer, _ := createMockHttpServerForAlertingChannelVerification(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(fmt.Sprintf(alertingChannelVerifyOnApplyResourceTemplate, 0), httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alertingChannelTestResourceName, AlertingChannelFieldVerifyOnApply, trueAsString),
+					func(_ *terraform.State) error {
+						return requireCallCount(httpServer, restapi.AlertingChannelsTestResourcePath, 1)
+					},
+				),
+			},
+			{
+				Config: appendProviderConfig(fmt.Sprintf(alertingChannelVerifyOnApplyResourceTemplate, 1), httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alertingChannelTestResourceName, AlertingChannelFieldName, formatResourceName(1)),
+					func(_ *terraform.State) error {
+						return requireCallCount(httpServer, restapi.AlertingChannelsTestResourcePath, 2)
+					},
+				),
+			},
+		},
+	})
+}
+
+func alertingChannelFailingVerifyOnApplyIntegrationTest(t *testing.T) {
+	httpServer, existingChannels := createMockHttpServerForAlertingChannelVerification(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid email address"))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      appendProviderConfig(fmt.Sprintf(alertingChannelVerifyOnApplyResourceTemplate, 0), httpServer.GetPort()),
+				ExpectError: regexp.MustCompile("verification of alerting channel name 0 failed(.|\\n)*invalid email address"),
+			},
+		},
+	})
+
+	require.Empty(t, existingChannels)
+}
+
+func requireCallCount(httpServer testutils.TestHTTPServer, path string, expectedCallCount int) error {
+	callCount := httpServer.GetCallCount(http.MethodPut, path)
+	if callCount != expectedCallCount {
+		return fmt.Errorf("expected %d calls of %s but got %d", expectedCallCount, path, callCount)
+	}
+	return nil
+}
+
 func newAlertingChannelIntegrationTest(resourceTemplate string, resourceName string, serverResponseTemplate string, useCaseSpecificChecks []resource.TestCheckFunc) *alertingChannelIntegrationTest {
 	return &alertingChannelIntegrationTest{
 		resourceTemplate:       resourceTemplate,
@@ -378,8 +698,9 @@ func (r *alertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaData := NewAlertingChannelResourceHandle().MetaData().Schema
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
-	require.Len(t, schemaData, 10)
+	require.Len(t, schemaData, 17)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelFieldVerifyOnApply, false)
 
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelEmail)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelOpsGenie)
@@ -390,6 +711,12 @@ func (r *alertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelWebhook)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelOffice365)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelGoogleChat)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelServiceNow)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelSalesforce)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelPrometheusWebhook)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelWatsonAIOpsWebhook)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelWebexTeamsWebhook)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelZChatOps)
 
 	r.validateEmailChannelSchema(t, schemaData[AlertingChannelFieldChannelEmail].Elem.(*schema.Resource).Schema)
 	r.validateOpsGenieChannelSchema(t, schemaData[AlertingChannelFieldChannelOpsGenie].Elem.(*schema.Resource).Schema)
@@ -400,6 +727,12 @@ func (r *alertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	r.validateWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWebhook].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelOffice365].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelGoogleChat].Elem.(*schema.Resource).Schema)
+	r.validateServiceNowChannelSchema(t, schemaData[AlertingChannelFieldChannelServiceNow].Elem.(*schema.Resource).Schema)
+	r.validateSalesforceChannelSchema(t, schemaData[AlertingChannelFieldChannelSalesforce].Elem.(*schema.Resource).Schema)
+	r.validatePrometheusWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelPrometheusWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWatsonAIOpsWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWatsonAIOpsWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelWebexTeamsWebhook].Elem.(*schema.Resource).Schema)
+	r.validateZChatOpsChannelSchema(t, schemaData[AlertingChannelFieldChannelZChatOps].Elem.(*schema.Resource).Schema)
 }
 
 func (r *alertingChannelUnitTest) validateEmailChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
@@ -457,6 +790,49 @@ func (r *alertingChannelUnitTest) validateWebhookBasedChannelSchema(t *testing.T
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
 }
 
+func (r *alertingChannelUnitTest) validateServiceNowChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelServiceNowFieldServiceNowURL)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelServiceNowFieldUsername)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelServiceNowFieldPassword)
+	require.True(t, channelSchema[AlertingChannelServiceNowFieldPassword].Sensitive)
+}
+
+func (r *alertingChannelUnitTest) validateSalesforceChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 4)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelSalesforceFieldSalesforceURL)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelSalesforceFieldClientID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelSalesforceFieldClientSecret)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelSalesforceFieldBearerToken)
+	require.True(t, channelSchema[AlertingChannelSalesforceFieldClientSecret].Sensitive)
+	require.True(t, channelSchema[AlertingChannelSalesforceFieldBearerToken].Sensitive)
+}
+
+func (r *alertingChannelUnitTest) validatePrometheusWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelPrometheusWebhookFieldReceiver)
+}
+
+func (r *alertingChannelUnitTest) validateWatsonAIOpsWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeMapOfStrings(AlertingChannelWebhookFieldHTTPHeaders)
+}
+
+func (r *alertingChannelUnitTest) validateZChatOpsChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelZChatOpsFieldIncidentsURL)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelZChatOpsFieldBearerAuthToken)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelZChatOpsFieldChannel)
+	require.True(t, channelSchema[AlertingChannelZChatOpsFieldBearerAuthToken].Sensitive)
+}
+
 func (r *alertingChannelUnitTest) shouldHaveSchemaVersion0(t *testing.T) {
 	require.Equal(t, 0, NewAlertingChannelResourceHandle().MetaData().SchemaVersion)
 }
@@ -760,6 +1136,168 @@ func (r *alertingChannelUnitTest) shouldMapGoogleChatChannelToState(t *testing.T
 	require.Equal(t, webhookURL, channel[AlertingChannelWebhookBasedFieldWebhookURL])
 }
 
+func (r *alertingChannelUnitTest) shouldMapServiceNowChannelToState(t *testing.T) {
+	serviceNowURL := "serviceNowUrl"
+	username := "username"
+	password := "password"
+	data := restapi.AlertingChannel{
+		ID:            "id",
+		Name:          resourceName,
+		Kind:          restapi.ServiceNowChannelType,
+		ServiceNowURL: &serviceNowURL,
+		Username:      &username,
+		Password:      &password,
+	}
+
+	channel := r.mapChannelToStateAndGetChannelState(t, &data, AlertingChannelFieldChannelServiceNow)
+
+	require.Len(t, channel, 3)
+	require.Equal(t, serviceNowURL, channel[AlertingChannelServiceNowFieldServiceNowURL])
+	require.Equal(t, username, channel[AlertingChannelServiceNowFieldUsername])
+	require.Equal(t, password, channel[AlertingChannelServiceNowFieldPassword])
+}
+
+func (r *alertingChannelUnitTest) shouldKeepConfiguredPasswordOfServiceNowChannelWhenNotProvidedByAPI(t *testing.T) {
+	serviceNowURL := "serviceNowUrl"
+	username := "username"
+	data := restapi.AlertingChannel{
+		ID:            "id",
+		Name:          resourceName,
+		Kind:          restapi.ServiceNowChannelType,
+		ServiceNowURL: &serviceNowURL,
+		Username:      &username,
+	}
+
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	sut := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelServiceNow, []interface{}{
+		map[string]interface{}{
+			AlertingChannelServiceNowFieldServiceNowURL: serviceNowURL,
+			AlertingChannelServiceNowFieldUsername:      username,
+			AlertingChannelServiceNowFieldPassword:      "configured-password",
+		},
+	})
+
+	err := sut.UpdateState(resourceData, &data)
+
+	require.Nil(t, err)
+	channel := resourceData.Get(AlertingChannelFieldChannelServiceNow).([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "configured-password", channel[AlertingChannelServiceNowFieldPassword])
+}
+
+func (r *alertingChannelUnitTest) shouldMapSalesforceChannelToState(t *testing.T) {
+	salesforceURL := "salesforceUrl"
+	clientID := "clientId"
+	clientSecret := "clientSecret"
+	bearerToken := "bearerToken"
+	data := restapi.AlertingChannel{
+		ID:            "id",
+		Name:          resourceName,
+		Kind:          restapi.SalesforceChannelType,
+		SalesforceURL: &salesforceURL,
+		ClientID:      &clientID,
+		ClientSecret:  &clientSecret,
+		BearerToken:   &bearerToken,
+	}
+
+	channel := r.mapChannelToStateAndGetChannelState(t, &data, AlertingChannelFieldChannelSalesforce)
+
+	require.Len(t, channel, 4)
+	require.Equal(t, salesforceURL, channel[AlertingChannelSalesforceFieldSalesforceURL])
+	require.Equal(t, clientID, channel[AlertingChannelSalesforceFieldClientID])
+	require.Equal(t, clientSecret, channel[AlertingChannelSalesforceFieldClientSecret])
+	require.Equal(t, bearerToken, channel[AlertingChannelSalesforceFieldBearerToken])
+}
+
+func (r *alertingChannelUnitTest) shouldMapPrometheusWebhookChannelToState(t *testing.T) {
+	webhookURL := "webhookUrl"
+	receiver := "receiver"
+	data := restapi.AlertingChannel{
+		ID:         "id",
+		Name:       resourceName,
+		Kind:       restapi.PrometheusWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Receiver:   &receiver,
+	}
+
+	channel := r.mapChannelToStateAndGetChannelState(t, &data, AlertingChannelFieldChannelPrometheusWebhook)
+
+	require.Len(t, channel, 2)
+	require.Equal(t, webhookURL, channel[AlertingChannelWebhookBasedFieldWebhookURL])
+	require.Equal(t, receiver, channel[AlertingChannelPrometheusWebhookFieldReceiver])
+}
+
+func (r *alertingChannelUnitTest) shouldMapWatsonAIOpsWebhookChannelToState(t *testing.T) {
+	webhookURL := "webhookUrl"
+	data := restapi.AlertingChannel{
+		ID:         "id",
+		Name:       resourceName,
+		Kind:       restapi.WatsonAIOpsWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Headers:    []string{"key1: value1", "key2"},
+	}
+
+	channel := r.mapChannelToStateAndGetChannelState(t, &data, AlertingChannelFieldChannelWatsonAIOpsWebhook)
+
+	require.Len(t, channel, 2)
+	require.Equal(t, webhookURL, channel[AlertingChannelWebhookBasedFieldWebhookURL])
+	require.Equal(t, map[string]interface{}{
+		"key1": "value1",
+		"key2": "",
+	}, channel[AlertingChannelWebhookFieldHTTPHeaders])
+}
+
+func (r *alertingChannelUnitTest) shouldMapWebexTeamsWebhookChannelToState(t *testing.T) {
+	webhookURL := "webhookUrl"
+	data := restapi.AlertingChannel{
+		ID:         "id",
+		Name:       resourceName,
+		Kind:       restapi.WebexTeamsWebhookChannelType,
+		WebhookURL: &webhookURL,
+	}
+
+	channel := r.mapChannelToStateAndGetChannelState(t, &data, AlertingChannelFieldChannelWebexTeamsWebhook)
+
+	require.Len(t, channel, 1)
+	require.Equal(t, webhookURL, channel[AlertingChannelWebhookBasedFieldWebhookURL])
+}
+
+func (r *alertingChannelUnitTest) shouldMapZChatOpsChannelToState(t *testing.T) {
+	incidentsURL := "incidentsUrl"
+	bearerAuthToken := "bearerAuthToken"
+	data := restapi.AlertingChannel{
+		ID:                   "id",
+		Name:                 resourceName,
+		Kind:                 restapi.ZChatOpsChannelType,
+		ZChatOpsIncidentsURL: &incidentsURL,
+		BearerAuthToken:      &bearerAuthToken,
+		Channels:             []string{"channel"},
+	}
+
+	channel := r.mapChannelToStateAndGetChannelState(t, &data, AlertingChannelFieldChannelZChatOps)
+
+	require.Len(t, channel, 3)
+	require.Equal(t, incidentsURL, channel[AlertingChannelZChatOpsFieldIncidentsURL])
+	require.Equal(t, bearerAuthToken, channel[AlertingChannelZChatOpsFieldBearerAuthToken])
+	require.Equal(t, "channel", channel[AlertingChannelZChatOpsFieldChannel])
+}
+
+func (r *alertingChannelUnitTest) mapChannelToStateAndGetChannelState(t *testing.T, data *restapi.AlertingChannel, expectedChannel string) map[string]interface{} {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	sut := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, data)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(AlertingChannelFieldName))
+	r.verifyChannelIsMappedToResource(t, resourceData, expectedChannel)
+
+	return resourceData.Get(expectedChannel).([]interface{})[0].(map[string]interface{})
+}
+
 func (r *alertingChannelUnitTest) verifyChannelIsMappedToResource(t *testing.T, d *schema.ResourceData, expectedChannel string) {
 	for _, k := range AlertingChannelTypeFields {
 		require.IsType(t, []interface{}{}, d.Get(k))
@@ -821,6 +1359,29 @@ func (r *alertingChannelUnitTest) shouldMapStateOfEmailChannelToDataModel(t *tes
 	require.Len(t, result.Emails, 2)
 	require.Contains(t, result.Emails, "email1")
 	require.Contains(t, result.Emails, "email2")
+	require.False(t, result.VerifyOnApply)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateWithVerifyOnApplyToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldVerifyOnApply, true)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelEmail, []interface{}{
+		map[string]interface{}{
+			AlertingChannelEmailFieldEmails: []string{"email1"},
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, restapi.EmailChannelType, result.Kind)
+	require.True(t, result.VerifyOnApply)
 }
 
 func (r *alertingChannelUnitTest) shouldMapStateOfOpsGenieChannelToDataModel(t *testing.T) {
@@ -1136,6 +1697,107 @@ func (r *alertingChannelUnitTest) shouldMapStateOfGoogleChatChannelToDataModel(t
 	require.Equal(t, webhookURL, *result.WebhookURL)
 }
 
+func (r *alertingChannelUnitTest) shouldMapStateOfServiceNowChannelToDataModel(t *testing.T) {
+	result := r.mapChannelStateToDataModel(t, AlertingChannelFieldChannelServiceNow, map[string]interface{}{
+		AlertingChannelServiceNowFieldServiceNowURL: "service-now-url",
+		AlertingChannelServiceNowFieldUsername:      "username",
+		AlertingChannelServiceNowFieldPassword:      "password",
+	})
+
+	require.Equal(t, restapi.ServiceNowChannelType, result.Kind)
+	require.Equal(t, "service-now-url", *result.ServiceNowURL)
+	require.Equal(t, "username", *result.Username)
+	require.Equal(t, "password", *result.Password)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfSalesforceChannelToDataModel(t *testing.T) {
+	result := r.mapChannelStateToDataModel(t, AlertingChannelFieldChannelSalesforce, map[string]interface{}{
+		AlertingChannelSalesforceFieldSalesforceURL: "salesforce-url",
+		AlertingChannelSalesforceFieldClientID:      "client-id",
+		AlertingChannelSalesforceFieldClientSecret:  "client-secret",
+		AlertingChannelSalesforceFieldBearerToken:   "bearer-token",
+	})
+
+	require.Equal(t, restapi.SalesforceChannelType, result.Kind)
+	require.Equal(t, "salesforce-url", *result.SalesforceURL)
+	require.Equal(t, "client-id", *result.ClientID)
+	require.Equal(t, "client-secret", *result.ClientSecret)
+	require.Equal(t, "bearer-token", *result.BearerToken)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfSalesforceChannelWithoutBearerTokenToDataModel(t *testing.T) {
+	result := r.mapChannelStateToDataModel(t, AlertingChannelFieldChannelSalesforce, map[string]interface{}{
+		AlertingChannelSalesforceFieldSalesforceURL: "salesforce-url",
+		AlertingChannelSalesforceFieldClientID:      "client-id",
+		AlertingChannelSalesforceFieldClientSecret:  "client-secret",
+	})
+
+	require.Equal(t, restapi.SalesforceChannelType, result.Kind)
+	require.Nil(t, result.BearerToken)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfPrometheusWebhookChannelToDataModel(t *testing.T) {
+	result := r.mapChannelStateToDataModel(t, AlertingChannelFieldChannelPrometheusWebhook, map[string]interface{}{
+		AlertingChannelWebhookBasedFieldWebhookURL:    "webhook-url",
+		AlertingChannelPrometheusWebhookFieldReceiver: "receiver",
+	})
+
+	require.Equal(t, restapi.PrometheusWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+	require.Equal(t, "receiver", *result.Receiver)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfWatsonAIOpsWebhookChannelToDataModel(t *testing.T) {
+	result := r.mapChannelStateToDataModel(t, AlertingChannelFieldChannelWatsonAIOpsWebhook, map[string]interface{}{
+		AlertingChannelWebhookBasedFieldWebhookURL: "webhook-url",
+		AlertingChannelWebhookFieldHTTPHeaders:     map[string]interface{}{"key1": "value1"},
+	})
+
+	require.Equal(t, restapi.WatsonAIOpsWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+	require.Equal(t, []string{"key1: value1"}, result.Headers)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfWebexTeamsWebhookChannelToDataModel(t *testing.T) {
+	result := r.mapChannelStateToDataModel(t, AlertingChannelFieldChannelWebexTeamsWebhook, map[string]interface{}{
+		AlertingChannelWebhookBasedFieldWebhookURL: "webhook-url",
+	})
+
+	require.Equal(t, restapi.WebexTeamsWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfZChatOpsChannelToDataModel(t *testing.T) {
+	result := r.mapChannelStateToDataModel(t, AlertingChannelFieldChannelZChatOps, map[string]interface{}{
+		AlertingChannelZChatOpsFieldIncidentsURL:    "incidents-url",
+		AlertingChannelZChatOpsFieldBearerAuthToken: "bearer-auth-token",
+		AlertingChannelZChatOpsFieldChannel:         "channel",
+	})
+
+	require.Equal(t, restapi.ZChatOpsChannelType, result.Kind)
+	require.Equal(t, "incidents-url", *result.ZChatOpsIncidentsURL)
+	require.Equal(t, "bearer-auth-token", *result.BearerAuthToken)
+	require.Equal(t, []string{"channel"}, result.Channels)
+}
+
+func (r *alertingChannelUnitTest) mapChannelStateToDataModel(t *testing.T, channelField string, channelState map[string]interface{}) *restapi.AlertingChannel {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, channelField, []interface{}{channelState})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", result.GetIDForResourcePath())
+	require.Equal(t, resourceName, result.Name)
+	return result
+}
+
 func (r *alertingChannelUnitTest) shouldFailToMapStateWhenNoChannelIsProvided(t *testing.T) {
 	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
 	resourceHandle := NewAlertingChannelResourceHandle()
@@ -0,0 +1,288 @@
+package instana
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaApdexConfig the name of the terraform-provider-instana resource to manage apdex configurations
+const ResourceInstanaApdexConfig = "instana_apdex_config"
+
+const (
+	//ApdexConfigFieldName constant value for the schema field name
+	ApdexConfigFieldName = "name"
+	//ApdexConfigFieldThreshold constant value for the schema field threshold
+	ApdexConfigFieldThreshold = "threshold"
+	//ApdexConfigFieldApdexEntity constant value for the schema field apdex_entity
+	ApdexConfigFieldApdexEntity = "apdex_entity"
+	//ApdexConfigFieldApdexEntityApplication constant value for the schema field apdex_entity.application
+	ApdexConfigFieldApdexEntityApplication = "application"
+	//ApdexConfigFieldApdexEntityWebsite constant value for the schema field apdex_entity.website
+	ApdexConfigFieldApdexEntityWebsite = "website"
+	//ApdexConfigFieldApplicationID constant value for the schema field apdex_entity.application.application_id
+	ApdexConfigFieldApplicationID = "application_id"
+	//ApdexConfigFieldBoundaryScope constant value for the schema field apdex_entity.application.boundary_scope
+	ApdexConfigFieldBoundaryScope = "boundary_scope"
+	//ApdexConfigFieldIncludeInternal constant value for the schema field apdex_entity.application.include_internal
+	ApdexConfigFieldIncludeInternal = "include_internal"
+	//ApdexConfigFieldIncludeSynthetic constant value for the schema field apdex_entity.application.include_synthetic
+	ApdexConfigFieldIncludeSynthetic = "include_synthetic"
+	//ApdexConfigFieldWebsiteID constant value for the schema field apdex_entity.website.website_id
+	ApdexConfigFieldWebsiteID = "website_id"
+	//ApdexConfigFieldBeaconType constant value for the schema field apdex_entity.website.beacon_type
+	ApdexConfigFieldBeaconType = "beacon_type"
+	//ApdexConfigFieldTagFilter constant value for the schema field apdex_entity.*.tag_filter
+	ApdexConfigFieldTagFilter = "tag_filter"
+)
+
+var apdexConfigApdexEntityTypeKeys = []string{
+	"apdex_entity.0.application",
+	"apdex_entity.0.website",
+}
+
+var (
+	//ApdexConfigApdexEntity schema field definition of instana_apdex_config field apdex_entity
+	ApdexConfigApdexEntity = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "The entity for which the apdex score is calculated",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ApdexConfigFieldApdexEntityApplication: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The apdex entity of type application",
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ApdexConfigFieldApplicationID: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The ID of the application perspective",
+							},
+							ApdexConfigFieldBoundaryScope: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{"ALL", "INBOUND"}, true),
+								Description:  "The boundary scope of the application perspective (ALL, INBOUND)",
+							},
+							ApdexConfigFieldTagFilter: RequiredTagFilterExpressionSchema,
+							ApdexConfigFieldIncludeInternal: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Optional flag to indicate whether also internal calls are included",
+							},
+							ApdexConfigFieldIncludeSynthetic: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Optional flag to indicate whether also synthetic calls are included",
+							},
+						},
+					},
+					ExactlyOneOf: apdexConfigApdexEntityTypeKeys,
+				},
+				ApdexConfigFieldApdexEntityWebsite: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The apdex entity of type website",
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ApdexConfigFieldWebsiteID: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The ID of the website",
+							},
+							ApdexConfigFieldBeaconType: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{"pageLoad", "resourceLoad", "httpRequest", "error", "custom", "pageChange"}, true),
+								Description:  "The beacon type of the website (pageLoad, resourceLoad, httpRequest, error, custom, pageChange)",
+							},
+							ApdexConfigFieldTagFilter: RequiredTagFilterExpressionSchema,
+						},
+					},
+					ExactlyOneOf: apdexConfigApdexEntityTypeKeys,
+				},
+			},
+		},
+	}
+)
+
+// NewApdexConfigResourceHandle creates the resource handle for apdex configurations
+func NewApdexConfigResourceHandle() ResourceHandle[*restapi.ApdexConfig] {
+	return &apdexConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaApdexConfig,
+			Schema: map[string]*schema.Schema{
+				ApdexConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+					Description:  "The name of the apdex config",
+				},
+				ApdexConfigFieldThreshold: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The apdex threshold T in milliseconds. Calls faster than T are satisfied, calls faster than 4T are tolerated",
+				},
+				ApdexConfigFieldApdexEntity: ApdexConfigApdexEntity,
+			},
+			SchemaVersion: 0,
+			CreateOnly:    true,
+		},
+	}
+}
+
+type apdexConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *apdexConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *apdexConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *apdexConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApdexConfig] {
+	return api.ApdexConfigs()
+}
+
+func (r *apdexConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *apdexConfigResource) UpdateState(d *schema.ResourceData, apdexConfig *restapi.ApdexConfig) error {
+	apdexEntity, err := r.mapApdexEntityToState(apdexConfig.ApdexEntity)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(apdexConfig.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ApdexConfigFieldName:        apdexConfig.Name,
+		ApdexConfigFieldThreshold:   apdexConfig.ApdexEntity.Threshold,
+		ApdexConfigFieldApdexEntity: []interface{}{apdexEntity},
+	})
+}
+
+func (r *apdexConfigResource) mapApdexEntityToState(apdexEntity restapi.ApdexEntity) (map[string]interface{}, error) {
+	var tagFilter *string
+	var err error
+	if apdexEntity.TagFilterExpression != nil {
+		tagFilter, err = tagfilter.MapTagFilterToNormalizedString(apdexEntity.TagFilterExpression)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if apdexEntity.Type == restapi.ApdexEntityTypeApplication {
+		return map[string]interface{}{
+			ApdexConfigFieldApdexEntityApplication: []interface{}{
+				map[string]interface{}{
+					ApdexConfigFieldApplicationID:    apdexEntity.EntityID,
+					ApdexConfigFieldBoundaryScope:    apdexEntity.BoundaryScope,
+					ApdexConfigFieldTagFilter:        tagFilter,
+					ApdexConfigFieldIncludeInternal:  apdexEntity.IncludeInternal,
+					ApdexConfigFieldIncludeSynthetic: apdexEntity.IncludeSynthetic,
+				},
+			},
+		}, nil
+	} else if apdexEntity.Type == restapi.ApdexEntityTypeWebsite {
+		return map[string]interface{}{
+			ApdexConfigFieldApdexEntityWebsite: []interface{}{
+				map[string]interface{}{
+					ApdexConfigFieldWebsiteID:  apdexEntity.EntityID,
+					ApdexConfigFieldBeaconType: apdexEntity.BeaconType,
+					ApdexConfigFieldTagFilter:  tagFilter,
+				},
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported apdex entity type %s", apdexEntity.Type)
+}
+
+func (r *apdexConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ApdexConfig, error) {
+	apdexEntitiesStateObject := d.Get(ApdexConfigFieldApdexEntity).([]interface{})
+	if len(apdexEntitiesStateObject) != 1 {
+		return nil, errors.New("exactly one apdex entity configuration is required")
+	}
+	apdexEntity, err := r.mapApdexEntityFromState(apdexEntitiesStateObject[0].(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	apdexEntity.Threshold = GetIntPointerFromResourceData(d, ApdexConfigFieldThreshold)
+
+	return &restapi.ApdexConfig{
+		ID:          d.Id(),
+		Name:        d.Get(ApdexConfigFieldName).(string),
+		ApdexEntity: apdexEntity,
+	}, nil
+}
+
+func (r *apdexConfigResource) mapApdexEntityFromState(stateObject map[string]interface{}) (restapi.ApdexEntity, error) {
+	if details, ok := stateObject[ApdexConfigFieldApdexEntityApplication]; ok && r.isApdexEntitySet(details) {
+		return r.mapApplicationApdexEntityFromState(details.([]interface{})[0].(map[string]interface{}))
+	}
+	if details, ok := stateObject[ApdexConfigFieldApdexEntityWebsite]; ok && r.isApdexEntitySet(details) {
+		return r.mapWebsiteApdexEntityFromState(details.([]interface{})[0].(map[string]interface{}))
+	}
+	return restapi.ApdexEntity{}, fmt.Errorf("exactly one apdex entity configuration of type %s or %s is required", ApdexConfigFieldApdexEntityApplication, ApdexConfigFieldApdexEntityWebsite)
+}
+
+func (r *apdexConfigResource) isApdexEntitySet(details interface{}) bool {
+	list, ok := details.([]interface{})
+	return ok && len(list) == 1
+}
+
+func (r *apdexConfigResource) mapApplicationApdexEntityFromState(data map[string]interface{}) (restapi.ApdexEntity, error) {
+	tagFilter, err := r.mapTagFilterStringToAPIModel(data[ApdexConfigFieldTagFilter].(string))
+	if err != nil {
+		return restapi.ApdexEntity{}, err
+	}
+	return restapi.ApdexEntity{
+		Type:                restapi.ApdexEntityTypeApplication,
+		EntityID:            data[ApdexConfigFieldApplicationID].(string),
+		TagFilterExpression: tagFilter,
+		BoundaryScope:       GetPointerFromMap[string](data, ApdexConfigFieldBoundaryScope),
+		IncludeInternal:     GetPointerFromMap[bool](data, ApdexConfigFieldIncludeInternal),
+		IncludeSynthetic:    GetPointerFromMap[bool](data, ApdexConfigFieldIncludeSynthetic),
+	}, nil
+}
+
+func (r *apdexConfigResource) mapWebsiteApdexEntityFromState(data map[string]interface{}) (restapi.ApdexEntity, error) {
+	tagFilter, err := r.mapTagFilterStringToAPIModel(data[ApdexConfigFieldTagFilter].(string))
+	if err != nil {
+		return restapi.ApdexEntity{}, err
+	}
+	return restapi.ApdexEntity{
+		Type:                restapi.ApdexEntityTypeWebsite,
+		EntityID:            data[ApdexConfigFieldWebsiteID].(string),
+		TagFilterExpression: tagFilter,
+		BeaconType:          GetPointerFromMap[string](data, ApdexConfigFieldBeaconType),
+	}, nil
+}
+
+func (r *apdexConfigResource) mapTagFilterStringToAPIModel(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
@@ -0,0 +1,400 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestApdexConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaApdexConfig + ".example"
+	inst := &apdexConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewApdexConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type apdexConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.ApdexConfig]
+}
+
+var apdexConfigApplicationTerraformTemplate = `
+resource "instana_apdex_config" "example" {
+	name      = "name"
+	threshold = 500
+
+	apdex_entity {
+		application {
+			application_id    = "app-id"
+			boundary_scope    = "INBOUND"
+			tag_filter        = "service.name@dest EQUALS 'test'"
+			include_internal  = true
+			include_synthetic = false
+		}
+	}
+}
+`
+
+var apdexConfigWebsiteTerraformTemplate = `
+resource "instana_apdex_config" "example" {
+	name = "name"
+
+	apdex_entity {
+		website {
+			website_id  = "website-id"
+			beacon_type = "pageLoad"
+			tag_filter  = "beacon.page.name@na EQUALS 'test'"
+		}
+	}
+}
+`
+
+const (
+	apdexConfigID                   = "apdex-id"
+	apdexConfigName                 = "name"
+	apdexConfigApplicationID        = "app-id"
+	apdexConfigWebsiteID            = "website-id"
+	apdexConfigApplicationTagFilter = "service.name@dest EQUALS 'test'"
+	apdexConfigWebsiteTagFilter     = "beacon.page.name@na EQUALS 'test'"
+)
+
+func (test *apdexConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s with application entity", ResourceInstanaApdexConfig), test.createIntegrationTest(apdexConfigApplicationTerraformTemplate, test.createApplicationEntityChecks()))
+	t.Run(fmt.Sprintf("CRUD integration test of %s with website entity", ResourceInstanaApdexConfig), test.createIntegrationTest(apdexConfigWebsiteTerraformTemplate, test.createWebsiteEntityChecks()))
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaApdexConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaApdexConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaApdexConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should be create only", ResourceInstanaApdexConfig), test.createTestResourceShouldBeCreateOnly())
+	t.Run(fmt.Sprintf("%s should update terraform state from model with application entity", ResourceInstanaApdexConfig), test.createTestShouldUpdateTerraformResourceStateFromModelWithApplicationEntity())
+	t.Run(fmt.Sprintf("%s should update terraform state from model with website entity", ResourceInstanaApdexConfig), test.createTestShouldUpdateTerraformResourceStateFromModelWithWebsiteEntity())
+	t.Run(fmt.Sprintf("%s should fail to update terraform state when entity type is not supported", ResourceInstanaApdexConfig), test.createTestShouldFailToUpdateTerraformResourceStateWhenEntityTypeIsNotSupported())
+	t.Run(fmt.Sprintf("%s should map terraform state to model with application entity", ResourceInstanaApdexConfig), test.createTestShouldMapTerraformResourceStateToModelWithApplicationEntity())
+	t.Run(fmt.Sprintf("%s should map terraform state to model with website entity", ResourceInstanaApdexConfig), test.createTestShouldMapTerraformResourceStateToModelWithWebsiteEntity())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state to model when no entity is provided", ResourceInstanaApdexConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenNoEntityIsProvided())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state to model when tag filter is invalid", ResourceInstanaApdexConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsInvalid())
+}
+
+func (test *apdexConfigTest) createIntegrationTest(terraformDefinition string, checks []resource.TestCheckFunc) func(t *testing.T) {
+	return func(t *testing.T) {
+		var serverState *restapi.ApdexConfig
+		writeJSON := func(w http.ResponseWriter, r *http.Request, data interface{}) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(data)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.ApdexConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.ApdexConfig{}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, config)
+			config.ID = apdexConfigID
+			serverState = config
+			writeJSON(w, r, serverState)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.ApdexConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			serverState = nil
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.ApdexConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if serverState == nil || mux.Vars(r)["id"] != serverState.ID {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeJSON(w, r, serverState)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				{
+					Config: appendProviderConfig(terraformDefinition, httpServer.GetPort()),
+					Check: resource.ComposeTestCheckFunc(append([]resource.TestCheckFunc{
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", apdexConfigID),
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldName, apdexConfigName),
+					}, checks...)...),
+				},
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *apdexConfigTest) createApplicationEntityChecks() []resource.TestCheckFunc {
+	applicationPattern := fmt.Sprintf("%s.0.%s.0.%%s", ApdexConfigFieldApdexEntity, ApdexConfigFieldApdexEntityApplication)
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldThreshold, "500"),
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(applicationPattern, ApdexConfigFieldApplicationID), apdexConfigApplicationID),
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(applicationPattern, ApdexConfigFieldBoundaryScope), "INBOUND"),
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(applicationPattern, ApdexConfigFieldTagFilter), apdexConfigApplicationTagFilter),
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(applicationPattern, ApdexConfigFieldIncludeInternal), trueAsString),
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(applicationPattern, ApdexConfigFieldIncludeSynthetic), falseAsString),
+	}
+}
+
+func (test *apdexConfigTest) createWebsiteEntityChecks() []resource.TestCheckFunc {
+	websitePattern := fmt.Sprintf("%s.0.%s.0.%%s", ApdexConfigFieldApdexEntity, ApdexConfigFieldApdexEntityWebsite)
+	return []resource.TestCheckFunc{
+		resource.TestCheckNoResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldThreshold),
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(websitePattern, ApdexConfigFieldWebsiteID), apdexConfigWebsiteID),
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(websitePattern, ApdexConfigFieldBeaconType), "pageLoad"),
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(websitePattern, ApdexConfigFieldTagFilter), apdexConfigWebsiteTagFilter),
+	}
+}
+
+func (test *apdexConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *apdexConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *apdexConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_apdex_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *apdexConfigTest) createTestResourceShouldBeCreateOnly() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.True(t, test.resourceHandle.MetaData().CreateOnly)
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldUpdateTerraformResourceStateFromModelWithApplicationEntity() func(t *testing.T) {
+	return func(t *testing.T) {
+		threshold := 500
+		boundaryScope := "INBOUND"
+		includeInternal := true
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &restapi.ApdexConfig{
+			ID:   apdexConfigID,
+			Name: apdexConfigName,
+			ApdexEntity: restapi.ApdexEntity{
+				Type:                restapi.ApdexEntityTypeApplication,
+				EntityID:            apdexConfigApplicationID,
+				TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "service.name", restapi.EqualsOperator, "test"),
+				Threshold:           &threshold,
+				BoundaryScope:       &boundaryScope,
+				IncludeInternal:     &includeInternal,
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, apdexConfigID, resourceData.Id())
+		require.Equal(t, apdexConfigName, resourceData.Get(ApdexConfigFieldName))
+		require.Equal(t, threshold, resourceData.Get(ApdexConfigFieldThreshold))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				ApdexConfigFieldApdexEntityApplication: []interface{}{
+					map[string]interface{}{
+						ApdexConfigFieldApplicationID:    apdexConfigApplicationID,
+						ApdexConfigFieldBoundaryScope:    boundaryScope,
+						ApdexConfigFieldTagFilter:        apdexConfigApplicationTagFilter,
+						ApdexConfigFieldIncludeInternal:  true,
+						ApdexConfigFieldIncludeSynthetic: false,
+					},
+				},
+				ApdexConfigFieldApdexEntityWebsite: []interface{}{},
+			},
+		}, resourceData.Get(ApdexConfigFieldApdexEntity))
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldUpdateTerraformResourceStateFromModelWithWebsiteEntity() func(t *testing.T) {
+	return func(t *testing.T) {
+		beaconType := "pageLoad"
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &restapi.ApdexConfig{
+			ID:   apdexConfigID,
+			Name: apdexConfigName,
+			ApdexEntity: restapi.ApdexEntity{
+				Type:                restapi.ApdexEntityTypeWebsite,
+				EntityID:            apdexConfigWebsiteID,
+				TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "beacon.page.name", restapi.EqualsOperator, "test"),
+				BeaconType:          &beaconType,
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, apdexConfigID, resourceData.Id())
+		_, thresholdSet := resourceData.GetOk(ApdexConfigFieldThreshold)
+		require.False(t, thresholdSet)
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				ApdexConfigFieldApdexEntityApplication: []interface{}{},
+				ApdexConfigFieldApdexEntityWebsite: []interface{}{
+					map[string]interface{}{
+						ApdexConfigFieldWebsiteID:  apdexConfigWebsiteID,
+						ApdexConfigFieldBeaconType: beaconType,
+						ApdexConfigFieldTagFilter:  apdexConfigWebsiteTagFilter,
+					},
+				},
+			},
+		}, resourceData.Get(ApdexConfigFieldApdexEntity))
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldFailToUpdateTerraformResourceStateWhenEntityTypeIsNotSupported() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &restapi.ApdexConfig{
+			ID:          apdexConfigID,
+			Name:        apdexConfigName,
+			ApdexEntity: restapi.ApdexEntity{Type: restapi.ApdexEntityType("invalid"), EntityID: "id"},
+		})
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported apdex entity type invalid")
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldMapTerraformResourceStateToModelWithApplicationEntity() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId(apdexConfigID)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldName, apdexConfigName)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 500)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldApdexEntity, []interface{}{
+			map[string]interface{}{
+				ApdexConfigFieldApdexEntityApplication: []interface{}{
+					map[string]interface{}{
+						ApdexConfigFieldApplicationID:    apdexConfigApplicationID,
+						ApdexConfigFieldBoundaryScope:    "ALL",
+						ApdexConfigFieldTagFilter:        apdexConfigApplicationTagFilter,
+						ApdexConfigFieldIncludeInternal:  false,
+						ApdexConfigFieldIncludeSynthetic: true,
+					},
+				},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		threshold := 500
+		boundaryScope := "ALL"
+		includeSynthetic := true
+		require.NoError(t, err)
+		require.Equal(t, &restapi.ApdexConfig{
+			ID:   apdexConfigID,
+			Name: apdexConfigName,
+			ApdexEntity: restapi.ApdexEntity{
+				Type:                restapi.ApdexEntityTypeApplication,
+				EntityID:            apdexConfigApplicationID,
+				TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "service.name", restapi.EqualsOperator, "test"),
+				Threshold:           &threshold,
+				BoundaryScope:       &boundaryScope,
+				IncludeSynthetic:    &includeSynthetic,
+			},
+		}, result)
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldMapTerraformResourceStateToModelWithWebsiteEntity() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId(apdexConfigID)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldName, apdexConfigName)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldApdexEntity, []interface{}{
+			map[string]interface{}{
+				ApdexConfigFieldApdexEntityWebsite: []interface{}{
+					map[string]interface{}{
+						ApdexConfigFieldWebsiteID:  apdexConfigWebsiteID,
+						ApdexConfigFieldBeaconType: "pageLoad",
+						ApdexConfigFieldTagFilter:  apdexConfigWebsiteTagFilter,
+					},
+				},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		beaconType := "pageLoad"
+		require.NoError(t, err)
+		require.Equal(t, &restapi.ApdexConfig{
+			ID:   apdexConfigID,
+			Name: apdexConfigName,
+			ApdexEntity: restapi.ApdexEntity{
+				Type:                restapi.ApdexEntityTypeWebsite,
+				EntityID:            apdexConfigWebsiteID,
+				TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "beacon.page.name", restapi.EqualsOperator, "test"),
+				BeaconType:          &beaconType,
+			},
+		}, result)
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenNoEntityIsProvided() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId(apdexConfigID)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldName, apdexConfigName)
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "exactly one apdex entity configuration is required")
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsInvalid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId(apdexConfigID)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldName, apdexConfigName)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldApdexEntity, []interface{}{
+			map[string]interface{}{
+				ApdexConfigFieldApdexEntityWebsite: []interface{}{
+					map[string]interface{}{
+						ApdexConfigFieldWebsiteID:  apdexConfigWebsiteID,
+						ApdexConfigFieldBeaconType: "pageLoad",
+						ApdexConfigFieldTagFilter:  "invalid tag filter",
+					},
+				},
+			},
+		})
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+	}
+}
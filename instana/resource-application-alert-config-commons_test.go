@@ -145,6 +145,7 @@ var applicationAlertConfigServerResponseTemplate = `
     },
     "severity": 5,
     "triggering": false,
+    "enabled": true,
     "tagFilters": [],
     "tagFilterExpression": {
       "type": "TAG_FILTER",
@@ -301,6 +302,7 @@ func (f *anyApplicationConfigTest) createIntegrationTestStep(httpPort int, itera
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldBoundaryScope, string(restapi.BoundaryScopeAll)),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldTriggering, falseAsString),
+			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldEnabled, trueAsString),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldIncludeInternal, falseAsString),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldIncludeSynthetic, falseAsString),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
@@ -717,6 +719,7 @@ func (f *anyApplicationConfigTest) createTestShouldUpdateTerraformResourceStateF
 			Threshold:           thresholdTestPair.input,
 			TimeThreshold:       timeThresholdTestPair.input,
 			Triggering:          true,
+			Enabled:             false,
 		}
 
 		testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
@@ -753,6 +756,7 @@ func (f *anyApplicationConfigTest) createTestShouldUpdateTerraformResourceStateF
 		f.requireApplicationAlertConfigThresholdSetOnSchema(t, thresholdTestPair.expected, resourceData)
 		require.Equal(t, timeThresholdTestPair.expected, resourceData.Get(ApplicationAlertConfigFieldTimeThreshold))
 		require.True(t, resourceData.Get(ApplicationAlertConfigFieldTriggering).(bool))
+		require.False(t, resourceData.Get(ApplicationAlertConfigFieldEnabled).(bool))
 	}
 }
 
@@ -1147,6 +1151,7 @@ func (f *anyApplicationConfigTest) createTestShouldMapTerraformResourceStateToMo
 			Threshold:           thresholdTestPair.expected,
 			TimeThreshold:       timeThresholdTestPair.expected,
 			Triggering:          true,
+			Enabled:             true,
 		}
 
 		testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
@@ -1195,6 +1200,7 @@ func (f *anyApplicationConfigTest) createTestShouldMapTerraformResourceStateToMo
 		setValueOnResourceData(t, resourceData, ResourceFieldThreshold, thresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, ApplicationAlertConfigFieldTimeThreshold, timeThresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, ApplicationAlertConfigFieldTriggering, true)
+		setValueOnResourceData(t, resourceData, ApplicationAlertConfigFieldEnabled, true)
 		resourceData.SetId(applicationAlertConfigID)
 
 		result, err := sut.MapStateToDataObject(resourceData)
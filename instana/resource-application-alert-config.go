@@ -2,6 +2,8 @@ package instana
 
 import (
 	"context"
+	"maps"
+
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
 	"github.com/gessnerfl/terraform-provider-instana/tfutils"
@@ -33,10 +35,14 @@ const (
 	ApplicationAlertConfigFieldApplicationsServicesEndpoints = "endpoint"
 	//ApplicationAlertConfigFieldApplicationsServicesEndpointsEndpointID constant value for field applications.services.endpoints.endpoint_id of resource instana_application_alert_config
 	ApplicationAlertConfigFieldApplicationsServicesEndpointsEndpointID = "endpoint_id"
+	//ApplicationAlertConfigFieldBaselineUpdateTrigger constant value for field baseline_update_trigger of resource instana_application_alert_config
+	ApplicationAlertConfigFieldBaselineUpdateTrigger = "baseline_update_trigger"
 	//ApplicationAlertConfigFieldBoundaryScope constant value for field boundary_scope of resource instana_application_alert_config
 	ApplicationAlertConfigFieldBoundaryScope = "boundary_scope"
 	//ApplicationAlertConfigFieldDescription constant value for field description of resource instana_application_alert_config
 	ApplicationAlertConfigFieldDescription = "description"
+	//ApplicationAlertConfigFieldEnabled constant value for field enabled of resource instana_application_alert_config
+	ApplicationAlertConfigFieldEnabled = "enabled"
 	//ApplicationAlertConfigFieldEvaluationType constant value for field evaluation_type of resource instana_application_alert_config
 	ApplicationAlertConfigFieldEvaluationType = "evaluation_type"
 	//ApplicationAlertConfigFieldGranularity constant value for field granularity of resource instana_application_alert_config
@@ -239,12 +245,23 @@ var (
 		ValidateFunc: validation.StringInSlice(restapi.SupportedApplicationAlertConfigBoundaryScopes.ToStringSlice(), false),
 		Description:  "The boundary scope of the application alert config",
 	}
+	applicationAlertConfigSchemaBaselineUpdateTrigger = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Optional arbitrary value which triggers the recalculation of the historic baseline of the application alert config whenever it is changed",
+	}
 	applicationAlertConfigSchemaDescription = &schema.Schema{
 		Type:         schema.TypeString,
 		Required:     true,
 		Description:  "The description text of the application alert config",
 		ValidateFunc: validation.StringLenBetween(0, 65536),
 	}
+	applicationAlertConfigSchemaEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Optional flag to indicate whether the application alert config is enabled. Disabled alert configs do not raise any alerts. The default is true",
+	}
 	applicationAlertConfigSchemaEvaluationType = &schema.Schema{
 		Type:         schema.TypeString,
 		Required:     true,
@@ -483,6 +500,7 @@ var applicationAlertConfigResourceSchema = map[string]*schema.Schema{
 	ApplicationAlertConfigFieldBoundaryScope:    applicationAlertConfigSchemaBoundaryScope,
 	DefaultCustomPayloadFieldsName:              buildCustomPayloadFields(),
 	ApplicationAlertConfigFieldDescription:      applicationAlertConfigSchemaDescription,
+	ApplicationAlertConfigFieldEnabled:          applicationAlertConfigSchemaEnabled,
 	ApplicationAlertConfigFieldEvaluationType:   applicationAlertConfigSchemaEvaluationType,
 	ApplicationAlertConfigFieldGranularity:      applicationAlertConfigSchemaGranularity,
 	ApplicationAlertConfigFieldIncludeInternal:  applicationAlertConfigSchemaIncludeInternal,
@@ -496,12 +514,18 @@ var applicationAlertConfigResourceSchema = map[string]*schema.Schema{
 	ApplicationAlertConfigFieldTriggering:       applicationAlertConfigSchemaTriggering,
 }
 
+func newApplicationAlertConfigResourceSchemaWithBaselineUpdateTrigger() map[string]*schema.Schema {
+	result := maps.Clone(applicationAlertConfigResourceSchema)
+	result[ApplicationAlertConfigFieldBaselineUpdateTrigger] = applicationAlertConfigSchemaBaselineUpdateTrigger
+	return result
+}
+
 // NewApplicationAlertConfigResourceHandle creates a new instance of the ResourceHandle for application alert configs
 func NewApplicationAlertConfigResourceHandle() ResourceHandle[*restapi.ApplicationAlertConfig] {
 	return &applicationAlertConfigResource{
 		metaData: ResourceMetaData{
 			ResourceName:     ResourceInstanaApplicationAlertConfig,
-			Schema:           applicationAlertConfigResourceSchema,
+			Schema:           newApplicationAlertConfigResourceSchemaWithBaselineUpdateTrigger(),
 			SkipIDGeneration: true,
 			SchemaVersion:    1,
 		},
@@ -572,6 +596,7 @@ func (r *applicationAlertConfigResource) UpdateState(d *schema.ResourceData, con
 		ApplicationAlertConfigFieldBoundaryScope:    config.BoundaryScope,
 		DefaultCustomPayloadFieldsName:              mapCustomPayloadFieldsToSchema(config),
 		ApplicationAlertConfigFieldDescription:      config.Description,
+		ApplicationAlertConfigFieldEnabled:          config.Enabled,
 		ApplicationAlertConfigFieldEvaluationType:   config.EvaluationType,
 		ApplicationAlertConfigFieldGranularity:      config.Granularity,
 		ApplicationAlertConfigFieldIncludeInternal:  config.IncludeInternal,
@@ -733,6 +758,7 @@ func (r *applicationAlertConfigResource) MapStateToDataObject(d *schema.Resource
 		BoundaryScope:         restapi.BoundaryScope(d.Get(ApplicationAlertConfigFieldBoundaryScope).(string)),
 		CustomerPayloadFields: customPayloadFields,
 		Description:           d.Get(ApplicationAlertConfigFieldDescription).(string),
+		Enabled:               d.Get(ApplicationAlertConfigFieldEnabled).(bool),
 		EvaluationType:        restapi.ApplicationAlertEvaluationType(d.Get(ApplicationAlertConfigFieldEvaluationType).(string)),
 		Granularity:           restapi.Granularity(d.Get(ApplicationAlertConfigFieldGranularity).(int)),
 		IncludeInternal:       d.Get(ApplicationAlertConfigFieldIncludeInternal).(bool),
@@ -744,9 +770,18 @@ func (r *applicationAlertConfigResource) MapStateToDataObject(d *schema.Resource
 		Threshold:             *threshold,
 		TimeThreshold:         r.mapTimeThresholdFromSchema(d),
 		Triggering:            d.Get(ApplicationAlertConfigFieldTriggering).(bool),
+		UpdateBaseline:        r.isBaselineUpdateRequested(d),
 	}, nil
 }
 
+func (r *applicationAlertConfigResource) isBaselineUpdateRequested(d *schema.ResourceData) bool {
+	//global application alert configs do not support the recalculation of the historic baseline
+	if _, ok := r.metaData.Schema[ApplicationAlertConfigFieldBaselineUpdateTrigger]; !ok {
+		return false
+	}
+	return !d.IsNewResource() && d.HasChange(ApplicationAlertConfigFieldBaselineUpdateTrigger)
+}
+
 func (r *applicationAlertConfigResource) mapApplicationsFromSchema(d *schema.ResourceData) map[string]restapi.IncludedApplication {
 	val := d.Get(ApplicationAlertConfigFieldApplications)
 	result := make(map[string]restapi.IncludedApplication)
@@ -8,6 +8,7 @@ import (
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
 	"net/http"
 	"testing"
 )
@@ -200,3 +201,83 @@ func createIssue141TestStep(terraformResourceInstanceName string, httpPort int,
 		),
 	}
 }
+
+func TestApplicationAlertConfigShouldRequestBaselineUpdateWhenBaselineUpdateTriggerIsChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	sut := NewApplicationAlertConfigResourceHandle()
+	resourceData := testHelper.CreateResourceDataForResourceHandle(sut, newMinimalApplicationAlertConfigState(map[string]interface{}{
+		ApplicationAlertConfigFieldBaselineUpdateTrigger: "trigger",
+	}))
+	resourceData.SetId("application-alert-config-id")
+
+	result, err := sut.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.True(t, result.UpdateBaseline)
+}
+
+func TestApplicationAlertConfigShouldNotRequestBaselineUpdateForNewResource(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	sut := NewApplicationAlertConfigResourceHandle()
+	resourceData := testHelper.CreateResourceDataForResourceHandle(sut, newMinimalApplicationAlertConfigState(map[string]interface{}{
+		ApplicationAlertConfigFieldBaselineUpdateTrigger: "trigger",
+	}))
+	resourceData.MarkNewResource()
+
+	result, err := sut.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.False(t, result.UpdateBaseline)
+}
+
+func TestApplicationAlertConfigShouldNotRequestBaselineUpdateWhenBaselineUpdateTriggerIsNotChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	sut := NewApplicationAlertConfigResourceHandle()
+	resourceData := testHelper.CreateResourceDataForResourceHandle(sut, newMinimalApplicationAlertConfigState(map[string]interface{}{}))
+	resourceData.SetId("application-alert-config-id")
+
+	result, err := sut.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.False(t, result.UpdateBaseline)
+}
+
+func newMinimalApplicationAlertConfigState(additionalFields map[string]interface{}) map[string]interface{} {
+	state := map[string]interface{}{
+		ApplicationAlertConfigFieldSeverity: restapi.SeverityWarning.GetTerraformRepresentation(),
+		ApplicationAlertConfigFieldRule: []interface{}{
+			map[string]interface{}{
+				ApplicationAlertConfigFieldRuleThroughput: []interface{}{
+					map[string]interface{}{
+						ApplicationAlertConfigFieldRuleMetricName:  "calls",
+						ApplicationAlertConfigFieldRuleAggregation: "SUM",
+					},
+				},
+			},
+		},
+		ResourceFieldThreshold: []interface{}{
+			map[string]interface{}{
+				ResourceFieldThresholdStatic: []interface{}{
+					map[string]interface{}{
+						ResourceFieldThresholdOperator:    ">=",
+						ResourceFieldThresholdStaticValue: 1.0,
+					},
+				},
+			},
+		},
+		ApplicationAlertConfigFieldTimeThreshold: []interface{}{
+			map[string]interface{}{
+				ApplicationAlertConfigFieldTimeThresholdViolationsInPeriod: []interface{}{
+					map[string]interface{}{
+						ApplicationAlertConfigFieldTimeThresholdTimeWindow:                   1800000,
+						ApplicationAlertConfigFieldTimeThresholdViolationsInPeriodViolations: 1,
+					},
+				},
+			},
+		},
+	}
+	for k, v := range additionalFields {
+		state[k] = v
+	}
+	return state
+}
@@ -0,0 +1,166 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaApplicationServiceConfig the name of the terraform-provider-instana resource to manage service configs (service mapping rules)
+const ResourceInstanaApplicationServiceConfig = "instana_application_service_config"
+
+const (
+	//ApplicationServiceConfigFieldName constant value for the schema field name
+	ApplicationServiceConfigFieldName = "name"
+	//ApplicationServiceConfigFieldComment constant value for the schema field comment
+	ApplicationServiceConfigFieldComment = "comment"
+	//ApplicationServiceConfigFieldLabel constant value for the schema field label
+	ApplicationServiceConfigFieldLabel = "label"
+	//ApplicationServiceConfigFieldEnabled constant value for the schema field enabled
+	ApplicationServiceConfigFieldEnabled = "enabled"
+	//ApplicationServiceConfigFieldOrder constant value for the schema field order
+	ApplicationServiceConfigFieldOrder = "order"
+	//ApplicationServiceConfigFieldMatchSpecification constant value for the schema field match_specification
+	ApplicationServiceConfigFieldMatchSpecification = "match_specification"
+	//ApplicationServiceConfigFieldMatchSpecificationKey constant value for the schema field match_specification.key
+	ApplicationServiceConfigFieldMatchSpecificationKey = "key"
+	//ApplicationServiceConfigFieldMatchSpecificationValue constant value for the schema field match_specification.value
+	ApplicationServiceConfigFieldMatchSpecificationValue = "value"
+)
+
+// NewApplicationServiceConfigResourceHandle creates the resource handle for service configs
+func NewApplicationServiceConfigResourceHandle() ResourceHandle[*restapi.ServiceConfig] {
+	return &applicationServiceConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaApplicationServiceConfig,
+			Schema: map[string]*schema.Schema{
+				ApplicationServiceConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+					Description:  "The name of the service config",
+				},
+				ApplicationServiceConfigFieldComment: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 2048),
+					Description:  "An optional comment of the service config",
+				},
+				ApplicationServiceConfigFieldLabel: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The label of the services created by the service config. The label can reference the keys of the match specification using curly braces, e.g. {docker.container.name}",
+				},
+				ApplicationServiceConfigFieldEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether the service config is enabled or not",
+				},
+				ApplicationServiceConfigFieldOrder: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The position of the service config in the ordered list of all service configs starting with 1. Service configs are evaluated in this order and the first matching service config is applied. When not defined the position assigned by Instana is kept",
+				},
+				ApplicationServiceConfigFieldMatchSpecification: {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 20,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ApplicationServiceConfigFieldMatchSpecificationKey: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The key of the tag which needs to be present, e.g. docker.container.name",
+							},
+							ApplicationServiceConfigFieldMatchSpecificationValue: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The regular expression which needs to match the value of the tag",
+							},
+						},
+					},
+					Description: "The list of rules which need to match so that the service config is applied",
+				},
+			},
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type applicationServiceConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *applicationServiceConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *applicationServiceConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *applicationServiceConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ServiceConfig] {
+	return api.ServiceConfigs()
+}
+
+func (r *applicationServiceConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *applicationServiceConfigResource) UpdateState(d *schema.ResourceData, config *restapi.ServiceConfig) error {
+	matchSpecification := make([]interface{}, len(config.MatchSpecification))
+	for i, rule := range config.MatchSpecification {
+		matchSpecification[i] = map[string]interface{}{
+			ApplicationServiceConfigFieldMatchSpecificationKey:   rule.Key,
+			ApplicationServiceConfigFieldMatchSpecificationValue: rule.Value,
+		}
+	}
+
+	data := map[string]interface{}{
+		ApplicationServiceConfigFieldName:               config.Name,
+		ApplicationServiceConfigFieldComment:            config.Comment,
+		ApplicationServiceConfigFieldLabel:              config.Label,
+		ApplicationServiceConfigFieldEnabled:            config.Enabled,
+		ApplicationServiceConfigFieldMatchSpecification: matchSpecification,
+	}
+	if config.Order != nil {
+		data[ApplicationServiceConfigFieldOrder] = *config.Order + 1
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *applicationServiceConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ServiceConfig, error) {
+	matchSpecificationSlice := d.Get(ApplicationServiceConfigFieldMatchSpecification).([]interface{})
+	matchSpecification := make([]restapi.ServiceMatchingRule, len(matchSpecificationSlice))
+	for i, v := range matchSpecificationSlice {
+		rule := v.(map[string]interface{})
+		matchSpecification[i] = restapi.ServiceMatchingRule{
+			Key:   rule[ApplicationServiceConfigFieldMatchSpecificationKey].(string),
+			Value: rule[ApplicationServiceConfigFieldMatchSpecificationValue].(string),
+		}
+	}
+
+	var order *int
+	if v, ok := d.GetOk(ApplicationServiceConfigFieldOrder); ok {
+		position := v.(int) - 1
+		order = &position
+	}
+
+	return &restapi.ServiceConfig{
+		ID:                 d.Id(),
+		Name:               d.Get(ApplicationServiceConfigFieldName).(string),
+		Comment:            GetStringPointerFromResourceData(d, ApplicationServiceConfigFieldComment),
+		Label:              d.Get(ApplicationServiceConfigFieldLabel).(string),
+		Enabled:            d.Get(ApplicationServiceConfigFieldEnabled).(bool),
+		MatchSpecification: matchSpecification,
+		Order:              order,
+	}, nil
+}
@@ -0,0 +1,262 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestApplicationServiceConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaApplicationServiceConfig + ".example"
+	inst := &applicationServiceConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewApplicationServiceConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type applicationServiceConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.ServiceConfig]
+}
+
+var applicationServiceConfigTerraformTemplate = `
+resource "instana_application_service_config" "example" {
+	name    = "name %d"
+	comment = "comment %d"
+	label   = "{docker.container.name}"
+	enabled = true
+	order   = 1
+
+	match_specification {
+		key   = "docker.container.name"
+		value = ".*"
+	}
+}
+`
+
+func (test *applicationServiceConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaApplicationServiceConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaApplicationServiceConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaApplicationServiceConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaApplicationServiceConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaApplicationServiceConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaApplicationServiceConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model without order when order is not set", ResourceInstanaApplicationServiceConfig), test.createTestShouldMapTerraformResourceStateToModelWithoutOrder())
+}
+
+func (test *applicationServiceConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		otherConfig := &restapi.ServiceConfig{ID: "other", Name: "other", Label: "{jvm.args.abc}", Enabled: true, MatchSpecification: []restapi.ServiceMatchingRule{{Key: "jvm.args.abc", Value: ".*"}}}
+		serverState := []*restapi.ServiceConfig{otherConfig}
+		writeJSON := func(w http.ResponseWriter, r *http.Request, data interface{}) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(data)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+		readConfig := func(r *http.Request) *restapi.ServiceConfig {
+			config := &restapi.ServiceConfig{}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, config)
+			return config
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.ServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			config := readConfig(r)
+			config.ID = RandomID()
+			serverState = append(serverState, config)
+			writeJSON(w, r, config)
+		})
+		httpServer.AddRoute(http.MethodPut, restapi.ServiceConfigOrderResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			ids := make([]string, 0)
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &ids)
+			ordered := make([]*restapi.ServiceConfig, 0)
+			for _, id := range ids {
+				for _, c := range serverState {
+					if c.ID == id {
+						ordered = append(ordered, c)
+					}
+				}
+			}
+			serverState = ordered
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodPut, restapi.ServiceConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			config := readConfig(r)
+			for i, c := range serverState {
+				if c.ID == mux.Vars(r)["id"] {
+					serverState[i] = config
+					writeJSON(w, r, config)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.ServiceConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			remaining := make([]*restapi.ServiceConfig, 0)
+			for _, c := range serverState {
+				if c.ID != mux.Vars(r)["id"] {
+					remaining = append(remaining, c)
+				}
+			}
+			serverState = remaining
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.ServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, r, serverState)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *applicationServiceConfigTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	matchSpecificationKey := fmt.Sprintf("%s.0.%s", ApplicationServiceConfigFieldMatchSpecification, ApplicationServiceConfigFieldMatchSpecificationKey)
+	matchSpecificationValue := fmt.Sprintf("%s.0.%s", ApplicationServiceConfigFieldMatchSpecification, ApplicationServiceConfigFieldMatchSpecificationValue)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(applicationServiceConfigTerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, "id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApplicationServiceConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApplicationServiceConfigFieldComment, fmt.Sprintf("comment %d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApplicationServiceConfigFieldLabel, "{docker.container.name}"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApplicationServiceConfigFieldEnabled, trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApplicationServiceConfigFieldOrder, "1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, matchSpecificationKey, "docker.container.name"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, matchSpecificationValue, ".*"),
+		),
+	}
+}
+
+func (test *applicationServiceConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *applicationServiceConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *applicationServiceConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_application_service_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *applicationServiceConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		comment := "comment"
+		order := 2
+		config := &restapi.ServiceConfig{
+			ID:                 "service-config-id",
+			Name:               resourceName,
+			Comment:            &comment,
+			Label:              "{docker.container.name}",
+			Enabled:            true,
+			MatchSpecification: []restapi.ServiceMatchingRule{{Key: "docker.container.name", Value: ".*"}},
+			Order:              &order,
+		}
+		testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "service-config-id", resourceData.Id())
+		require.Equal(t, resourceName, resourceData.Get(ApplicationServiceConfigFieldName))
+		require.Equal(t, comment, resourceData.Get(ApplicationServiceConfigFieldComment))
+		require.Equal(t, "{docker.container.name}", resourceData.Get(ApplicationServiceConfigFieldLabel))
+		require.True(t, resourceData.Get(ApplicationServiceConfigFieldEnabled).(bool))
+		require.Equal(t, 3, resourceData.Get(ApplicationServiceConfigFieldOrder))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				ApplicationServiceConfigFieldMatchSpecificationKey:   "docker.container.name",
+				ApplicationServiceConfigFieldMatchSpecificationValue: ".*",
+			},
+		}, resourceData.Get(ApplicationServiceConfigFieldMatchSpecification))
+	}
+}
+
+func (test *applicationServiceConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("service-config-id")
+		test.setRequiredValues(t, resourceData)
+		setValueOnResourceData(t, resourceData, ApplicationServiceConfigFieldComment, "comment")
+		setValueOnResourceData(t, resourceData, ApplicationServiceConfigFieldEnabled, false)
+		setValueOnResourceData(t, resourceData, ApplicationServiceConfigFieldOrder, 1)
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		comment := "comment"
+		order := 0
+		require.NoError(t, err)
+		require.Equal(t, &restapi.ServiceConfig{
+			ID:                 "service-config-id",
+			Name:               resourceName,
+			Comment:            &comment,
+			Label:              "{docker.container.name}",
+			Enabled:            false,
+			MatchSpecification: []restapi.ServiceMatchingRule{{Key: "docker.container.name", Value: ".*"}},
+			Order:              &order,
+		}, result)
+	}
+}
+
+func (test *applicationServiceConfigTest) createTestShouldMapTerraformResourceStateToModelWithoutOrder() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		test.setRequiredValues(t, resourceData)
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Nil(t, result.Order)
+		require.Nil(t, result.Comment)
+	}
+}
+
+func (test *applicationServiceConfigTest) setRequiredValues(t *testing.T, resourceData *schema.ResourceData) {
+	setValueOnResourceData(t, resourceData, ApplicationServiceConfigFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, ApplicationServiceConfigFieldLabel, "{docker.container.name}")
+	setValueOnResourceData(t, resourceData, ApplicationServiceConfigFieldMatchSpecification, []interface{}{
+		map[string]interface{}{
+			ApplicationServiceConfigFieldMatchSpecificationKey:   "docker.container.name",
+			ApplicationServiceConfigFieldMatchSpecificationValue: ".*",
+		},
+	})
+}
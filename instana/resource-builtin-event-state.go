@@ -0,0 +1,107 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaBuiltinEventState the name of the terraform-provider-instana resource to manage the enabled flag of builtin event specifications
+const ResourceInstanaBuiltinEventState = "instana_builtin_event_state"
+
+const (
+	//BuiltinEventStateFieldBuiltinEventID constant value for the schema field builtin_event_id
+	BuiltinEventStateFieldBuiltinEventID = "builtin_event_id"
+	//BuiltinEventStateFieldEnabled constant value for the schema field enabled
+	BuiltinEventStateFieldEnabled = "enabled"
+	//BuiltinEventStateFieldPreviousEnabled constant value for the computed schema field previous_enabled
+	BuiltinEventStateFieldPreviousEnabled = "previous_enabled"
+)
+
+// NewBuiltinEventStateResourceHandle creates the resource handle for the enabled flag of builtin event specifications
+func NewBuiltinEventStateResourceHandle() ResourceHandle[*restapi.BuiltinEventSpecificationState] {
+	builtinEventIDField := BuiltinEventStateFieldBuiltinEventID
+	return &builtinEventStateResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaBuiltinEventState,
+			Schema: map[string]*schema.Schema{
+				BuiltinEventStateFieldBuiltinEventID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+					Description:  "The ID of the builtin event specification (e.g. from the data source instana_builtin_event_spec)",
+				},
+				BuiltinEventStateFieldEnabled: {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Flag to indicate whether the builtin event specification is enabled",
+				},
+				BuiltinEventStateFieldPreviousEnabled: {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "The enabled flag of the builtin event specification before it was managed by terraform. The flag is restored when the resource is destroyed",
+				},
+			},
+			SkipIDGeneration: true,
+			ResourceIDField:  &builtinEventIDField,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type builtinEventStateResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *builtinEventStateResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *builtinEventStateResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *builtinEventStateResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.BuiltinEventSpecificationState] {
+	return api.BuiltinEventSpecificationStates()
+}
+
+func (r *builtinEventStateResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *builtinEventStateResource) UpdateState(d *schema.ResourceData, state *restapi.BuiltinEventSpecificationState) error {
+	data := map[string]interface{}{
+		BuiltinEventStateFieldBuiltinEventID: state.ID,
+		BuiltinEventStateFieldEnabled:        state.Enabled,
+	}
+	//the previous state is only known on creation and must be retained otherwise
+	if state.PreviousEnabled != nil {
+		data[BuiltinEventStateFieldPreviousEnabled] = *state.PreviousEnabled
+	}
+	d.SetId(state.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *builtinEventStateResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.BuiltinEventSpecificationState, error) {
+	return &restapi.BuiltinEventSpecificationState{
+		ID:              d.Get(BuiltinEventStateFieldBuiltinEventID).(string),
+		Enabled:         d.Get(BuiltinEventStateFieldEnabled).(bool),
+		PreviousEnabled: r.mapPreviousEnabledFromState(d),
+	}, nil
+}
+
+// mapPreviousEnabledFromState reads the previous enabled flag from the raw state as it is not known for imported resources and false must not be restored in this case
+func (r *builtinEventStateResource) mapPreviousEnabledFromState(d *schema.ResourceData) *bool {
+	rawState := d.GetRawState()
+	if rawState.IsNull() || !rawState.IsKnown() {
+		return nil
+	}
+	value := rawState.GetAttr(BuiltinEventStateFieldPreviousEnabled)
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	previousEnabled := value.True()
+	return &previousEnabled
+}
@@ -0,0 +1,215 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestBuiltinEventState(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaBuiltinEventState + ".example"
+	inst := &builtinEventStateTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewBuiltinEventStateResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type builtinEventStateTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.BuiltinEventSpecificationState]
+}
+
+const builtinEventStateBuiltinEventID = "builtin-event-id"
+
+var builtinEventStateTerraformTemplate = `
+resource "instana_builtin_event_state" "example" {
+	builtin_event_id = "builtin-event-id"
+	enabled          = %t
+}
+`
+
+func (test *builtinEventStateTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaBuiltinEventState), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaBuiltinEventState), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaBuiltinEventState), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaBuiltinEventState), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaBuiltinEventState), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should retain previous state when updating terraform state from model without previous state", ResourceInstanaBuiltinEventState), test.createTestShouldRetainPreviousStateWhenUpdatingTerraformResourceStateFromModelWithoutPreviousState())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaBuiltinEventState), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model without previous state when no raw state exists", ResourceInstanaBuiltinEventState), test.createTestShouldMapTerraformResourceStateToModelWithoutPreviousStateWhenNoRawStateExists())
+}
+
+func (test *builtinEventStateTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		serverState := &restapi.BuiltinEventSpecification{ID: builtinEventStateBuiltinEventID, Name: "builtin-event", Enabled: true}
+
+		writeServerState := func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(serverState)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.BuiltinEventSpecificationResourcePath+"/{id}/enable", func(w http.ResponseWriter, r *http.Request) {
+			serverState.Enabled = true
+			writeServerState(w, r)
+		})
+		httpServer.AddRoute(http.MethodPost, restapi.BuiltinEventSpecificationResourcePath+"/{id}/disable", func(w http.ResponseWriter, r *http.Request) {
+			serverState.Enabled = false
+			writeServerState(w, r)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.BuiltinEventSpecificationResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if mux.Vars(r)["id"] != serverState.ID {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeServerState(w, r)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			CheckDestroy: func(_ *terraform.State) error {
+				if !serverState.Enabled {
+					return fmt.Errorf("previous state of builtin event specification not restored")
+				}
+				return nil
+			},
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), false),
+				test.createImportTestStep(),
+				test.createIntegrationTestStep(httpServer.GetPort(), true),
+				test.createImportTestStep(),
+			},
+		})
+	}
+}
+
+func (test *builtinEventStateTest) createIntegrationTestStep(httpPort int, enabled bool) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(builtinEventStateTerraformTemplate, enabled), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", builtinEventStateBuiltinEventID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, BuiltinEventStateFieldBuiltinEventID, builtinEventStateBuiltinEventID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, BuiltinEventStateFieldEnabled, fmt.Sprintf("%t", enabled)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, BuiltinEventStateFieldPreviousEnabled, "true"),
+		),
+	}
+}
+
+func (test *builtinEventStateTest) createImportTestStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            test.terraformResourceInstanceName,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateId:           builtinEventStateBuiltinEventID,
+		ImportStateVerifyIgnore: []string{BuiltinEventStateFieldPreviousEnabled},
+	}
+}
+
+func (test *builtinEventStateTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *builtinEventStateTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *builtinEventStateTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_builtin_event_state", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *builtinEventStateTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.BuiltinEventSpecificationState](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		previousEnabled := true
+
+		err := sut.UpdateState(resourceData, &restapi.BuiltinEventSpecificationState{ID: builtinEventStateBuiltinEventID, Enabled: false, PreviousEnabled: &previousEnabled})
+
+		require.NoError(t, err)
+		require.Equal(t, builtinEventStateBuiltinEventID, resourceData.Id())
+		require.Equal(t, builtinEventStateBuiltinEventID, resourceData.Get(BuiltinEventStateFieldBuiltinEventID))
+		require.False(t, resourceData.Get(BuiltinEventStateFieldEnabled).(bool))
+		require.True(t, resourceData.Get(BuiltinEventStateFieldPreviousEnabled).(bool))
+	}
+}
+
+func (test *builtinEventStateTest) createTestShouldRetainPreviousStateWhenUpdatingTerraformResourceStateFromModelWithoutPreviousState() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.BuiltinEventSpecificationState](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldPreviousEnabled, true)
+
+		err := sut.UpdateState(resourceData, &restapi.BuiltinEventSpecificationState{ID: builtinEventStateBuiltinEventID, Enabled: false})
+
+		require.NoError(t, err)
+		require.False(t, resourceData.Get(BuiltinEventStateFieldEnabled).(bool))
+		require.True(t, resourceData.Get(BuiltinEventStateFieldPreviousEnabled).(bool))
+	}
+}
+
+func (test *builtinEventStateTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		sut := test.resourceHandle
+		resourceData := NewTerraformResource(sut).ToSchemaResource().Data(&terraform.InstanceState{
+			ID: builtinEventStateBuiltinEventID,
+			Attributes: map[string]string{
+				BuiltinEventStateFieldBuiltinEventID:  builtinEventStateBuiltinEventID,
+				BuiltinEventStateFieldEnabled:         "false",
+				BuiltinEventStateFieldPreviousEnabled: "true",
+			},
+			RawState: cty.ObjectVal(map[string]cty.Value{
+				"id":                                  cty.StringVal(builtinEventStateBuiltinEventID),
+				BuiltinEventStateFieldBuiltinEventID:  cty.StringVal(builtinEventStateBuiltinEventID),
+				BuiltinEventStateFieldEnabled:         cty.False,
+				BuiltinEventStateFieldPreviousEnabled: cty.True,
+			}),
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		previousEnabled := true
+		require.Equal(t, &restapi.BuiltinEventSpecificationState{ID: builtinEventStateBuiltinEventID, Enabled: false, PreviousEnabled: &previousEnabled}, result)
+	}
+}
+
+func (test *builtinEventStateTest) createTestShouldMapTerraformResourceStateToModelWithoutPreviousStateWhenNoRawStateExists() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.BuiltinEventSpecificationState](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldBuiltinEventID, builtinEventStateBuiltinEventID)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldEnabled, true)
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.BuiltinEventSpecificationState{ID: builtinEventStateBuiltinEventID, Enabled: true}, result)
+	}
+}
@@ -0,0 +1,263 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	//GeoLocationConfigFieldGeoDetailRemoval constant value for field geo_detail_removal of the geo location configuration of websites and mobile apps
+	GeoLocationConfigFieldGeoDetailRemoval = "geo_detail_removal"
+	//GeoLocationConfigFieldGeoMappingRule constant value for field geo_mapping_rule of the geo location configuration of websites and mobile apps
+	GeoLocationConfigFieldGeoMappingRule = "geo_mapping_rule"
+	//GeoMappingRuleFieldCidr constant value for field geo_mapping_rule.cidr of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldCidr = "cidr"
+	//GeoMappingRuleFieldAccuracyRadius constant value for field geo_mapping_rule.accuracy_radius of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldAccuracyRadius = "accuracy_radius"
+	//GeoMappingRuleFieldCity constant value for field geo_mapping_rule.city of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldCity = "city"
+	//GeoMappingRuleFieldContinent constant value for field geo_mapping_rule.continent of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldContinent = "continent"
+	//GeoMappingRuleFieldContinentCode constant value for field geo_mapping_rule.continent_code of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldContinentCode = "continent_code"
+	//GeoMappingRuleFieldCountry constant value for field geo_mapping_rule.country of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldCountry = "country"
+	//GeoMappingRuleFieldCountryCode constant value for field geo_mapping_rule.country_code of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldCountryCode = "country_code"
+	//GeoMappingRuleFieldLatitude constant value for field geo_mapping_rule.latitude of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldLatitude = "latitude"
+	//GeoMappingRuleFieldLongitude constant value for field geo_mapping_rule.longitude of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldLongitude = "longitude"
+	//GeoMappingRuleFieldSubdivision constant value for field geo_mapping_rule.subdivision of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldSubdivision = "subdivision"
+	//GeoMappingRuleFieldSubdivisionCode constant value for field geo_mapping_rule.subdivision.code of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldSubdivisionCode = "code"
+	//GeoMappingRuleFieldSubdivisionName constant value for field geo_mapping_rule.subdivision.name of the geo location configuration of websites and mobile apps
+	GeoMappingRuleFieldSubdivisionName = "name"
+)
+
+// GeoLocationConfigSchemaGeoDetailRemoval schema field definition of the geo detail removal of websites and mobile apps
+var GeoLocationConfigSchemaGeoDetailRemoval = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	ValidateFunc: validation.StringInSlice(restapi.SupportedGeoDetailRemovals.ToStringSlice(), false),
+	Description:  "Defines which details of the geo location are removed from the collected data",
+}
+
+// GeoLocationConfigSchemaGeoMappingRule schema field definition of the custom geo mapping rules of websites and mobile apps
+var GeoLocationConfigSchemaGeoMappingRule = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	MinItems:    0,
+	MaxItems:    512,
+	Description: "Custom geo mapping rules which map an IP range to a geo location",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			GeoMappingRuleFieldCidr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "The IP range in CIDR notation which is mapped by the rule",
+			},
+			GeoMappingRuleFieldAccuracyRadius: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+				Description:  "The accuracy radius of the geo location in kilometers",
+			},
+			GeoMappingRuleFieldCity: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The city of the geo location",
+			},
+			GeoMappingRuleFieldContinent: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The continent of the geo location",
+			},
+			GeoMappingRuleFieldContinentCode: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The code of the continent of the geo location",
+			},
+			GeoMappingRuleFieldCountry: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The country of the geo location",
+			},
+			GeoMappingRuleFieldCountryCode: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ISO code of the country of the geo location",
+			},
+			GeoMappingRuleFieldLatitude: {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(-90, 90),
+				Description:  "The latitude of the geo location",
+			},
+			GeoMappingRuleFieldLongitude: {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(-180, 180),
+				Description:  "The longitude of the geo location",
+			},
+			GeoMappingRuleFieldSubdivision: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MinItems:    0,
+				MaxItems:    8,
+				Description: "The subdivisions (e.g. state or province) of the geo location",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						GeoMappingRuleFieldSubdivisionCode: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 32),
+							Description:  "The code of the subdivision",
+						},
+						GeoMappingRuleFieldSubdivisionName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 256),
+							Description:  "The name of the subdivision",
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func newGeoLocationConfigResourceHandle(resourceName string, parentIDFieldName string, parentIDDescription string, restResourceProvider func(api restapi.InstanaAPI) restapi.RestResource[*restapi.GeoLocationConfig]) ResourceHandle[*restapi.GeoLocationConfig] {
+	return &geoLocationConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: resourceName,
+			Schema: map[string]*schema.Schema{
+				parentIDFieldName: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: parentIDDescription,
+				},
+				GeoLocationConfigFieldGeoDetailRemoval: GeoLocationConfigSchemaGeoDetailRemoval,
+				GeoLocationConfigFieldGeoMappingRule:   GeoLocationConfigSchemaGeoMappingRule,
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			ResourceIDField:  &parentIDFieldName,
+		},
+		parentIDFieldName:    parentIDFieldName,
+		restResourceProvider: restResourceProvider,
+	}
+}
+
+type geoLocationConfigResource struct {
+	metaData             ResourceMetaData
+	parentIDFieldName    string
+	restResourceProvider func(api restapi.InstanaAPI) restapi.RestResource[*restapi.GeoLocationConfig]
+}
+
+func (r *geoLocationConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *geoLocationConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *geoLocationConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GeoLocationConfig] {
+	return r.restResourceProvider(api)
+}
+
+func (r *geoLocationConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *geoLocationConfigResource) UpdateState(d *schema.ResourceData, config *restapi.GeoLocationConfig) error {
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		r.parentIDFieldName:                    config.ID,
+		GeoLocationConfigFieldGeoDetailRemoval: string(config.GeoDetailRemoval),
+		GeoLocationConfigFieldGeoMappingRule:   mapGeoMappingRulesToSchema(config.GeoMappingRules),
+	})
+}
+
+func (r *geoLocationConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GeoLocationConfig, error) {
+	return &restapi.GeoLocationConfig{
+		ID:               d.Get(r.parentIDFieldName).(string),
+		GeoDetailRemoval: restapi.GeoDetailRemoval(d.Get(GeoLocationConfigFieldGeoDetailRemoval).(string)),
+		GeoMappingRules:  mapGeoMappingRulesFromSchema(d.Get(GeoLocationConfigFieldGeoMappingRule).([]interface{})),
+	}, nil
+}
+
+func mapGeoMappingRulesToSchema(rules []restapi.GeoMappingRule) []interface{} {
+	result := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		ruleAttributes := map[string]interface{}{
+			GeoMappingRuleFieldCidr:          rule.Cidr,
+			GeoMappingRuleFieldCity:          rule.City,
+			GeoMappingRuleFieldContinent:     rule.Continent,
+			GeoMappingRuleFieldContinentCode: rule.ContinentCode,
+			GeoMappingRuleFieldCountry:       rule.Country,
+			GeoMappingRuleFieldCountryCode:   rule.CountryCode,
+			GeoMappingRuleFieldLatitude:      rule.Latitude,
+			GeoMappingRuleFieldLongitude:     rule.Longitude,
+			GeoMappingRuleFieldSubdivision:   mapGeoSubdivisionsToSchema(rule.Subdivisions),
+		}
+		if rule.AccuracyRadius != nil {
+			ruleAttributes[GeoMappingRuleFieldAccuracyRadius] = int(*rule.AccuracyRadius)
+		}
+		result[i] = ruleAttributes
+	}
+	return result
+}
+
+func mapGeoSubdivisionsToSchema(subdivisions []restapi.GeoSubdivision) []interface{} {
+	result := make([]interface{}, len(subdivisions))
+	for i, subdivision := range subdivisions {
+		result[i] = map[string]interface{}{
+			GeoMappingRuleFieldSubdivisionCode: subdivision.Code,
+			GeoMappingRuleFieldSubdivisionName: subdivision.Name,
+		}
+	}
+	return result
+}
+
+func mapGeoMappingRulesFromSchema(input []interface{}) []restapi.GeoMappingRule {
+	result := make([]restapi.GeoMappingRule, 0, len(input))
+	for _, v := range input {
+		ruleData := v.(map[string]interface{})
+		rule := restapi.GeoMappingRule{
+			Cidr:          ruleData[GeoMappingRuleFieldCidr].(string),
+			City:          GetPointerFromMap[string](ruleData, GeoMappingRuleFieldCity),
+			Continent:     GetPointerFromMap[string](ruleData, GeoMappingRuleFieldContinent),
+			ContinentCode: GetPointerFromMap[string](ruleData, GeoMappingRuleFieldContinentCode),
+			Country:       GetPointerFromMap[string](ruleData, GeoMappingRuleFieldCountry),
+			CountryCode:   GetPointerFromMap[string](ruleData, GeoMappingRuleFieldCountryCode),
+			Latitude:      GetPointerFromMap[float64](ruleData, GeoMappingRuleFieldLatitude),
+			Longitude:     GetPointerFromMap[float64](ruleData, GeoMappingRuleFieldLongitude),
+			Subdivisions:  mapGeoSubdivisionsFromSchema(ruleData[GeoMappingRuleFieldSubdivision].([]interface{})),
+		}
+		if accuracyRadius := GetPointerFromMap[int](ruleData, GeoMappingRuleFieldAccuracyRadius); accuracyRadius != nil {
+			value := int64(*accuracyRadius)
+			rule.AccuracyRadius = &value
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+func mapGeoSubdivisionsFromSchema(input []interface{}) []restapi.GeoSubdivision {
+	result := make([]restapi.GeoSubdivision, 0, len(input))
+	for _, v := range input {
+		subdivisionData := v.(map[string]interface{})
+		result = append(result, restapi.GeoSubdivision{
+			Code: GetPointerFromMap[string](subdivisionData, GeoMappingRuleFieldSubdivisionCode),
+			Name: subdivisionData[GeoMappingRuleFieldSubdivisionName].(string),
+		})
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package instana_test
 import (
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -10,3 +11,16 @@ func TestGlobalApplicationAlertConfig(t *testing.T) {
 	commonTests := createApplicationAlertConfigTestFor("instana_global_application_alert_config", restapi.GlobalApplicationAlertConfigsResourcePath, NewGlobalApplicationAlertConfigResourceHandle())
 	commonTests.run(t)
 }
+
+func TestGlobalApplicationAlertConfigShouldNotSupportBaselineUpdateTrigger(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	sut := NewGlobalApplicationAlertConfigResourceHandle()
+	resourceData := testHelper.CreateResourceDataForResourceHandle(sut, newMinimalApplicationAlertConfigState(map[string]interface{}{}))
+	resourceData.SetId("global-application-alert-config-id")
+
+	result, err := sut.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.NotContains(t, sut.MetaData().Schema, ApplicationAlertConfigFieldBaselineUpdateTrigger)
+	require.False(t, result.UpdateBaseline)
+}
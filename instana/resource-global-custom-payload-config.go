@@ -0,0 +1,61 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaGlobalCustomPayloadConfig the name of the terraform-provider-instana resource to manage the global custom payload configuration of the Instana tenant
+const ResourceInstanaGlobalCustomPayloadConfig = "instana_global_custom_payload_config"
+
+// NewGlobalCustomPayloadConfigResourceHandle creates the resource handle for the global custom payload configuration of the Instana tenant
+func NewGlobalCustomPayloadConfigResourceHandle() ResourceHandle[*restapi.CustomPayloadConfiguration] {
+	return &globalCustomPayloadConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGlobalCustomPayloadConfig,
+			Schema: map[string]*schema.Schema{
+				DefaultCustomPayloadFieldsName: buildCustomPayloadFields(),
+			},
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type globalCustomPayloadConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *globalCustomPayloadConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *globalCustomPayloadConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *globalCustomPayloadConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.CustomPayloadConfiguration] {
+	return api.CustomPayloadConfiguration()
+}
+
+func (r *globalCustomPayloadConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *globalCustomPayloadConfigResource) UpdateState(d *schema.ResourceData, config *restapi.CustomPayloadConfiguration) error {
+	d.SetId(config.GetIDForResourcePath())
+	return tfutils.UpdateState(d, map[string]interface{}{
+		DefaultCustomPayloadFieldsName: mapCustomPayloadFieldsToSchema(config),
+	})
+}
+
+func (r *globalCustomPayloadConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.CustomPayloadConfiguration, error) {
+	customPayloadFields, err := mapDefaultCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return nil, err
+	}
+	return &restapi.CustomPayloadConfiguration{
+		Fields: customPayloadFields,
+	}, nil
+}
@@ -0,0 +1,239 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestGlobalCustomPayloadConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaGlobalCustomPayloadConfig + ".example"
+	inst := &globalCustomPayloadConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewGlobalCustomPayloadConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type globalCustomPayloadConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.CustomPayloadConfiguration]
+}
+
+var globalCustomPayloadConfigTerraformTemplate = `
+resource "instana_global_custom_payload_config" "example" {
+	custom_payload_field {
+		key    = "static-key"
+		value  = "%s"
+	}
+
+	custom_payload_field {
+		key = "dynamic-key"
+		dynamic_value {
+			key      = "dynamic-value-key"
+			tag_name = "dynamic-value-tag-name"
+		}
+	}
+}
+`
+
+func (test *globalCustomPayloadConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaGlobalCustomPayloadConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaGlobalCustomPayloadConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaGlobalCustomPayloadConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaGlobalCustomPayloadConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaGlobalCustomPayloadConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaGlobalCustomPayloadConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state to model when custom payload field is not valid", ResourceInstanaGlobalCustomPayloadConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenCustomPayloadFieldIsNotValid())
+}
+
+func (test *globalCustomPayloadConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		serverState := &restapi.CustomPayloadConfiguration{Fields: []restapi.CustomPayloadField[any]{}}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, restapi.CustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.CustomPayloadConfiguration{}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, config)
+			serverState = config
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.CustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			serverState = &restapi.CustomPayloadConfiguration{Fields: []restapi.CustomPayloadField[any]{}}
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.CustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(serverState)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), "value-0"),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.CustomPayloadConfigurationID),
+				test.createIntegrationTestStep(httpServer.GetPort(), "value-1"),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.CustomPayloadConfigurationID),
+			},
+		})
+	}
+}
+
+func (test *globalCustomPayloadConfigTest) createIntegrationTestStep(httpPort int, staticValue string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(globalCustomPayloadConfigTerraformTemplate, staticValue), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", restapi.CustomPayloadConfigurationID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.#", DefaultCustomPayloadFieldsName), "2"),
+			resource.TestCheckTypeSetElemNestedAttrs(test.terraformResourceInstanceName, fmt.Sprintf("%s.*", DefaultCustomPayloadFieldsName), map[string]string{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldStaticStringValue: staticValue,
+			}),
+			resource.TestCheckTypeSetElemNestedAttrs(test.terraformResourceInstanceName, fmt.Sprintf("%s.*", DefaultCustomPayloadFieldsName), map[string]string{
+				CustomPayloadFieldsFieldKey: "dynamic-key",
+				fmt.Sprintf("%s.0.%s", CustomPayloadFieldsFieldDynamicValue, CustomPayloadFieldsFieldDynamicKey):     "dynamic-value-key",
+				fmt.Sprintf("%s.0.%s", CustomPayloadFieldsFieldDynamicValue, CustomPayloadFieldsFieldDynamicTagName): "dynamic-value-tag-name",
+			}),
+		),
+	}
+}
+
+func (test *globalCustomPayloadConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *globalCustomPayloadConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *globalCustomPayloadConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_global_custom_payload_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *globalCustomPayloadConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		dynamicValueKey := "dynamic-value-key"
+		dynamicValueTagName := "dynamic-value-tag-name"
+		config := &restapi.CustomPayloadConfiguration{
+			Fields: []restapi.CustomPayloadField[any]{
+				{
+					Type:  restapi.StaticStringCustomPayloadType,
+					Key:   "static-key",
+					Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+				},
+				{
+					Type:  restapi.DynamicCustomPayloadType,
+					Key:   "dynamic-key",
+					Value: restapi.DynamicCustomPayloadFieldValue{Key: &dynamicValueKey, TagName: dynamicValueTagName},
+				},
+			},
+		}
+		testHelper := NewTestHelper[*restapi.CustomPayloadConfiguration](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, restapi.CustomPayloadConfigurationID, resourceData.Id())
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+			},
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "dynamic-key",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{map[string]interface{}{CustomPayloadFieldsFieldDynamicKey: dynamicValueKey, CustomPayloadFieldsFieldDynamicTagName: dynamicValueTagName}},
+				CustomPayloadFieldsFieldStaticStringValue: "",
+			},
+		}, resourceData.Get(DefaultCustomPayloadFieldsName).(*schema.Set).List())
+	}
+}
+
+func (test *globalCustomPayloadConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		dynamicValueKey := "dynamic-value-key"
+		dynamicValueTagName := "dynamic-value-tag-name"
+		testHelper := NewTestHelper[*restapi.CustomPayloadConfiguration](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+			},
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:          "dynamic-key",
+				CustomPayloadFieldsFieldDynamicValue: []interface{}{map[string]interface{}{CustomPayloadFieldsFieldDynamicKey: dynamicValueKey, CustomPayloadFieldsFieldDynamicTagName: dynamicValueTagName}},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.CustomPayloadConfiguration{
+			Fields: []restapi.CustomPayloadField[any]{
+				{
+					Type:  restapi.StaticStringCustomPayloadType,
+					Key:   "static-key",
+					Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+				},
+				{
+					Type:  restapi.DynamicCustomPayloadType,
+					Key:   "dynamic-key",
+					Value: restapi.DynamicCustomPayloadFieldValue{Key: &dynamicValueKey, TagName: dynamicValueTagName},
+				},
+			},
+		}, result)
+	}
+}
+
+func (test *globalCustomPayloadConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenCustomPayloadFieldIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.CustomPayloadConfiguration](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "dynamic-key",
+				CustomPayloadFieldsFieldStaticStringValue: "invalid",
+				CustomPayloadFieldsFieldDynamicValue: []interface{}{
+					map[string]interface{}{
+						CustomPayloadFieldsFieldDynamicKey:     "dynamic-value-key",
+						CustomPayloadFieldsFieldDynamicTagName: "dynamic-value-tag-name",
+					},
+				},
+			},
+		})
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.ErrorContains(t, err, "either a static string value or a dynamic value must")
+	}
+}
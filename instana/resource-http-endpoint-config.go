@@ -0,0 +1,227 @@
+package instana
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaHttpEndpointConfig the name of the terraform-provider-instana resource to manage http endpoint configs of services
+const ResourceInstanaHttpEndpointConfig = "instana_http_endpoint_config"
+
+const (
+	//HttpEndpointConfigFieldServiceID constant value for the schema field service_id
+	HttpEndpointConfigFieldServiceID = "service_id"
+	//HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled constant value for the schema field endpoint_name_by_first_path_segment_rule_enabled
+	HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled = "endpoint_name_by_first_path_segment_rule_enabled"
+	//HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled constant value for the schema field endpoint_name_by_collected_path_template_rule_enabled
+	HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled = "endpoint_name_by_collected_path_template_rule_enabled"
+	//HttpEndpointConfigFieldRule constant value for the schema field rule
+	HttpEndpointConfigFieldRule = "rule"
+	//HttpEndpointConfigFieldRuleEnabled constant value for the schema field rule.enabled
+	HttpEndpointConfigFieldRuleEnabled = "enabled"
+	//HttpEndpointConfigFieldRulePathTemplate constant value for the schema field rule.path_template
+	HttpEndpointConfigFieldRulePathTemplate = "path_template"
+	//HttpEndpointConfigFieldRuleTestCases constant value for the schema field rule.test_cases
+	HttpEndpointConfigFieldRuleTestCases = "test_cases"
+)
+
+const (
+	httpPathTemplateSeparator       = "/"
+	httpPathTemplateMatchAllSegment = "**"
+	httpPathTemplateMaxSegments     = 16
+)
+
+var (
+	httpPathTemplateParameterRegex = regexp.MustCompile(`^\{([a-zA-Z0-9_.\-]+)}$`)
+	httpPathTemplateFixedRegex     = regexp.MustCompile(`^[^{}*/]+$`)
+)
+
+// NewHttpEndpointConfigResourceHandle creates the resource handle for http endpoint configs
+func NewHttpEndpointConfigResourceHandle() ResourceHandle[*restapi.HttpEndpointConfig] {
+	serviceIDField := HttpEndpointConfigFieldServiceID
+	return &httpEndpointConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaHttpEndpointConfig,
+			Schema: map[string]*schema.Schema{
+				HttpEndpointConfigFieldServiceID: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The ID of the service to which the http endpoint config is applied",
+				},
+				HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether endpoints are named by the first path segment when no rule matches",
+				},
+				HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether endpoints are named by the path template collected by the tracer when no rule matches",
+				},
+				HttpEndpointConfigFieldRule: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 500,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							HttpEndpointConfigFieldRuleEnabled: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Flag to indicate whether the rule is enabled or not",
+							},
+							HttpEndpointConfigFieldRulePathTemplate: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateHttpPathTemplate,
+								Description:  "The path template of the rule, e.g. /api/{version}/**. Path parameters are defined in curly braces and ** matches all remaining path segments",
+							},
+							HttpEndpointConfigFieldRuleTestCases: {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 32,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+								Description: "List of http paths which must be matched by the rule",
+							},
+						},
+					},
+					Description: "The ordered list of rules which define how http paths are collapsed into endpoints",
+				},
+			},
+			SkipIDGeneration: true,
+			ResourceIDField:  &serviceIDField,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type httpEndpointConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *httpEndpointConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *httpEndpointConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *httpEndpointConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.HttpEndpointConfig] {
+	return api.HttpEndpointConfigs()
+}
+
+func (r *httpEndpointConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *httpEndpointConfigResource) UpdateState(d *schema.ResourceData, config *restapi.HttpEndpointConfig) error {
+	rules := make([]interface{}, len(config.Rules))
+	for i, rule := range config.Rules {
+		pathTemplate, err := formatHttpPathTemplate(rule.PathSegments)
+		if err != nil {
+			return err
+		}
+		rules[i] = map[string]interface{}{
+			HttpEndpointConfigFieldRuleEnabled:      rule.Enabled,
+			HttpEndpointConfigFieldRulePathTemplate: pathTemplate,
+			HttpEndpointConfigFieldRuleTestCases:    rule.TestCases,
+		}
+	}
+
+	d.SetId(config.ServiceID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		HttpEndpointConfigFieldServiceID:                                      config.ServiceID,
+		HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled:      config.EndpointNameByFirstPathSegmentRuleEnabled,
+		HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: config.EndpointNameByCollectedPathTemplateRuleEnabled,
+		HttpEndpointConfigFieldRule:                                           rules,
+	})
+}
+
+func (r *httpEndpointConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.HttpEndpointConfig, error) {
+	rulesSlice := d.Get(HttpEndpointConfigFieldRule).([]interface{})
+	rules := make([]restapi.HttpEndpointRule, len(rulesSlice))
+	for i, v := range rulesSlice {
+		rule := v.(map[string]interface{})
+		pathSegments, err := parseHttpPathTemplate(rule[HttpEndpointConfigFieldRulePathTemplate].(string))
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = restapi.HttpEndpointRule{
+			Enabled:      rule[HttpEndpointConfigFieldRuleEnabled].(bool),
+			PathSegments: pathSegments,
+			TestCases:    ConvertInterfaceSlice[string](rule[HttpEndpointConfigFieldRuleTestCases].([]interface{})),
+		}
+	}
+
+	return &restapi.HttpEndpointConfig{
+		ServiceID: d.Get(HttpEndpointConfigFieldServiceID).(string),
+		EndpointNameByFirstPathSegmentRuleEnabled:      d.Get(HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool),
+		EndpointNameByCollectedPathTemplateRuleEnabled: d.Get(HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool),
+		Rules: rules,
+	}, nil
+}
+
+var validateHttpPathTemplate = func(val interface{}, key string) (warns []string, errs []error) {
+	if _, err := parseHttpPathTemplate(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid path template; %s", key, err))
+	}
+	return
+}
+
+func parseHttpPathTemplate(pathTemplate string) ([]restapi.HttpPathSegmentMatchingRule, error) {
+	if !strings.HasPrefix(pathTemplate, httpPathTemplateSeparator) {
+		return nil, fmt.Errorf("path template %s must start with %s", pathTemplate, httpPathTemplateSeparator)
+	}
+	segments := strings.Split(strings.TrimPrefix(pathTemplate, httpPathTemplateSeparator), httpPathTemplateSeparator)
+	if len(segments) > httpPathTemplateMaxSegments {
+		return nil, fmt.Errorf("path template %s has more than %d path segments", pathTemplate, httpPathTemplateMaxSegments)
+	}
+
+	result := make([]restapi.HttpPathSegmentMatchingRule, len(segments))
+	for i, segment := range segments {
+		if segment == httpPathTemplateMatchAllSegment {
+			result[i] = restapi.HttpPathSegmentMatchingRule{Type: restapi.HttpPathSegmentMatchingTypeMatchAll}
+		} else if match := httpPathTemplateParameterRegex.FindStringSubmatch(segment); match != nil {
+			name := match[1]
+			result[i] = restapi.HttpPathSegmentMatchingRule{Type: restapi.HttpPathSegmentMatchingTypeParameter, Name: &name}
+		} else if httpPathTemplateFixedRegex.MatchString(segment) {
+			name := segment
+			result[i] = restapi.HttpPathSegmentMatchingRule{Type: restapi.HttpPathSegmentMatchingTypeFixed, Name: &name}
+		} else {
+			return nil, fmt.Errorf("path segment '%s' of path template %s is not valid; path segments must either be a fixed non empty name, a path parameter like {name} or %s", segment, pathTemplate, httpPathTemplateMatchAllSegment)
+		}
+	}
+	return result, nil
+}
+
+func formatHttpPathTemplate(pathSegments []restapi.HttpPathSegmentMatchingRule) (string, error) {
+	segments := make([]string, len(pathSegments))
+	for i, segment := range pathSegments {
+		name := ""
+		if segment.Name != nil {
+			name = *segment.Name
+		}
+		switch segment.Type {
+		case restapi.HttpPathSegmentMatchingTypeMatchAll:
+			segments[i] = httpPathTemplateMatchAllSegment
+		case restapi.HttpPathSegmentMatchingTypeParameter:
+			segments[i] = fmt.Sprintf("{%s}", name)
+		case restapi.HttpPathSegmentMatchingTypeFixed:
+			segments[i] = name
+		default:
+			return "", fmt.Errorf("path segment type %s is not supported", segment.Type)
+		}
+	}
+	return httpPathTemplateSeparator + strings.Join(segments, httpPathTemplateSeparator), nil
+}
@@ -0,0 +1,277 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestHttpEndpointConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaHttpEndpointConfig + ".example"
+	inst := &httpEndpointConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewHttpEndpointConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type httpEndpointConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.HttpEndpointConfig]
+}
+
+var httpEndpointConfigTerraformTemplate = `
+resource "instana_http_endpoint_config" "example" {
+	service_id = "service-id"
+	endpoint_name_by_first_path_segment_rule_enabled      = false
+	endpoint_name_by_collected_path_template_rule_enabled = true
+
+	rule {
+		enabled       = true
+		path_template = "/api/{version}/users%d/**"
+		test_cases    = [ "/api/v2/users%d/123" ]
+	}
+}
+`
+
+func (test *httpEndpointConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaHttpEndpointConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaHttpEndpointConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaHttpEndpointConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaHttpEndpointConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should use service id as resource id", ResourceInstanaHttpEndpointConfig), test.createTestResourceShouldUseServiceIDAsResourceID())
+	t.Run(fmt.Sprintf("%s should accept valid path templates", ResourceInstanaHttpEndpointConfig), test.createTestShouldAcceptValidPathTemplates())
+	t.Run(fmt.Sprintf("%s should reject invalid path templates", ResourceInstanaHttpEndpointConfig), test.createTestShouldRejectInvalidPathTemplates())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaHttpEndpointConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should fail to update terraform state when path segment type is not supported", ResourceInstanaHttpEndpointConfig), test.createTestShouldFailToUpdateTerraformResourceStateWhenPathSegmentTypeIsNotSupported())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaHttpEndpointConfig), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *httpEndpointConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var serverState *restapi.HttpEndpointConfig
+		writeJSON := func(w http.ResponseWriter, r *http.Request, data interface{}) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(data)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+		storeConfig := func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.HttpEndpointConfig{}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, config)
+			serverState = config
+			writeJSON(w, r, serverState)
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.HttpEndpointConfigResourcePath, storeConfig)
+		httpServer.AddRoute(http.MethodPut, restapi.HttpEndpointConfigResourcePath+"/{id}", storeConfig)
+		httpServer.AddRoute(http.MethodDelete, restapi.HttpEndpointConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			serverState = nil
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.HttpEndpointConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if serverState == nil || serverState.ServiceID != mux.Vars(r)["id"] {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeJSON(w, r, serverState)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *httpEndpointConfigTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	ruleEnabled := fmt.Sprintf("%s.0.%s", HttpEndpointConfigFieldRule, HttpEndpointConfigFieldRuleEnabled)
+	rulePathTemplate := fmt.Sprintf("%s.0.%s", HttpEndpointConfigFieldRule, HttpEndpointConfigFieldRulePathTemplate)
+	ruleTestCase := fmt.Sprintf("%s.0.%s.0", HttpEndpointConfigFieldRule, HttpEndpointConfigFieldRuleTestCases)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(httpEndpointConfigTerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", "service-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, HttpEndpointConfigFieldServiceID, "service-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, falseAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleEnabled, trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, rulePathTemplate, fmt.Sprintf("/api/{version}/users%d/**", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleTestCase, fmt.Sprintf("/api/v2/users%d/123", iteration)),
+		),
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_http_endpoint_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestResourceShouldUseServiceIDAsResourceID() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.True(t, test.resourceHandle.MetaData().SkipIDGeneration)
+		require.Equal(t, HttpEndpointConfigFieldServiceID, *test.resourceHandle.MetaData().ResourceIDField)
+		require.True(t, test.resourceHandle.MetaData().Schema[HttpEndpointConfigFieldServiceID].ForceNew)
+	}
+}
+
+func (test *httpEndpointConfigTest) pathTemplateSchema() *schema.Schema {
+	return test.resourceHandle.MetaData().Schema[HttpEndpointConfigFieldRule].Elem.(*schema.Resource).Schema[HttpEndpointConfigFieldRulePathTemplate]
+}
+
+func (test *httpEndpointConfigTest) createTestShouldAcceptValidPathTemplates() func(t *testing.T) {
+	return func(t *testing.T) {
+		for _, pathTemplate := range []string{"/**", "/api", "/api/{version}/**", "/api/v1/users/{user-id}/orders/{order_id}", "/static/main.js"} {
+			t.Run(pathTemplate, func(t *testing.T) {
+				warns, errs := test.pathTemplateSchema().ValidateFunc(pathTemplate, HttpEndpointConfigFieldRulePathTemplate)
+				require.Empty(t, warns)
+				require.Empty(t, errs)
+			})
+		}
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldRejectInvalidPathTemplates() func(t *testing.T) {
+	return func(t *testing.T) {
+		for _, pathTemplate := range []string{"", "/", "api/v1", "/api//v1", "/api/", "/api/{}", "/api/{version", "/api/v*", "/api/***", "/1/2/3/4/5/6/7/8/9/10/11/12/13/14/15/16/17"} {
+			t.Run(pathTemplate, func(t *testing.T) {
+				warns, errs := test.pathTemplateSchema().ValidateFunc(pathTemplate, HttpEndpointConfigFieldRulePathTemplate)
+				require.Empty(t, warns)
+				require.Len(t, errs, 1)
+			})
+		}
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		api := "api"
+		version := "version"
+		config := &restapi.HttpEndpointConfig{
+			ServiceID: "service-id",
+			EndpointNameByFirstPathSegmentRuleEnabled:      true,
+			EndpointNameByCollectedPathTemplateRuleEnabled: false,
+			Rules: []restapi.HttpEndpointRule{
+				{
+					Enabled: true,
+					PathSegments: []restapi.HttpPathSegmentMatchingRule{
+						{Type: restapi.HttpPathSegmentMatchingTypeFixed, Name: &api},
+						{Type: restapi.HttpPathSegmentMatchingTypeParameter, Name: &version},
+						{Type: restapi.HttpPathSegmentMatchingTypeMatchAll},
+					},
+					TestCases: []string{"/api/v2/users"},
+				},
+			},
+		}
+		testHelper := NewTestHelper[*restapi.HttpEndpointConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "service-id", resourceData.Id())
+		require.Equal(t, "service-id", resourceData.Get(HttpEndpointConfigFieldServiceID))
+		require.True(t, resourceData.Get(HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool))
+		require.False(t, resourceData.Get(HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				HttpEndpointConfigFieldRuleEnabled:      true,
+				HttpEndpointConfigFieldRulePathTemplate: "/api/{version}/**",
+				HttpEndpointConfigFieldRuleTestCases:    []interface{}{"/api/v2/users"},
+			},
+		}, resourceData.Get(HttpEndpointConfigFieldRule))
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldFailToUpdateTerraformResourceStateWhenPathSegmentTypeIsNotSupported() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := &restapi.HttpEndpointConfig{
+			ServiceID: "service-id",
+			Rules: []restapi.HttpEndpointRule{
+				{PathSegments: []restapi.HttpPathSegmentMatchingRule{{Type: restapi.HttpPathSegmentMatchingType("UNSUPPORTED")}}},
+			},
+		}
+		testHelper := NewTestHelper[*restapi.HttpEndpointConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, config)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "UNSUPPORTED")
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HttpEndpointConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldServiceID, "service-id")
+		setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, false)
+		setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldRule, []interface{}{
+			map[string]interface{}{
+				HttpEndpointConfigFieldRuleEnabled:      false,
+				HttpEndpointConfigFieldRulePathTemplate: "/api/{version}/**",
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		api := "api"
+		version := "version"
+		require.NoError(t, err)
+		require.Equal(t, &restapi.HttpEndpointConfig{
+			ServiceID: "service-id",
+			EndpointNameByFirstPathSegmentRuleEnabled:      false,
+			EndpointNameByCollectedPathTemplateRuleEnabled: true,
+			Rules: []restapi.HttpEndpointRule{
+				{
+					Enabled: false,
+					PathSegments: []restapi.HttpPathSegmentMatchingRule{
+						{Type: restapi.HttpPathSegmentMatchingTypeFixed, Name: &api},
+						{Type: restapi.HttpPathSegmentMatchingTypeParameter, Name: &version},
+						{Type: restapi.HttpPathSegmentMatchingTypeMatchAll},
+					},
+					TestCases: []string{},
+				},
+			},
+		}, result)
+	}
+}
@@ -0,0 +1,387 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaInfraAlertConfig the name of the terraform-provider-instana resource to manage infrastructure alert configs
+const ResourceInstanaInfraAlertConfig = "instana_infra_alert_config"
+
+const (
+	//InfraAlertConfigFieldAlertChannelIDs constant value for field alert_channel_ids of resource instana_infra_alert_config
+	InfraAlertConfigFieldAlertChannelIDs = "alert_channel_ids"
+	//InfraAlertConfigFieldDescription constant value for field description of resource instana_infra_alert_config
+	InfraAlertConfigFieldDescription = "description"
+	//InfraAlertConfigFieldGranularity constant value for field granularity of resource instana_infra_alert_config
+	InfraAlertConfigFieldGranularity = "granularity"
+	//InfraAlertConfigFieldGroupBy constant value for field group_by of resource instana_infra_alert_config
+	InfraAlertConfigFieldGroupBy = "group_by"
+	//InfraAlertConfigFieldName constant value for field name of resource instana_infra_alert_config
+	InfraAlertConfigFieldName = "name"
+
+	//InfraAlertConfigFieldRule constant value for field rule of resource instana_infra_alert_config
+	InfraAlertConfigFieldRule = "rule"
+	//InfraAlertConfigFieldRuleGenericRule constant value for field rule.generic_rule of resource instana_infra_alert_config
+	InfraAlertConfigFieldRuleGenericRule = "generic_rule"
+	//InfraAlertConfigFieldRuleMetricName constant value for field rule.*.metric_name of resource instana_infra_alert_config
+	InfraAlertConfigFieldRuleMetricName = "metric_name"
+	//InfraAlertConfigFieldRuleEntityType constant value for field rule.*.entity_type of resource instana_infra_alert_config
+	InfraAlertConfigFieldRuleEntityType = "entity_type"
+	//InfraAlertConfigFieldRuleAggregation constant value for field rule.*.aggregation of resource instana_infra_alert_config
+	InfraAlertConfigFieldRuleAggregation = "aggregation"
+	//InfraAlertConfigFieldRuleCrossSeriesAggregation constant value for field rule.*.cross_series_aggregation of resource instana_infra_alert_config
+	InfraAlertConfigFieldRuleCrossSeriesAggregation = "cross_series_aggregation"
+
+	//InfraAlertConfigFieldSeverity constant value for field severity of resource instana_infra_alert_config
+	InfraAlertConfigFieldSeverity = "severity"
+	//InfraAlertConfigFieldTagFilter constant value for field tag_filter of resource instana_infra_alert_config
+	InfraAlertConfigFieldTagFilter = "tag_filter"
+
+	//InfraAlertConfigFieldTimeThreshold constant value for field time_threshold of resource instana_infra_alert_config
+	InfraAlertConfigFieldTimeThreshold = "time_threshold"
+	//InfraAlertConfigFieldTimeThresholdViolationsInSequence constant value for field time_threshold.violations_in_sequence of resource instana_infra_alert_config
+	InfraAlertConfigFieldTimeThresholdViolationsInSequence = "violations_in_sequence"
+	//InfraAlertConfigFieldTimeThresholdTimeWindow constant value for field time_threshold.*.time_window of resource instana_infra_alert_config
+	InfraAlertConfigFieldTimeThresholdTimeWindow = "time_window"
+)
+
+var (
+	infraAlertConfigSchemaDescription = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The description text of the infrastructure alert config",
+		ValidateFunc: validation.StringLenBetween(0, 65536),
+	}
+	infraAlertConfigSchemaGroupBy = &schema.Schema{
+		Type:     schema.TypeList,
+		MinItems: 0,
+		MaxItems: 5,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		Description: "The list of tag names used to group the entities for the evaluation of the alert",
+	}
+	infraAlertConfigSchemaName = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Name for the infrastructure alert configuration",
+		ValidateFunc: validation.StringLenBetween(0, 256),
+	}
+	infraAlertConfigSchemaRule = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of rule this alert configuration is about.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				InfraAlertConfigFieldRuleGenericRule: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Generic rule based on a metric of the configured entity type",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							InfraAlertConfigFieldRuleMetricName: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The metric name of the infrastructure alert rule",
+							},
+							InfraAlertConfigFieldRuleEntityType: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The entity type of the infrastructure alert rule",
+							},
+							InfraAlertConfigFieldRuleAggregation: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(restapi.SupportedAggregations.ToStringSlice(), false),
+								Description:  "The aggregation function applied to the metric of a single entity",
+							},
+							InfraAlertConfigFieldRuleCrossSeriesAggregation: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(restapi.SupportedAggregations.ToStringSlice(), false),
+								Description:  "The aggregation function applied across the metric series of all entities of a group",
+							},
+						},
+					},
+					ExactlyOneOf: infraAlertConfigRuleTypeKeys,
+				},
+			},
+		},
+	}
+	infraAlertConfigSchemaTimeThreshold = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of violation of the defined threshold.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				InfraAlertConfigFieldTimeThresholdViolationsInSequence: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Time threshold base on violations in sequence",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							InfraAlertConfigFieldTimeThresholdTimeWindow: {
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "The time window if the time threshold",
+							},
+						},
+					},
+					ExactlyOneOf: infraAlertConfigTimeThresholdTypeKeys,
+				},
+			},
+		},
+	}
+	infraAlertConfigRuleTypeKeys = []string{
+		"rule.0.generic_rule",
+	}
+	infraAlertConfigTimeThresholdTypeKeys = []string{
+		"time_threshold.0.violations_in_sequence",
+	}
+)
+
+var infraAlertConfigResourceSchema = map[string]*schema.Schema{
+	InfraAlertConfigFieldAlertChannelIDs: applicationAlertConfigSchemaAlertChannelIDs,
+	DefaultCustomPayloadFieldsName:       buildCustomPayloadFields(),
+	InfraAlertConfigFieldDescription:     infraAlertConfigSchemaDescription,
+	InfraAlertConfigFieldGranularity:     applicationAlertConfigSchemaGranularity,
+	InfraAlertConfigFieldGroupBy:         infraAlertConfigSchemaGroupBy,
+	InfraAlertConfigFieldName:            infraAlertConfigSchemaName,
+	InfraAlertConfigFieldRule:            infraAlertConfigSchemaRule,
+	InfraAlertConfigFieldSeverity:        applicationAlertConfigSchemaSeverity,
+	InfraAlertConfigFieldTagFilter:       OptionalTagFilterExpressionSchema,
+	ResourceFieldThreshold:               thresholdSchema,
+	InfraAlertConfigFieldTimeThreshold:   infraAlertConfigSchemaTimeThreshold,
+}
+
+// NewInfraAlertConfigResourceHandle creates the resource handle for infrastructure alert configs
+func NewInfraAlertConfigResourceHandle() ResourceHandle[*restapi.InfraAlertConfig] {
+	return &infraAlertConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName:     ResourceInstanaInfraAlertConfig,
+			Schema:           infraAlertConfigResourceSchema,
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type infraAlertConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *infraAlertConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *infraAlertConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *infraAlertConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.InfraAlertConfig] {
+	return api.InfraAlertConfigs()
+}
+
+func (r *infraAlertConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *infraAlertConfigResource) UpdateState(d *schema.ResourceData, config *restapi.InfraAlertConfig) error {
+	severity, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(config.Severity)
+	if err != nil {
+		return err
+	}
+	var normalizedTagFilterString *string
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		InfraAlertConfigFieldAlertChannelIDs: config.AlertChannelIDs,
+		DefaultCustomPayloadFieldsName:       mapCustomPayloadFieldsToSchema(config),
+		InfraAlertConfigFieldDescription:     config.Description,
+		InfraAlertConfigFieldGranularity:     config.Granularity,
+		InfraAlertConfigFieldGroupBy:         config.GroupBy,
+		InfraAlertConfigFieldName:            config.Name,
+		InfraAlertConfigFieldRule:            r.mapRuleToSchema(config),
+		InfraAlertConfigFieldSeverity:        severity,
+		InfraAlertConfigFieldTagFilter:       normalizedTagFilterString,
+		ResourceFieldThreshold:               newThresholdMapper().toState(&config.Threshold),
+		InfraAlertConfigFieldTimeThreshold:   r.mapTimeThresholdToSchema(config),
+	})
+}
+
+func (r *infraAlertConfigResource) mapRuleToSchema(config *restapi.InfraAlertConfig) []map[string]interface{} {
+	ruleAttribute := make(map[string]interface{})
+	ruleAttribute[InfraAlertConfigFieldRuleMetricName] = config.Rule.MetricName
+	ruleAttribute[InfraAlertConfigFieldRuleEntityType] = config.Rule.EntityType
+
+	if config.Rule.Aggregation != nil {
+		ruleAttribute[InfraAlertConfigFieldRuleAggregation] = string(*config.Rule.Aggregation)
+	}
+	if config.Rule.CrossSeriesAggregation != nil {
+		ruleAttribute[InfraAlertConfigFieldRuleCrossSeriesAggregation] = string(*config.Rule.CrossSeriesAggregation)
+	}
+
+	alertType := r.mapAlertTypeToSchema(config.Rule.AlertType)
+	rule := make(map[string]interface{})
+	rule[alertType] = []interface{}{ruleAttribute}
+	result := make([]map[string]interface{}, 1)
+	result[0] = rule
+	return result
+}
+
+func (r *infraAlertConfigResource) mapAlertTypeToSchema(alertType string) string {
+	if alertType == "genericRule" {
+		return InfraAlertConfigFieldRuleGenericRule
+	}
+	return alertType
+}
+
+func (r *infraAlertConfigResource) mapTimeThresholdToSchema(config *restapi.InfraAlertConfig) []map[string]interface{} {
+	timeThresholdConfig := make(map[string]interface{})
+	timeThresholdConfig[InfraAlertConfigFieldTimeThresholdTimeWindow] = config.TimeThreshold.TimeWindow
+
+	timeThresholdType := r.mapTimeThresholdTypeToSchema(config.TimeThreshold.Type)
+	timeThreshold := make(map[string]interface{})
+	timeThreshold[timeThresholdType] = []interface{}{timeThresholdConfig}
+	result := make([]map[string]interface{}, 1)
+	result[0] = timeThreshold
+	return result
+}
+
+func (r *infraAlertConfigResource) mapTimeThresholdTypeToSchema(input string) string {
+	if input == "violationsInSequence" {
+		return InfraAlertConfigFieldTimeThresholdViolationsInSequence
+	}
+	return input
+}
+
+func (r *infraAlertConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.InfraAlertConfig, error) {
+	severity, err := ConvertSeverityFromTerraformToInstanaAPIRepresentation(d.Get(InfraAlertConfigFieldSeverity).(string))
+	if err != nil {
+		return nil, err
+	}
+
+	var tagFilter *restapi.TagFilter
+	tagFilterStr, ok := d.GetOk(InfraAlertConfigFieldTagFilter)
+	if ok {
+		tagFilter, err = r.mapTagFilterExpressionFromSchema(tagFilterStr.(string))
+		if err != nil {
+			return &restapi.InfraAlertConfig{}, err
+		}
+	}
+
+	customPayloadFields, err := mapDefaultCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return &restapi.InfraAlertConfig{}, err
+	}
+
+	threshold := newThresholdMapper().fromState(d)
+
+	return &restapi.InfraAlertConfig{
+		ID:                    d.Id(),
+		AlertChannelIDs:       ReadStringSetParameterFromResource(d, InfraAlertConfigFieldAlertChannelIDs),
+		CustomerPayloadFields: customPayloadFields,
+		Description:           d.Get(InfraAlertConfigFieldDescription).(string),
+		Granularity:           restapi.Granularity(d.Get(InfraAlertConfigFieldGranularity).(int)),
+		GroupBy:               ConvertInterfaceSlice[string](d.Get(InfraAlertConfigFieldGroupBy).([]interface{})),
+		Name:                  d.Get(InfraAlertConfigFieldName).(string),
+		Rule:                  r.mapRuleFromSchema(d),
+		Severity:              severity,
+		TagFilterExpression:   tagFilter,
+		Threshold:             *threshold,
+		TimeThreshold:         r.mapTimeThresholdFromSchema(d),
+	}, nil
+}
+
+func (r *infraAlertConfigResource) mapTagFilterExpressionFromSchema(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
+
+func (r *infraAlertConfigResource) mapRuleFromSchema(d *schema.ResourceData) restapi.InfraAlertRule {
+	ruleSlice := d.Get(InfraAlertConfigFieldRule).([]interface{})
+	rule := ruleSlice[0].(map[string]interface{})
+	for alertType, v := range rule {
+		configSlice := v.([]interface{})
+		if len(configSlice) == 1 {
+			config := configSlice[0].(map[string]interface{})
+			return r.mapRuleConfigFromSchema(config, alertType)
+		}
+	}
+	return restapi.InfraAlertRule{}
+}
+
+func (r *infraAlertConfigResource) mapRuleConfigFromSchema(config map[string]interface{}, alertType string) restapi.InfraAlertRule {
+	var aggregationPtr *restapi.Aggregation
+	if v, ok := config[InfraAlertConfigFieldRuleAggregation]; ok {
+		aggregation := restapi.Aggregation(v.(string))
+		aggregationPtr = &aggregation
+	}
+	var crossSeriesAggregationPtr *restapi.Aggregation
+	if v, ok := config[InfraAlertConfigFieldRuleCrossSeriesAggregation]; ok {
+		crossSeriesAggregation := restapi.Aggregation(v.(string))
+		crossSeriesAggregationPtr = &crossSeriesAggregation
+	}
+	return restapi.InfraAlertRule{
+		AlertType:              r.mapAlertTypeFromSchema(alertType),
+		MetricName:             config[InfraAlertConfigFieldRuleMetricName].(string),
+		EntityType:             config[InfraAlertConfigFieldRuleEntityType].(string),
+		Aggregation:            aggregationPtr,
+		CrossSeriesAggregation: crossSeriesAggregationPtr,
+	}
+}
+
+func (r *infraAlertConfigResource) mapAlertTypeFromSchema(alertType string) string {
+	if alertType == InfraAlertConfigFieldRuleGenericRule {
+		return "genericRule"
+	}
+	return alertType
+}
+
+func (r *infraAlertConfigResource) mapTimeThresholdFromSchema(d *schema.ResourceData) restapi.InfraTimeThreshold {
+	timeThresholdSlice := d.Get(InfraAlertConfigFieldTimeThreshold).([]interface{})
+	timeThreshold := timeThresholdSlice[0].(map[string]interface{})
+	for timeThresholdType, v := range timeThreshold {
+		configSlice := v.([]interface{})
+		if len(configSlice) == 1 {
+			config := configSlice[0].(map[string]interface{})
+			return restapi.InfraTimeThreshold{
+				Type:       r.mapTimeThresholdTypeFromSchema(timeThresholdType),
+				TimeWindow: int64(config[InfraAlertConfigFieldTimeThresholdTimeWindow].(int)),
+			}
+		}
+	}
+	return restapi.InfraTimeThreshold{}
+}
+
+func (r *infraAlertConfigResource) mapTimeThresholdTypeFromSchema(input string) string {
+	if input == InfraAlertConfigFieldTimeThresholdViolationsInSequence {
+		return "violationsInSequence"
+	}
+	return input
+}
@@ -0,0 +1,78 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// IPMaskingConfigFieldIPMasking constant value for field ip_masking of the ip masking configuration of websites and mobile apps
+const IPMaskingConfigFieldIPMasking = "ip_masking"
+
+// IPMaskingConfigSchemaIPMasking schema field definition of the ip masking mode of websites and mobile apps
+var IPMaskingConfigSchemaIPMasking = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	ValidateFunc: validation.StringInSlice(restapi.SupportedIPMaskings.ToStringSlice(), false),
+	Description:  "The ip masking mode which is applied to the collected ip addresses",
+}
+
+func newIPMaskingConfigResourceHandle(resourceName string, parentIDFieldName string, parentIDDescription string, restResourceProvider func(api restapi.InstanaAPI) restapi.RestResource[*restapi.IPMaskingConfig]) ResourceHandle[*restapi.IPMaskingConfig] {
+	return &ipMaskingConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: resourceName,
+			Schema: map[string]*schema.Schema{
+				parentIDFieldName: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: parentIDDescription,
+				},
+				IPMaskingConfigFieldIPMasking: IPMaskingConfigSchemaIPMasking,
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			ResourceIDField:  &parentIDFieldName,
+		},
+		parentIDFieldName:    parentIDFieldName,
+		restResourceProvider: restResourceProvider,
+	}
+}
+
+type ipMaskingConfigResource struct {
+	metaData             ResourceMetaData
+	parentIDFieldName    string
+	restResourceProvider func(api restapi.InstanaAPI) restapi.RestResource[*restapi.IPMaskingConfig]
+}
+
+func (r *ipMaskingConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *ipMaskingConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *ipMaskingConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.IPMaskingConfig] {
+	return r.restResourceProvider(api)
+}
+
+func (r *ipMaskingConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *ipMaskingConfigResource) UpdateState(d *schema.ResourceData, config *restapi.IPMaskingConfig) error {
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		r.parentIDFieldName:           config.ID,
+		IPMaskingConfigFieldIPMasking: string(config.IPMasking),
+	})
+}
+
+func (r *ipMaskingConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.IPMaskingConfig, error) {
+	return &restapi.IPMaskingConfig{
+		ID:        d.Get(r.parentIDFieldName).(string),
+		IPMasking: restapi.IPMasking(d.Get(IPMaskingConfigFieldIPMasking).(string)),
+	}, nil
+}
@@ -0,0 +1,267 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaMaintenanceWindow the name of the terraform-provider-instana resource to manage maintenance windows
+const ResourceInstanaMaintenanceWindow = "instana_maintenance_window"
+
+const (
+	//MaintenanceWindowFieldName constant value for field name of resource instana_maintenance_window
+	MaintenanceWindowFieldName = "name"
+	//MaintenanceWindowFieldQuery constant value for field query of resource instana_maintenance_window
+	MaintenanceWindowFieldQuery = "query"
+	//MaintenanceWindowFieldPaused constant value for field paused of resource instana_maintenance_window
+	MaintenanceWindowFieldPaused = "paused"
+
+	//MaintenanceWindowFieldScheduling constant value for field scheduling of resource instana_maintenance_window
+	MaintenanceWindowFieldScheduling = "scheduling"
+	//MaintenanceWindowFieldSchedulingOneTime constant value for field scheduling.one_time of resource instana_maintenance_window
+	MaintenanceWindowFieldSchedulingOneTime = "one_time"
+	//MaintenanceWindowFieldSchedulingRecurrent constant value for field scheduling.recurrent of resource instana_maintenance_window
+	MaintenanceWindowFieldSchedulingRecurrent = "recurrent"
+	//MaintenanceWindowFieldSchedulingStart constant value for field scheduling.*.start of resource instana_maintenance_window
+	MaintenanceWindowFieldSchedulingStart = "start"
+	//MaintenanceWindowFieldSchedulingDuration constant value for field scheduling.*.duration of resource instana_maintenance_window
+	MaintenanceWindowFieldSchedulingDuration = "duration"
+	//MaintenanceWindowFieldSchedulingDurationAmount constant value for field scheduling.*.duration.amount of resource instana_maintenance_window
+	MaintenanceWindowFieldSchedulingDurationAmount = "amount"
+	//MaintenanceWindowFieldSchedulingDurationUnit constant value for field scheduling.*.duration.unit of resource instana_maintenance_window
+	MaintenanceWindowFieldSchedulingDurationUnit = "unit"
+	//MaintenanceWindowFieldSchedulingRRule constant value for field scheduling.recurrent.rrule of resource instana_maintenance_window
+	MaintenanceWindowFieldSchedulingRRule = "rrule"
+	//MaintenanceWindowFieldSchedulingTimezoneID constant value for field scheduling.recurrent.timezone_id of resource instana_maintenance_window
+	MaintenanceWindowFieldSchedulingTimezoneID = "timezone_id"
+)
+
+var maintenanceWindowSchedulingTypeKeys = []string{
+	"scheduling.0." + MaintenanceWindowFieldSchedulingOneTime,
+	"scheduling.0." + MaintenanceWindowFieldSchedulingRecurrent,
+}
+
+var maintenanceWindowSchemaSchedulingStart = &schema.Schema{
+	Type:         schema.TypeInt,
+	Required:     true,
+	ValidateFunc: validation.IntAtLeast(0),
+	Description:  "The start of the maintenance window as unix timestamp in milliseconds",
+}
+
+var maintenanceWindowSchemaSchedulingDuration = &schema.Schema{
+	Type:        schema.TypeList,
+	MinItems:    1,
+	MaxItems:    1,
+	Required:    true,
+	Description: "The duration of the maintenance window",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			MaintenanceWindowFieldSchedulingDurationAmount: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The amount of the duration of the maintenance window in the given unit",
+			},
+			MaintenanceWindowFieldSchedulingDurationUnit: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(restapi.SupportedMaintenanceWindowDurationUnits.ToStringSlice(), false),
+				Description:  "The unit of the duration of the maintenance window",
+			},
+		},
+	},
+}
+
+var maintenanceWindowResourceSchema = map[string]*schema.Schema{
+	MaintenanceWindowFieldName: {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 256),
+		Description:  "The name of the maintenance window",
+	},
+	MaintenanceWindowFieldQuery: {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(0, 2048),
+		Description:  "The dynamic focus query (DFQ) which defines the entities affected by the maintenance window",
+	},
+	MaintenanceWindowFieldPaused: {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Indicates if the maintenance window is paused",
+	},
+	MaintenanceWindowFieldScheduling: {
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "The scheduling of the maintenance window",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				MaintenanceWindowFieldSchedulingOneTime: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					ExactlyOneOf: maintenanceWindowSchedulingTypeKeys,
+					Description:  "Scheduling of a maintenance window which is active exactly once",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MaintenanceWindowFieldSchedulingStart:    maintenanceWindowSchemaSchedulingStart,
+							MaintenanceWindowFieldSchedulingDuration: maintenanceWindowSchemaSchedulingDuration,
+						},
+					},
+				},
+				MaintenanceWindowFieldSchedulingRecurrent: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					ExactlyOneOf: maintenanceWindowSchedulingTypeKeys,
+					Description:  "Scheduling of a maintenance window which is active repeatedly according to the given recurrence rule",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MaintenanceWindowFieldSchedulingStart:    maintenanceWindowSchemaSchedulingStart,
+							MaintenanceWindowFieldSchedulingDuration: maintenanceWindowSchemaSchedulingDuration,
+							MaintenanceWindowFieldSchedulingRRule: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The recurrence rule (RFC 5545) of the maintenance window, e.g. FREQ=WEEKLY;BYDAY=SA",
+							},
+							MaintenanceWindowFieldSchedulingTimezoneID: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The ID of the timezone in which the recurrence rule is evaluated, e.g. Europe/Berlin",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// NewMaintenanceWindowResourceHandle creates the resource handle for Maintenance Windows
+func NewMaintenanceWindowResourceHandle() ResourceHandle[*restapi.MaintenanceWindow] {
+	return &maintenanceWindowResource{
+		metaData: ResourceMetaData{
+			ResourceName:  ResourceInstanaMaintenanceWindow,
+			Schema:        maintenanceWindowResourceSchema,
+			SchemaVersion: 0,
+		},
+	}
+}
+
+type maintenanceWindowResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *maintenanceWindowResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *maintenanceWindowResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *maintenanceWindowResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.MaintenanceWindow] {
+	return api.MaintenanceWindows()
+}
+
+func (r *maintenanceWindowResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *maintenanceWindowResource) UpdateState(d *schema.ResourceData, window *restapi.MaintenanceWindow) error {
+	d.SetId(window.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		MaintenanceWindowFieldName:       window.Name,
+		MaintenanceWindowFieldQuery:      window.Query,
+		MaintenanceWindowFieldPaused:     window.Paused,
+		MaintenanceWindowFieldScheduling: r.mapSchedulingToSchema(window.Scheduling),
+	})
+}
+
+func (r *maintenanceWindowResource) mapSchedulingToSchema(scheduling restapi.MaintenanceWindowScheduling) []interface{} {
+	config := map[string]interface{}{
+		MaintenanceWindowFieldSchedulingStart: int(scheduling.Start),
+		MaintenanceWindowFieldSchedulingDuration: []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingDurationAmount: int(scheduling.Duration.Amount),
+				MaintenanceWindowFieldSchedulingDurationUnit:   string(scheduling.Duration.Unit),
+			},
+		},
+	}
+
+	schedulingType := MaintenanceWindowFieldSchedulingOneTime
+	if scheduling.Type == restapi.MaintenanceWindowSchedulingTypeRecurrent {
+		schedulingType = MaintenanceWindowFieldSchedulingRecurrent
+		config[MaintenanceWindowFieldSchedulingRRule] = scheduling.RRule
+		config[MaintenanceWindowFieldSchedulingTimezoneID] = scheduling.TimezoneID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			schedulingType: []interface{}{config},
+		},
+	}
+}
+
+func (r *maintenanceWindowResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.MaintenanceWindow, error) {
+	return &restapi.MaintenanceWindow{
+		ID:         d.Id(),
+		Name:       d.Get(MaintenanceWindowFieldName).(string),
+		Query:      d.Get(MaintenanceWindowFieldQuery).(string),
+		Paused:     d.Get(MaintenanceWindowFieldPaused).(bool),
+		Scheduling: r.mapSchedulingFromSchema(d),
+	}, nil
+}
+
+func (r *maintenanceWindowResource) mapSchedulingFromSchema(d *schema.ResourceData) restapi.MaintenanceWindowScheduling {
+	schedulingSlice := d.Get(MaintenanceWindowFieldScheduling).([]interface{})
+	if len(schedulingSlice) == 0 || schedulingSlice[0] == nil {
+		return restapi.MaintenanceWindowScheduling{}
+	}
+	scheduling := schedulingSlice[0].(map[string]interface{})
+
+	if recurrent, ok := r.getSingleSchedulingConfig(scheduling, MaintenanceWindowFieldSchedulingRecurrent); ok {
+		result := r.mapCommonSchedulingFieldsFromSchema(restapi.MaintenanceWindowSchedulingTypeRecurrent, recurrent)
+		result.RRule = GetPointerFromMap[string](recurrent, MaintenanceWindowFieldSchedulingRRule)
+		result.TimezoneID = GetPointerFromMap[string](recurrent, MaintenanceWindowFieldSchedulingTimezoneID)
+		return result
+	}
+	if oneTime, ok := r.getSingleSchedulingConfig(scheduling, MaintenanceWindowFieldSchedulingOneTime); ok {
+		return r.mapCommonSchedulingFieldsFromSchema(restapi.MaintenanceWindowSchedulingTypeOneTime, oneTime)
+	}
+	return restapi.MaintenanceWindowScheduling{}
+}
+
+func (r *maintenanceWindowResource) getSingleSchedulingConfig(scheduling map[string]interface{}, key string) (map[string]interface{}, bool) {
+	if v, ok := scheduling[key]; ok {
+		configSlice := v.([]interface{})
+		if len(configSlice) == 1 && configSlice[0] != nil {
+			return configSlice[0].(map[string]interface{}), true
+		}
+	}
+	return nil, false
+}
+
+func (r *maintenanceWindowResource) mapCommonSchedulingFieldsFromSchema(schedulingType restapi.MaintenanceWindowSchedulingType, config map[string]interface{}) restapi.MaintenanceWindowScheduling {
+	result := restapi.MaintenanceWindowScheduling{
+		Type:  schedulingType,
+		Start: int64(config[MaintenanceWindowFieldSchedulingStart].(int)),
+	}
+	durationSlice := config[MaintenanceWindowFieldSchedulingDuration].([]interface{})
+	if len(durationSlice) == 1 && durationSlice[0] != nil {
+		duration := durationSlice[0].(map[string]interface{})
+		result.Duration = restapi.MaintenanceWindowDuration{
+			Amount: int64(duration[MaintenanceWindowFieldSchedulingDurationAmount].(int)),
+			Unit:   restapi.MaintenanceWindowDurationUnit(duration[MaintenanceWindowFieldSchedulingDurationUnit].(string)),
+		}
+	}
+	return result
+}
@@ -0,0 +1,343 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaMaintenanceWindow + ".example"
+	inst := &maintenanceWindowTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewMaintenanceWindowResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type maintenanceWindowTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.MaintenanceWindow]
+}
+
+var maintenanceWindowTerraformTemplate = `
+resource "instana_maintenance_window" "example" {
+	name  = "name %d"
+	query = "entity.zone:production"
+
+	scheduling {
+		recurrent {
+			start       = 1700000000000
+			rrule       = "FREQ=WEEKLY;BYDAY=SA"
+			timezone_id = "Europe/Berlin"
+
+			duration {
+				amount = 2
+				unit   = "HOURS"
+			}
+		}
+	}
+}
+`
+
+var maintenanceWindowServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"query": "entity.zone:production",
+	"paused": false,
+	"state": "SCHEDULED",
+	"scheduling": {
+		"type": "RECURRENT",
+		"start": 1700000000000,
+		"duration": {
+			"amount": 2,
+			"unit": "HOURS"
+		},
+		"rrule": "FREQ=WEEKLY;BYDAY=SA",
+		"timezoneId": "Europe/Berlin"
+	}
+}
+`
+
+func (test *maintenanceWindowTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaMaintenanceWindow), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaMaintenanceWindow), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaMaintenanceWindow), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaMaintenanceWindow), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from one time model", ResourceInstanaMaintenanceWindow), test.createTestShouldUpdateTerraformResourceStateFromOneTimeModel())
+	t.Run(fmt.Sprintf("%s should update terraform state from recurrent model", ResourceInstanaMaintenanceWindow), test.createTestShouldUpdateTerraformResourceStateFromRecurrentModel())
+	t.Run(fmt.Sprintf("%s should map one time terraform state to model", ResourceInstanaMaintenanceWindow), test.createTestShouldMapOneTimeTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should map recurrent terraform state to model", ResourceInstanaMaintenanceWindow), test.createTestShouldMapRecurrentTerraformResourceStateToModel())
+}
+
+func (test *maintenanceWindowTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.MaintenanceWindowResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPut, resourceRestAPIPath+"/"+id)
+			jsonData := fmt.Sprintf(maintenanceWindowServerResponseTemplate, id, modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+			},
+		})
+	}
+}
+
+func (test *maintenanceWindowTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	recurrentPath := fmt.Sprintf("%s.0.%s.0", MaintenanceWindowFieldScheduling, MaintenanceWindowFieldSchedulingRecurrent)
+	durationPath := fmt.Sprintf("%s.%s.0", recurrentPath, MaintenanceWindowFieldSchedulingDuration)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(maintenanceWindowTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, "id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MaintenanceWindowFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MaintenanceWindowFieldQuery, "entity.zone:production"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MaintenanceWindowFieldPaused, falseAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, recurrentPath+"."+MaintenanceWindowFieldSchedulingStart, "1700000000000"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, recurrentPath+"."+MaintenanceWindowFieldSchedulingRRule, "FREQ=WEEKLY;BYDAY=SA"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, recurrentPath+"."+MaintenanceWindowFieldSchedulingTimezoneID, "Europe/Berlin"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, durationPath+"."+MaintenanceWindowFieldSchedulingDurationAmount, "2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, durationPath+"."+MaintenanceWindowFieldSchedulingDurationUnit, "HOURS"),
+		),
+	}
+}
+
+func (test *maintenanceWindowTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *maintenanceWindowTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *maintenanceWindowTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_maintenance_window", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldUpdateTerraformResourceStateFromOneTimeModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		window := restapi.MaintenanceWindow{
+			ID:     "maintenance-window-id",
+			Name:   "maintenance-window-name",
+			Query:  "entity.zone:production",
+			Paused: true,
+			Scheduling: restapi.MaintenanceWindowScheduling{
+				Type:  restapi.MaintenanceWindowSchedulingTypeOneTime,
+				Start: 1700000000000,
+				Duration: restapi.MaintenanceWindowDuration{
+					Amount: 30,
+					Unit:   restapi.MaintenanceWindowDurationUnitMinutes,
+				},
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.MaintenanceWindow](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &window)
+
+		require.NoError(t, err)
+		require.Equal(t, "maintenance-window-id", resourceData.Id())
+		require.Equal(t, "maintenance-window-name", resourceData.Get(MaintenanceWindowFieldName))
+		require.Equal(t, "entity.zone:production", resourceData.Get(MaintenanceWindowFieldQuery))
+		require.True(t, resourceData.Get(MaintenanceWindowFieldPaused).(bool))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingOneTime: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingStart: 1700000000000,
+						MaintenanceWindowFieldSchedulingDuration: []interface{}{
+							map[string]interface{}{
+								MaintenanceWindowFieldSchedulingDurationAmount: 30,
+								MaintenanceWindowFieldSchedulingDurationUnit:   "MINUTES",
+							},
+						},
+					},
+				},
+				MaintenanceWindowFieldSchedulingRecurrent: []interface{}{},
+			},
+		}, resourceData.Get(MaintenanceWindowFieldScheduling))
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldUpdateTerraformResourceStateFromRecurrentModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		rrule := "FREQ=WEEKLY;BYDAY=SA"
+		timezoneID := "Europe/Berlin"
+		window := restapi.MaintenanceWindow{
+			ID:    "maintenance-window-id",
+			Name:  "maintenance-window-name",
+			Query: "entity.zone:production",
+			Scheduling: restapi.MaintenanceWindowScheduling{
+				Type:  restapi.MaintenanceWindowSchedulingTypeRecurrent,
+				Start: 1700000000000,
+				Duration: restapi.MaintenanceWindowDuration{
+					Amount: 2,
+					Unit:   restapi.MaintenanceWindowDurationUnitHours,
+				},
+				RRule:      &rrule,
+				TimezoneID: &timezoneID,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.MaintenanceWindow](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &window)
+
+		require.NoError(t, err)
+		require.Equal(t, "maintenance-window-id", resourceData.Id())
+		require.False(t, resourceData.Get(MaintenanceWindowFieldPaused).(bool))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingOneTime: []interface{}{},
+				MaintenanceWindowFieldSchedulingRecurrent: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingStart: 1700000000000,
+						MaintenanceWindowFieldSchedulingDuration: []interface{}{
+							map[string]interface{}{
+								MaintenanceWindowFieldSchedulingDurationAmount: 2,
+								MaintenanceWindowFieldSchedulingDurationUnit:   "HOURS",
+							},
+						},
+						MaintenanceWindowFieldSchedulingRRule:      rrule,
+						MaintenanceWindowFieldSchedulingTimezoneID: timezoneID,
+					},
+				},
+			},
+		}, resourceData.Get(MaintenanceWindowFieldScheduling))
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldMapOneTimeTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MaintenanceWindow](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("maintenance-window-id")
+		test.setRequiredValues(t, resourceData)
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldPaused, true)
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldScheduling, []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingOneTime: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingStart: 1700000000000,
+						MaintenanceWindowFieldSchedulingDuration: []interface{}{
+							map[string]interface{}{
+								MaintenanceWindowFieldSchedulingDurationAmount: 1,
+								MaintenanceWindowFieldSchedulingDurationUnit:   "DAYS",
+							},
+						},
+					},
+				},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.MaintenanceWindow{
+			ID:     "maintenance-window-id",
+			Name:   "maintenance-window-name",
+			Query:  "entity.zone:production",
+			Paused: true,
+			Scheduling: restapi.MaintenanceWindowScheduling{
+				Type:  restapi.MaintenanceWindowSchedulingTypeOneTime,
+				Start: 1700000000000,
+				Duration: restapi.MaintenanceWindowDuration{
+					Amount: 1,
+					Unit:   restapi.MaintenanceWindowDurationUnitDays,
+				},
+			},
+		}, result)
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldMapRecurrentTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MaintenanceWindow](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("maintenance-window-id")
+		test.setRequiredValues(t, resourceData)
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldScheduling, []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingRecurrent: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingStart: 1700000000000,
+						MaintenanceWindowFieldSchedulingDuration: []interface{}{
+							map[string]interface{}{
+								MaintenanceWindowFieldSchedulingDurationAmount: 2,
+								MaintenanceWindowFieldSchedulingDurationUnit:   "HOURS",
+							},
+						},
+						MaintenanceWindowFieldSchedulingRRule: "FREQ=WEEKLY;BYDAY=SA",
+					},
+				},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		rrule := "FREQ=WEEKLY;BYDAY=SA"
+		require.NoError(t, err)
+		require.Equal(t, &restapi.MaintenanceWindow{
+			ID:     "maintenance-window-id",
+			Name:   "maintenance-window-name",
+			Query:  "entity.zone:production",
+			Paused: false,
+			Scheduling: restapi.MaintenanceWindowScheduling{
+				Type:  restapi.MaintenanceWindowSchedulingTypeRecurrent,
+				Start: 1700000000000,
+				Duration: restapi.MaintenanceWindowDuration{
+					Amount: 2,
+					Unit:   restapi.MaintenanceWindowDurationUnitHours,
+				},
+				RRule: &rrule,
+			},
+		}, result)
+	}
+}
+
+func (test *maintenanceWindowTest) setRequiredValues(t *testing.T, resourceData *schema.ResourceData) {
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldName, "maintenance-window-name")
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldQuery, "entity.zone:production")
+}
@@ -0,0 +1,137 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaManualService the name of the terraform-provider-instana resource to manage manual service configs
+const ResourceInstanaManualService = "instana_manual_service"
+
+const (
+	//ManualServiceFieldTagFilter constant value for the schema field tag_filter
+	ManualServiceFieldTagFilter = "tag_filter"
+	//ManualServiceFieldDescription constant value for the schema field description
+	ManualServiceFieldDescription = "description"
+	//ManualServiceFieldEnabled constant value for the schema field enabled
+	ManualServiceFieldEnabled = "enabled"
+	//ManualServiceFieldExistingServiceID constant value for the schema field existing_service_id
+	ManualServiceFieldExistingServiceID = "existing_service_id"
+	//ManualServiceFieldUnmonitoredServiceName constant value for the schema field unmonitored_service_name
+	ManualServiceFieldUnmonitoredServiceName = "unmonitored_service_name"
+)
+
+var manualServiceTargetFields = []string{ManualServiceFieldExistingServiceID, ManualServiceFieldUnmonitoredServiceName}
+
+// NewManualServiceResourceHandle creates the resource handle for manual service configs
+func NewManualServiceResourceHandle() ResourceHandle[*restapi.ManualServiceConfig] {
+	return &manualServiceResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaManualService,
+			Schema: map[string]*schema.Schema{
+				ManualServiceFieldTagFilter: {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The tag filter expression to match the calls on which the manual service config is applied. Only call tags are allowed in the expression",
+					DiffSuppressFunc: tagFilterDiffSuppressFunc,
+					StateFunc:        tagFilterStateFunc,
+					ValidateFunc:     tagFilterValidateFunc,
+				},
+				ManualServiceFieldDescription: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The description of the manual service config",
+				},
+				ManualServiceFieldEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether the manual service config is enabled or not",
+				},
+				ManualServiceFieldExistingServiceID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: manualServiceTargetFields,
+					Description:  "The ID of the existing service to which the matching calls are mapped",
+				},
+				ManualServiceFieldUnmonitoredServiceName: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: manualServiceTargetFields,
+					Description:  "The name of the unmonitored service to which the matching calls are mapped",
+				},
+			},
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type manualServiceResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *manualServiceResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *manualServiceResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *manualServiceResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ManualServiceConfig] {
+	return api.ManualServiceConfigs()
+}
+
+func (r *manualServiceResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *manualServiceResource) UpdateState(d *schema.ResourceData, config *restapi.ManualServiceConfig) error {
+	var normalizedTagFilterString *string
+	var err error
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ManualServiceFieldTagFilter:              normalizedTagFilterString,
+		ManualServiceFieldDescription:            config.Description,
+		ManualServiceFieldEnabled:                config.Enabled,
+		ManualServiceFieldExistingServiceID:      config.ExistingServiceID,
+		ManualServiceFieldUnmonitoredServiceName: config.UnmonitoredServiceName,
+	})
+}
+
+func (r *manualServiceResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ManualServiceConfig, error) {
+	tagFilter, err := r.mapTagFilterExpressionFromSchema(d.Get(ManualServiceFieldTagFilter).(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &restapi.ManualServiceConfig{
+		ID:                     d.Id(),
+		Description:            GetStringPointerFromResourceData(d, ManualServiceFieldDescription),
+		Enabled:                d.Get(ManualServiceFieldEnabled).(bool),
+		ExistingServiceID:      GetStringPointerFromResourceData(d, ManualServiceFieldExistingServiceID),
+		UnmonitoredServiceName: GetStringPointerFromResourceData(d, ManualServiceFieldUnmonitoredServiceName),
+		TagFilterExpression:    tagFilter,
+	}, nil
+}
+
+func (r *manualServiceResource) mapTagFilterExpressionFromSchema(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
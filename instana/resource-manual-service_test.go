@@ -0,0 +1,208 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestManualService(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaManualService + ".example"
+	inst := &manualServiceTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewManualServiceResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type manualServiceTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.ManualServiceConfig]
+}
+
+var manualServiceTerraformTemplate = `
+resource "instana_manual_service" "example" {
+	tag_filter               = "call.http.host@dest EQUALS 'example.com'"
+	description              = "description %d"
+	enabled                  = true
+	unmonitored_service_name = "service %d"
+}
+`
+
+func (test *manualServiceTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaManualService), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaManualService), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaManualService), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaManualService), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaManualService), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaManualService), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state to model when tag filter is not valid", ResourceInstanaManualService), test.createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
+}
+
+func (test *manualServiceTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var serverState *restapi.ManualServiceConfig
+		writeJSON := func(w http.ResponseWriter, r *http.Request, data interface{}) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(data)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+		readConfig := func(r *http.Request) *restapi.ManualServiceConfig {
+			config := &restapi.ManualServiceConfig{}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, config)
+			return config
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.ManualServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			serverState = readConfig(r)
+			serverState.ID = RandomID()
+			writeJSON(w, r, serverState)
+		})
+		httpServer.AddRoute(http.MethodPut, restapi.ManualServiceConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if serverState == nil || mux.Vars(r)["id"] != serverState.ID {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			serverState = readConfig(r)
+			writeJSON(w, r, serverState)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.ManualServiceConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			serverState = nil
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.ManualServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			configs := make([]*restapi.ManualServiceConfig, 0)
+			if serverState != nil {
+				configs = append(configs, serverState)
+			}
+			writeJSON(w, r, configs)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *manualServiceTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(manualServiceTerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, "id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ManualServiceFieldTagFilter, "call.http.host@dest EQUALS 'example.com'"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ManualServiceFieldDescription, fmt.Sprintf("description %d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ManualServiceFieldEnabled, trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ManualServiceFieldUnmonitoredServiceName, fmt.Sprintf("service %d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ManualServiceFieldExistingServiceID, ""),
+		),
+	}
+}
+
+func (test *manualServiceTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *manualServiceTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *manualServiceTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_manual_service", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *manualServiceTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		description := "description"
+		existingServiceID := "service-id"
+		tagFilterName := "call.http.host"
+		tagFilterValue := "example.com"
+		config := &restapi.ManualServiceConfig{
+			ID:                  "manual-service-id",
+			Description:         &description,
+			Enabled:             true,
+			ExistingServiceID:   &existingServiceID,
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, tagFilterValue),
+		}
+		testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "manual-service-id", resourceData.Id())
+		require.Equal(t, "call.http.host@dest EQUALS 'example.com'", resourceData.Get(ManualServiceFieldTagFilter))
+		require.Equal(t, description, resourceData.Get(ManualServiceFieldDescription))
+		require.True(t, resourceData.Get(ManualServiceFieldEnabled).(bool))
+		require.Equal(t, existingServiceID, resourceData.Get(ManualServiceFieldExistingServiceID))
+		require.Equal(t, "", resourceData.Get(ManualServiceFieldUnmonitoredServiceName))
+	}
+}
+
+func (test *manualServiceTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("manual-service-id")
+		setValueOnResourceData(t, resourceData, ManualServiceFieldTagFilter, "call.http.host@dest EQUALS 'example.com'")
+		setValueOnResourceData(t, resourceData, ManualServiceFieldDescription, "description")
+		setValueOnResourceData(t, resourceData, ManualServiceFieldEnabled, false)
+		setValueOnResourceData(t, resourceData, ManualServiceFieldUnmonitoredServiceName, "service")
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		description := "description"
+		unmonitoredServiceName := "service"
+		require.NoError(t, err)
+		require.Equal(t, &restapi.ManualServiceConfig{
+			ID:                     "manual-service-id",
+			Description:            &description,
+			Enabled:                false,
+			UnmonitoredServiceName: &unmonitoredServiceName,
+			TagFilterExpression:    restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.http.host", restapi.EqualsOperator, "example.com"),
+		}, result)
+	}
+}
+
+func (test *manualServiceTest) createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, ManualServiceFieldTagFilter, "invalid invalid invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+	}
+}
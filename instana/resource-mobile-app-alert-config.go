@@ -0,0 +1,627 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaMobileAppAlertConfig the name of the terraform-provider-instana resource to manage mobile app alert configs
+const ResourceInstanaMobileAppAlertConfig = "instana_mobile_app_alert_config"
+
+const (
+	//MobileAppAlertConfigFieldAlertChannelIDs constant value for field alerting_channel_ids of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldAlertChannelIDs = "alert_channel_ids"
+	//MobileAppAlertConfigFieldMobileAppID constant value for field mobile_app_id of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldMobileAppID = "mobile_app_id"
+	//MobileAppAlertConfigFieldDescription constant value for field description of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldDescription = "description"
+	//MobileAppAlertConfigFieldGranularity constant value for field granularity of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldGranularity = "granularity"
+	//MobileAppAlertConfigFieldName constant value for field name of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldName = "name"
+
+	//MobileAppAlertConfigFieldRule constant value for field rule of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRule = "rule"
+	//MobileAppAlertConfigFieldRuleMetricName constant value for field rule.*.metric_name of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleMetricName = "metric_name"
+	//MobileAppAlertConfigFieldRuleAggregation constant value for field rule.*.aggregation of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleAggregation = "aggregation"
+	//MobileAppAlertConfigFieldRuleOperator constant value for field rule.*.operator of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleOperator = "operator"
+	//MobileAppAlertConfigFieldRuleValue constant value for field rule.*.value of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleValue = "value"
+	//MobileAppAlertConfigFieldRuleCustomEventName constant value for field rule.custom_event.custom_event_name of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleCustomEventName = "custom_event_name"
+	//MobileAppAlertConfigFieldRuleCrash constant value for field rule.crash of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleCrash = "crash"
+	//MobileAppAlertConfigFieldRuleCustomEvent constant value for field rule.custom_event of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleCustomEvent = "custom_event"
+	//MobileAppAlertConfigFieldRuleSlowness constant value for field rule.slowness of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleSlowness = "slowness"
+	//MobileAppAlertConfigFieldRuleStatusCode constant value for field rule.status_code of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleStatusCode = "status_code"
+	//MobileAppAlertConfigFieldRuleThroughput constant value for field rule.throughput of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleThroughput = "throughput"
+
+	//MobileAppAlertConfigFieldSeverity constant value for field severity of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldSeverity = "severity"
+	//MobileAppAlertConfigFieldTagFilter constant value for field tag_filter of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTagFilter = "tag_filter"
+
+	//MobileAppAlertConfigFieldTimeThreshold constant value for field time_threshold of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThreshold = "time_threshold"
+	//MobileAppAlertConfigFieldTimeThresholdTimeWindow constant value for field time_threshold.time_window of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThresholdTimeWindow = "time_window"
+	//MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence constant value for field time_threshold.user_impact_of_violations_in_sequence of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence = "user_impact_of_violations_in_sequence"
+	//MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod constant value for field time_threshold.user_impact_of_violations_in_sequence.impact_measurement_method of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod = "impact_measurement_method"
+	//MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage constant value for field time_threshold.user_impact_of_violations_in_sequence.user_percentage of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage = "user_percentage"
+	//MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers constant value for field time_threshold.user_impact_of_violations_in_sequence.users of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers = "users"
+	//MobileAppAlertConfigFieldTimeThresholdViolationsInPeriod constant value for field time_threshold.violations_in_period of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThresholdViolationsInPeriod = "violations_in_period"
+	//MobileAppAlertConfigFieldTimeThresholdViolationsInPeriodViolations constant value for field time_threshold.violations_in_period.violations of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThresholdViolationsInPeriodViolations = "violations"
+	//MobileAppAlertConfigFieldTimeThresholdViolationsInSequence constant value for field time_threshold.violations_in_sequence of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThresholdViolationsInSequence = "violations_in_sequence"
+	//MobileAppAlertConfigFieldTriggering constant value for field triggering of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTriggering = "triggering"
+)
+
+var (
+	mobileAppAlertConfigRuleTypeKeys = []string{
+		"rule.0.crash",
+		"rule.0.custom_event",
+		"rule.0.slowness",
+		"rule.0.status_code",
+		"rule.0.throughput",
+	}
+	mobileAppAlertConfigTimeThresholdTypeKeys = []string{
+		"time_threshold.0.user_impact_of_violations_in_sequence",
+		"time_threshold.0.violations_in_period",
+		"time_threshold.0.violations_in_sequence",
+	}
+	mobileAppAlertConfigSchemaRuleMetricName = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The metric name of the mobile app alert rule",
+	}
+	mobileAppAlertConfigSchemaRequiredRuleAggregation = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(restapi.SupportedAggregations.ToStringSlice(), true),
+		Description:  "The aggregation function of the mobile app alert rule",
+	}
+	mobileAppAlertConfigSchemaOptionalRuleAggregation = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(restapi.SupportedAggregations.ToStringSlice(), true),
+		Description:  "The aggregation function of the mobile app alert rule",
+	}
+	mobileAppAlertConfigSchemaOptionalTimeThresholdTimeWindow = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The time window if the time threshold",
+	}
+)
+
+var mobileAppAlertConfigResourceSchema = map[string]*schema.Schema{
+	MobileAppAlertConfigFieldAlertChannelIDs: {
+		Type:     schema.TypeSet,
+		MinItems: 0,
+		MaxItems: 1024,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		Description: "List of IDs of alert channels defined in Instana.",
+	},
+	DefaultCustomPayloadFieldsName: buildCustomPayloadFields(),
+	MobileAppAlertConfigFieldDescription: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The description text of the mobile app alert config",
+		ValidateFunc: validation.StringLenBetween(0, 65536),
+	},
+	MobileAppAlertConfigFieldGranularity: {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      restapi.Granularity600000,
+		ValidateFunc: validation.IntInSlice(restapi.SupportedGranularities.ToIntSlice()),
+		Description:  "The evaluation granularity used for detection of violations of the defined threshold. In other words, it defines the size of the tumbling window used",
+	},
+	MobileAppAlertConfigFieldMobileAppID: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Unique ID of the mobile app",
+		ValidateFunc: validation.StringLenBetween(0, 64),
+	},
+	MobileAppAlertConfigFieldName: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Name for the mobile app alert configuration",
+		ValidateFunc: validation.StringLenBetween(0, 256),
+	},
+	MobileAppAlertConfigFieldRule: {
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of rule this alert configuration is about.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				MobileAppAlertConfigFieldRuleCrash: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Rule based on the crash rate of the configured alert configuration target",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MobileAppAlertConfigFieldRuleMetricName:  mobileAppAlertConfigSchemaRuleMetricName,
+							MobileAppAlertConfigFieldRuleAggregation: mobileAppAlertConfigSchemaOptionalRuleAggregation,
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigRuleTypeKeys,
+				},
+				MobileAppAlertConfigFieldRuleCustomEvent: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Rule based on a custom event metric of the configured alert configuration target",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MobileAppAlertConfigFieldRuleMetricName:  mobileAppAlertConfigSchemaRuleMetricName,
+							MobileAppAlertConfigFieldRuleAggregation: mobileAppAlertConfigSchemaOptionalRuleAggregation,
+							MobileAppAlertConfigFieldRuleCustomEventName: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The name of the custom event",
+							},
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigRuleTypeKeys,
+				},
+				MobileAppAlertConfigFieldRuleSlowness: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Rule based on the slowness of the configured alert configuration target",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MobileAppAlertConfigFieldRuleMetricName:  mobileAppAlertConfigSchemaRuleMetricName,
+							MobileAppAlertConfigFieldRuleAggregation: mobileAppAlertConfigSchemaRequiredRuleAggregation,
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigRuleTypeKeys,
+				},
+				MobileAppAlertConfigFieldRuleStatusCode: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Rule based on the HTTP status code of the configured alert configuration target",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MobileAppAlertConfigFieldRuleMetricName:  mobileAppAlertConfigSchemaRuleMetricName,
+							MobileAppAlertConfigFieldRuleAggregation: mobileAppAlertConfigSchemaOptionalRuleAggregation,
+							MobileAppAlertConfigFieldRuleOperator: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The operator which will be applied to evaluate this rule",
+								ValidateFunc: validation.StringInSlice(restapi.SupportedExpressionOperators.ToStringSlice(), true),
+							},
+							MobileAppAlertConfigFieldRuleValue: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The value identify the specific http status code",
+							},
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigRuleTypeKeys,
+				},
+				MobileAppAlertConfigFieldRuleThroughput: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Rule based on the throughput of the configured alert configuration target",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MobileAppAlertConfigFieldRuleMetricName:  mobileAppAlertConfigSchemaRuleMetricName,
+							MobileAppAlertConfigFieldRuleAggregation: mobileAppAlertConfigSchemaOptionalRuleAggregation,
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigRuleTypeKeys,
+				},
+			},
+		},
+	},
+	MobileAppAlertConfigFieldSeverity: {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(restapi.SupportedSeverities.TerraformRepresentations(), false),
+		Description:  "The severity of the alert when triggered",
+	},
+	MobileAppAlertConfigFieldTagFilter: OptionalTagFilterExpressionSchema,
+	ResourceFieldThreshold:             thresholdSchema,
+	MobileAppAlertConfigFieldTimeThreshold: {
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of violation of the defined threshold.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Time threshold base on user impact of violations in sequence",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MobileAppAlertConfigFieldTimeThresholdTimeWindow: mobileAppAlertConfigSchemaOptionalTimeThresholdTimeWindow,
+							MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(restapi.SupportedWebsiteImpactMeasurementMethods.ToStringSlice(), false),
+								Description:  "The impact method of the time threshold based on user impact of violations in sequence",
+							},
+							MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage: {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: validation.FloatBetween(0.0, 1.0),
+								Description:  "The percentage of impacted users of the time threshold based on user impact of violations in sequence",
+							},
+							MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The number of impacted users of the time threshold based on user impact of violations in sequence",
+							},
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigTimeThresholdTypeKeys,
+				},
+				MobileAppAlertConfigFieldTimeThresholdViolationsInPeriod: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Time threshold base on violations in period",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MobileAppAlertConfigFieldTimeThresholdTimeWindow: mobileAppAlertConfigSchemaOptionalTimeThresholdTimeWindow,
+							MobileAppAlertConfigFieldTimeThresholdViolationsInPeriodViolations: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(1, 12),
+								Description:  "The violations appeared in the period",
+							},
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigTimeThresholdTypeKeys,
+				},
+				MobileAppAlertConfigFieldTimeThresholdViolationsInSequence: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Time threshold base on violations in sequence",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MobileAppAlertConfigFieldTimeThresholdTimeWindow: mobileAppAlertConfigSchemaOptionalTimeThresholdTimeWindow,
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigTimeThresholdTypeKeys,
+				},
+			},
+		},
+	},
+	MobileAppAlertConfigFieldTriggering: {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Optional flag to indicate whether also an Incident is triggered or not. The default is false",
+	},
+}
+
+// NewMobileAppAlertConfigResourceHandle creates the resource handle for Mobile App Alert Configs
+func NewMobileAppAlertConfigResourceHandle() ResourceHandle[*restapi.MobileAppAlertConfig] {
+	return &mobileAppAlertConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName:     ResourceInstanaMobileAppAlertConfig,
+			Schema:           mobileAppAlertConfigResourceSchema,
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type mobileAppAlertConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *mobileAppAlertConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *mobileAppAlertConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *mobileAppAlertConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.MobileAppAlertConfig] {
+	return api.MobileAppAlertConfigs()
+}
+
+func (r *mobileAppAlertConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *mobileAppAlertConfigResource) UpdateState(d *schema.ResourceData, config *restapi.MobileAppAlertConfig) error {
+	severity, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(config.Severity)
+	if err != nil {
+		return err
+	}
+	var normalizedTagFilterString *string
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		MobileAppAlertConfigFieldAlertChannelIDs: config.AlertChannelIDs,
+		DefaultCustomPayloadFieldsName:           mapCustomPayloadFieldsToSchema(config),
+		MobileAppAlertConfigFieldDescription:     config.Description,
+		MobileAppAlertConfigFieldGranularity:     config.Granularity,
+		MobileAppAlertConfigFieldMobileAppID:     config.MobileAppID,
+		MobileAppAlertConfigFieldName:            config.Name,
+		MobileAppAlertConfigFieldRule:            r.mapRuleToSchema(config),
+		MobileAppAlertConfigFieldSeverity:        severity,
+		MobileAppAlertConfigFieldTagFilter:       normalizedTagFilterString,
+		ResourceFieldThreshold:                   newThresholdMapper().toState(&config.Threshold),
+		MobileAppAlertConfigFieldTimeThreshold:   r.mapTimeThresholdToSchema(config),
+		MobileAppAlertConfigFieldTriggering:      config.Triggering,
+	})
+}
+
+func (r *mobileAppAlertConfigResource) mapRuleToSchema(config *restapi.MobileAppAlertConfig) []map[string]interface{} {
+	ruleAttribute := make(map[string]interface{})
+	ruleAttribute[MobileAppAlertConfigFieldRuleMetricName] = config.Rule.MetricName
+
+	if config.Rule.Aggregation != nil {
+		ruleAttribute[MobileAppAlertConfigFieldRuleAggregation] = string(*config.Rule.Aggregation)
+	}
+	if config.Rule.Operator != nil {
+		ruleAttribute[MobileAppAlertConfigFieldRuleOperator] = string(*config.Rule.Operator)
+	}
+	if config.Rule.Value != nil {
+		ruleAttribute[MobileAppAlertConfigFieldRuleValue] = *config.Rule.Value
+	}
+	if config.Rule.CustomEventName != nil {
+		ruleAttribute[MobileAppAlertConfigFieldRuleCustomEventName] = *config.Rule.CustomEventName
+	}
+
+	alertType := r.mapAlertTypeToSchema(config.Rule.AlertType)
+	rule := make(map[string]interface{})
+	rule[alertType] = []interface{}{ruleAttribute}
+	result := make([]map[string]interface{}, 1)
+	result[0] = rule
+	return result
+}
+
+func (r *mobileAppAlertConfigResource) mapAlertTypeToSchema(alertType string) string {
+	if alertType == "customEvent" {
+		return MobileAppAlertConfigFieldRuleCustomEvent
+	} else if alertType == "statusCode" {
+		return MobileAppAlertConfigFieldRuleStatusCode
+	}
+	return alertType
+}
+
+func (r *mobileAppAlertConfigResource) mapTimeThresholdToSchema(config *restapi.MobileAppAlertConfig) []map[string]interface{} {
+	timeThresholdConfig := make(map[string]interface{})
+
+	if config.TimeThreshold.TimeWindow != nil {
+		timeThresholdConfig[MobileAppAlertConfigFieldTimeThresholdTimeWindow] = config.TimeThreshold.TimeWindow
+	}
+	if config.TimeThreshold.Violations != nil {
+		timeThresholdConfig[MobileAppAlertConfigFieldTimeThresholdViolationsInPeriodViolations] = int(*config.TimeThreshold.Violations)
+	}
+	if config.TimeThreshold.ImpactMeasurementMethod != nil {
+		timeThresholdConfig[MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod] = string(*config.TimeThreshold.ImpactMeasurementMethod)
+	}
+	if config.TimeThreshold.Users != nil {
+		timeThresholdConfig[MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers] = int(*config.TimeThreshold.Users)
+	}
+	if config.TimeThreshold.UserPercentage != nil {
+		timeThresholdConfig[MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage] = *config.TimeThreshold.UserPercentage
+	}
+
+	timeThresholdType := r.mapTimeThresholdTypeToSchema(config.TimeThreshold.Type)
+	timeThreshold := make(map[string]interface{})
+	timeThreshold[timeThresholdType] = []interface{}{timeThresholdConfig}
+	result := make([]map[string]interface{}, 1)
+	result[0] = timeThreshold
+	return result
+}
+
+func (r *mobileAppAlertConfigResource) mapTimeThresholdTypeToSchema(input string) string {
+	if input == "userImpactOfViolationsInSequence" {
+		return MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence
+	} else if input == "violationsInPeriod" {
+		return MobileAppAlertConfigFieldTimeThresholdViolationsInPeriod
+	} else if input == "violationsInSequence" {
+		return MobileAppAlertConfigFieldTimeThresholdViolationsInSequence
+	}
+	return input
+}
+
+func (r *mobileAppAlertConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.MobileAppAlertConfig, error) {
+	severity, err := ConvertSeverityFromTerraformToInstanaAPIRepresentation(d.Get(MobileAppAlertConfigFieldSeverity).(string))
+	if err != nil {
+		return nil, err
+	}
+
+	var tagFilter *restapi.TagFilter
+	tagFilterStr, ok := d.GetOk(MobileAppAlertConfigFieldTagFilter)
+	if ok {
+		tagFilter, err = r.mapTagFilterExpressionFromSchema(tagFilterStr.(string))
+		if err != nil {
+			return &restapi.MobileAppAlertConfig{}, err
+		}
+	}
+	customPayloadFields, err := mapDefaultCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return &restapi.MobileAppAlertConfig{}, err
+	}
+
+	threshold := newThresholdMapper().fromState(d)
+
+	return &restapi.MobileAppAlertConfig{
+		ID:                    d.Id(),
+		AlertChannelIDs:       ReadStringSetParameterFromResource(d, MobileAppAlertConfigFieldAlertChannelIDs),
+		CustomerPayloadFields: customPayloadFields,
+		Description:           d.Get(MobileAppAlertConfigFieldDescription).(string),
+		Granularity:           restapi.Granularity(d.Get(MobileAppAlertConfigFieldGranularity).(int)),
+		MobileAppID:           d.Get(MobileAppAlertConfigFieldMobileAppID).(string),
+		Name:                  d.Get(MobileAppAlertConfigFieldName).(string),
+		Rule:                  *r.mapRuleFromSchema(d),
+		Severity:              severity,
+		TagFilterExpression:   tagFilter,
+		Threshold:             *threshold,
+		TimeThreshold:         *r.mapTimeThresholdFromSchema(d),
+		Triggering:            d.Get(MobileAppAlertConfigFieldTriggering).(bool),
+	}, nil
+}
+
+func (r *mobileAppAlertConfigResource) mapTagFilterExpressionFromSchema(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
+
+func (r *mobileAppAlertConfigResource) mapRuleFromSchema(d *schema.ResourceData) *restapi.MobileAppAlertRule {
+	ruleSlice := d.Get(MobileAppAlertConfigFieldRule).([]interface{})
+	rule := ruleSlice[0].(map[string]interface{})
+	for alertType, v := range rule {
+		configSlice := v.([]interface{})
+		if len(configSlice) == 1 {
+			config := configSlice[0].(map[string]interface{})
+			return r.mapRuleConfigFromSchema(config, alertType)
+		}
+	}
+	return &restapi.MobileAppAlertRule{}
+}
+
+func (r *mobileAppAlertConfigResource) mapRuleConfigFromSchema(config map[string]interface{}, alertType string) *restapi.MobileAppAlertRule {
+	var aggregationPtr *restapi.Aggregation
+	if v, ok := config[MobileAppAlertConfigFieldRuleAggregation]; ok && len(v.(string)) > 0 {
+		aggregation := restapi.Aggregation(v.(string))
+		aggregationPtr = &aggregation
+	}
+	var valuePtr *string
+	if v, ok := config[MobileAppAlertConfigFieldRuleValue]; ok {
+		value := v.(string)
+		valuePtr = &value
+	}
+	var operatorPtr *restapi.ExpressionOperator
+	if v, ok := config[MobileAppAlertConfigFieldRuleOperator]; ok {
+		operator := restapi.ExpressionOperator(v.(string))
+		operatorPtr = &operator
+	}
+	var customEventNamePtr *string
+	if v, ok := config[MobileAppAlertConfigFieldRuleCustomEventName]; ok {
+		customEventName := v.(string)
+		customEventNamePtr = &customEventName
+	}
+	return &restapi.MobileAppAlertRule{
+		AlertType:       r.mapAlertTypeFromSchema(alertType),
+		MetricName:      config[MobileAppAlertConfigFieldRuleMetricName].(string),
+		Aggregation:     aggregationPtr,
+		Operator:        operatorPtr,
+		Value:           valuePtr,
+		CustomEventName: customEventNamePtr,
+	}
+}
+
+func (r *mobileAppAlertConfigResource) mapAlertTypeFromSchema(alertType string) string {
+	if alertType == MobileAppAlertConfigFieldRuleCustomEvent {
+		return "customEvent"
+	} else if alertType == MobileAppAlertConfigFieldRuleStatusCode {
+		return "statusCode"
+	}
+	return alertType
+}
+
+func (r *mobileAppAlertConfigResource) mapTimeThresholdFromSchema(d *schema.ResourceData) *restapi.MobileAppTimeThreshold {
+	timeThresholdSlice := d.Get(MobileAppAlertConfigFieldTimeThreshold).([]interface{})
+	timeThreshold := timeThresholdSlice[0].(map[string]interface{})
+	for timeThresholdType, v := range timeThreshold {
+		configSlice := v.([]interface{})
+		if len(configSlice) == 1 {
+			config := configSlice[0].(map[string]interface{})
+			var timeWindowPtr *int64
+			if v, ok := config[MobileAppAlertConfigFieldTimeThresholdTimeWindow]; ok {
+				timeWindow := int64(v.(int))
+				timeWindowPtr = &timeWindow
+			}
+			var violationsPtr *int32
+			if v, ok := config[MobileAppAlertConfigFieldTimeThresholdViolationsInPeriodViolations]; ok {
+				violations := int32(v.(int))
+				violationsPtr = &violations
+			}
+			var impactMeasurementMethodPtr *restapi.WebsiteImpactMeasurementMethod
+			if v, ok := config[MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod]; ok {
+				impactMeasurementMethod := restapi.WebsiteImpactMeasurementMethod(v.(string))
+				impactMeasurementMethodPtr = &impactMeasurementMethod
+			}
+			var userPercentagePtr *float64
+			if v, ok := config[MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage]; ok {
+				userPercentage := v.(float64)
+				userPercentagePtr = &userPercentage
+			}
+			var usersPtr *int32
+			if v, ok := config[MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers]; ok {
+				users := int32(v.(int))
+				usersPtr = &users
+			}
+			return &restapi.MobileAppTimeThreshold{
+				Type:                    r.mapTimeThresholdTypeFromSchema(timeThresholdType),
+				TimeWindow:              timeWindowPtr,
+				Violations:              violationsPtr,
+				ImpactMeasurementMethod: impactMeasurementMethodPtr,
+				UserPercentage:          userPercentagePtr,
+				Users:                   usersPtr,
+			}
+		}
+	}
+	return &restapi.MobileAppTimeThreshold{}
+}
+
+func (r *mobileAppAlertConfigResource) mapTimeThresholdTypeFromSchema(input string) string {
+	if input == MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence {
+		return "userImpactOfViolationsInSequence"
+	} else if input == MobileAppAlertConfigFieldTimeThresholdViolationsInPeriod {
+		return "violationsInPeriod"
+	} else if input == MobileAppAlertConfigFieldTimeThresholdViolationsInSequence {
+		return "violationsInSequence"
+	}
+	return input
+}
@@ -0,0 +1,535 @@
+package instana_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestMobileAppAlertConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaMobileAppAlertConfig + ".example"
+	inst := &mobileAppAlertConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewMobileAppAlertConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type mobileAppAlertConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.MobileAppAlertConfig]
+}
+
+var mobileAppAlertConfigTerraformTemplate = `
+resource "instana_mobile_app_alert_config" "example" {
+	name              = "name %d"
+	description       = "test-alert-description"
+	severity          = "warning"
+	triggering        = true
+	mobile_app_id     = "mobile-app-id"
+	alert_channel_ids = [ "alert-channel-id-1", "alert-channel-id-2" ]
+	granularity       = 600000
+	tag_filter        = "mobileBeacon.platform@na EQUALS 'iOS'"
+
+	rule {
+		slowness {
+			metric_name = "httpLatency"
+			aggregation = "P90"
+		}
+	}
+
+	threshold {
+		static {
+			operator = ">="
+			value    = 5.0
+		}
+	}
+
+	time_threshold {
+		violations_in_sequence {
+			time_window = 600000
+		}
+	}
+
+	custom_payload_field {
+		key    = "test1"
+		value  = "test123"
+	}
+}
+`
+
+var mobileAppAlertConfigServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"description": "test-alert-description",
+	"mobileAppId": "mobile-app-id",
+	"severity": 5,
+	"triggering": true,
+	"tagFilterExpression": {
+		"type": "TAG_FILTER",
+		"name": "mobileBeacon.platform",
+		"stringValue": "iOS",
+		"numberValue": null,
+		"booleanValue": null,
+		"key": null,
+		"value": "iOS",
+		"operator": "EQUALS",
+		"entity": "NOT_APPLICABLE"
+	},
+	"rule": {
+		"alertType": "slowness",
+		"metricName": "httpLatency",
+		"aggregation": "P90"
+	},
+	"threshold": {
+		"type": "staticThreshold",
+		"operator": ">=",
+		"value": 5.0,
+		"lastUpdated": 0
+	},
+	"alertChannelIds": [ "alert-channel-id-1", "alert-channel-id-2" ],
+	"granularity": 600000,
+	"timeThreshold": {
+		"type": "violationsInSequence",
+		"timeWindow": 600000
+	},
+	"customPayloadFields": [
+		{
+			"type": "staticString",
+			"key": "test1",
+			"value": "test123"
+		}
+	],
+	"created": 1647679325301,
+	"readOnly": false,
+	"enabled": true
+}
+`
+
+func (test *mobileAppAlertConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaMobileAppAlertConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaMobileAppAlertConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should update terraform state from model with user impact time threshold", ResourceInstanaMobileAppAlertConfig), test.createTestShouldUpdateTerraformResourceStateFromModelWithUserImpactTimeThreshold())
+	t.Run(fmt.Sprintf("%s should fail to update state from model when severity is invalid", ResourceInstanaMobileAppAlertConfig), test.createTestShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaMobileAppAlertConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model with status code rule", ResourceInstanaMobileAppAlertConfig), test.createTestShouldMapTerraformResourceStateWithStatusCodeRuleToModel())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when severity is invalid", ResourceInstanaMobileAppAlertConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when tag filter expression is invalid", ResourceInstanaMobileAppAlertConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
+}
+
+func (test *mobileAppAlertConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.MobileAppAlertConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.MobileAppAlertConfig{}
+			err := json.NewDecoder(r.Body).Decode(config)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				err = r.Write(bytes.NewBufferString("Failed to get request"))
+				if err != nil {
+					fmt.Printf("failed to write response; %s\n", err)
+				}
+			} else {
+				config.ID = id
+				w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+				w.WriteHeader(http.StatusOK)
+				err = json.NewEncoder(w).Encode(config)
+				if err != nil {
+					fmt.Printf("failed to encode json; %s\n", err)
+				}
+			}
+		})
+		httpServer.AddRoute(http.MethodPost, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPost, resourceRestAPIPath+"/"+id)
+			jsonData := fmt.Sprintf(mobileAppAlertConfigServerResponseTemplate, id, modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+			},
+		})
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	ruleMetricName := fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldRule, MobileAppAlertConfigFieldRuleSlowness, MobileAppAlertConfigFieldRuleMetricName)
+	ruleAggregation := fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldRule, MobileAppAlertConfigFieldRuleSlowness, MobileAppAlertConfigFieldRuleAggregation)
+	thresholdStaticOperator := fmt.Sprintf("%s.0.%s.0.%s", ResourceFieldThreshold, ResourceFieldThresholdStatic, ResourceFieldThresholdOperator)
+	thresholdStaticValue := fmt.Sprintf("%s.0.%s.0.%s", ResourceFieldThreshold, ResourceFieldThresholdStatic, ResourceFieldThresholdStaticValue)
+	timeThresholdTimeWindow := fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldTimeThreshold, MobileAppAlertConfigFieldTimeThresholdViolationsInSequence, MobileAppAlertConfigFieldTimeThresholdTimeWindow)
+	customPayloadFieldStaticKey := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldKey)
+	customPayloadFieldStaticValue := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldStaticStringValue)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(mobileAppAlertConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldDescription, "test-alert-description"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldTriggering, "true"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldMobileAppID, "mobile-app-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldAlertChannelIDs+".1", "alert-channel-id-2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldGranularity, "600000"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldTagFilter, "mobileBeacon.platform@na EQUALS 'iOS'"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleMetricName, "httpLatency"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleAggregation, "P90"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, thresholdStaticOperator, ">="),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, thresholdStaticValue, "5"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, timeThresholdTimeWindow, "600000"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticKey, "test1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticValue, "test123"),
+		),
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_mobile_app_alert_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		customEventName := "purchase"
+		timeWindow := int64(600000)
+		thresholdValue := 12.3
+		config := restapi.MobileAppAlertConfig{
+			ID:              "mobile-app-alert-config-id",
+			Name:            "mobile-app-alert-config-name",
+			Description:     "mobile-app-alert-config-description",
+			Severity:        restapi.SeverityCritical.GetAPIRepresentation(),
+			Triggering:      true,
+			MobileAppID:     "mobile-app-id",
+			AlertChannelIDs: []string{"channel-1"},
+			Granularity:     restapi.Granularity600000,
+			CustomerPayloadFields: []restapi.CustomPayloadField[any]{
+				{
+					Type:  restapi.StaticStringCustomPayloadType,
+					Key:   "static-key",
+					Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+				},
+			},
+			Rule: restapi.MobileAppAlertRule{
+				AlertType:       "customEvent",
+				MetricName:      "customEvents",
+				CustomEventName: &customEventName,
+			},
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "mobileBeacon.platform", restapi.EqualsOperator, "iOS"),
+			Threshold: restapi.Threshold{
+				Type:     "staticThreshold",
+				Operator: restapi.ThresholdOperatorGreaterThan,
+				Value:    &thresholdValue,
+			},
+			TimeThreshold: restapi.MobileAppTimeThreshold{
+				Type:       "violationsInSequence",
+				TimeWindow: &timeWindow,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.NoError(t, err)
+		require.Equal(t, "mobile-app-alert-config-id", resourceData.Id())
+		require.Equal(t, "mobile-app-alert-config-name", resourceData.Get(MobileAppAlertConfigFieldName))
+		require.Equal(t, "mobile-app-alert-config-description", resourceData.Get(MobileAppAlertConfigFieldDescription))
+		require.Equal(t, restapi.SeverityCritical.GetTerraformRepresentation(), resourceData.Get(MobileAppAlertConfigFieldSeverity))
+		require.True(t, resourceData.Get(MobileAppAlertConfigFieldTriggering).(bool))
+		require.Equal(t, "mobile-app-id", resourceData.Get(MobileAppAlertConfigFieldMobileAppID))
+		require.Equal(t, []interface{}{"channel-1"}, resourceData.Get(MobileAppAlertConfigFieldAlertChannelIDs).(*schema.Set).List())
+		require.Equal(t, int(restapi.Granularity600000), resourceData.Get(MobileAppAlertConfigFieldGranularity))
+		require.Equal(t, "mobileBeacon.platform@na EQUALS 'iOS'", resourceData.Get(MobileAppAlertConfigFieldTagFilter))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				MobileAppAlertConfigFieldRuleCrash:      []interface{}{},
+				MobileAppAlertConfigFieldRuleSlowness:   []interface{}{},
+				MobileAppAlertConfigFieldRuleStatusCode: []interface{}{},
+				MobileAppAlertConfigFieldRuleThroughput: []interface{}{},
+				MobileAppAlertConfigFieldRuleCustomEvent: []interface{}{
+					map[string]interface{}{
+						MobileAppAlertConfigFieldRuleMetricName:      "customEvents",
+						MobileAppAlertConfigFieldRuleAggregation:     "",
+						MobileAppAlertConfigFieldRuleCustomEventName: "purchase",
+					},
+				},
+			},
+		}, resourceData.Get(MobileAppAlertConfigFieldRule))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence: []interface{}{},
+				MobileAppAlertConfigFieldTimeThresholdViolationsInPeriod:               []interface{}{},
+				MobileAppAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+					map[string]interface{}{
+						MobileAppAlertConfigFieldTimeThresholdTimeWindow: 600000,
+					},
+				},
+			},
+		}, resourceData.Get(MobileAppAlertConfigFieldTimeThreshold))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+			},
+		}, resourceData.Get(DefaultCustomPayloadFieldsName).(*schema.Set).List())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldUpdateTerraformResourceStateFromModelWithUserImpactTimeThreshold() func(t *testing.T) {
+	return func(t *testing.T) {
+		timeWindow := int64(600000)
+		impactMeasurementMethod := restapi.WebsiteImpactMeasurementMethodPerWindow
+		userPercentage := 0.5
+		users := int32(10)
+		thresholdValue := 12.3
+		config := restapi.MobileAppAlertConfig{
+			ID:          "mobile-app-alert-config-id",
+			Name:        "mobile-app-alert-config-name",
+			Description: "mobile-app-alert-config-description",
+			Severity:    restapi.SeverityWarning.GetAPIRepresentation(),
+			MobileAppID: "mobile-app-id",
+			Granularity: restapi.Granularity600000,
+			Rule: restapi.MobileAppAlertRule{
+				AlertType:  "crash",
+				MetricName: "crashes",
+			},
+			Threshold: restapi.Threshold{
+				Type:     "staticThreshold",
+				Operator: restapi.ThresholdOperatorGreaterThan,
+				Value:    &thresholdValue,
+			},
+			TimeThreshold: restapi.MobileAppTimeThreshold{
+				Type:                    "userImpactOfViolationsInSequence",
+				TimeWindow:              &timeWindow,
+				ImpactMeasurementMethod: &impactMeasurementMethod,
+				UserPercentage:          &userPercentage,
+				Users:                   &users,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.NoError(t, err)
+		require.Equal(t, "crashes", resourceData.Get(fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldRule, MobileAppAlertConfigFieldRuleCrash, MobileAppAlertConfigFieldRuleMetricName)))
+		timeThresholdPrefix := fmt.Sprintf("%s.0.%s.0.", MobileAppAlertConfigFieldTimeThreshold, MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence)
+		require.Equal(t, 600000, resourceData.Get(timeThresholdPrefix+MobileAppAlertConfigFieldTimeThresholdTimeWindow))
+		require.Equal(t, string(restapi.WebsiteImpactMeasurementMethodPerWindow), resourceData.Get(timeThresholdPrefix+MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod))
+		require.Equal(t, 0.5, resourceData.Get(timeThresholdPrefix+MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage))
+		require.Equal(t, 10, resourceData.Get(timeThresholdPrefix+MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers))
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := restapi.MobileAppAlertConfig{
+			Name:     "test",
+			Severity: -1,
+		}
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.Error(t, err)
+		require.Equal(t, "-1 is not a valid severity", err.Error())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("mobile-app-alert-config-id")
+		test.setRequiredValues(t, resourceData)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTagFilter, "mobileBeacon.platform@na EQUALS 'iOS'")
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		aggregation := restapi.Percentile90Aggregation
+		timeWindow := int64(600000)
+		thresholdValue := 12.3
+		thresholdLastUpdated := int64(0)
+		require.NoError(t, err)
+		require.Equal(t, &restapi.MobileAppAlertConfig{
+			ID:                    "mobile-app-alert-config-id",
+			Name:                  "mobile-app-alert-config-name",
+			Description:           "mobile-app-alert-config-description",
+			Severity:              restapi.SeverityWarning.GetAPIRepresentation(),
+			MobileAppID:           "mobile-app-id",
+			AlertChannelIDs:       []string{"channel-1"},
+			Granularity:           restapi.Granularity600000,
+			CustomerPayloadFields: []restapi.CustomPayloadField[any]{},
+			TagFilterExpression:   restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "mobileBeacon.platform", restapi.EqualsOperator, "iOS"),
+			Rule: restapi.MobileAppAlertRule{
+				AlertType:   "slowness",
+				MetricName:  "httpLatency",
+				Aggregation: &aggregation,
+			},
+			Threshold: restapi.Threshold{
+				Type:        "staticThreshold",
+				Operator:    restapi.ThresholdOperatorGreaterThan,
+				LastUpdated: &thresholdLastUpdated,
+				Value:       &thresholdValue,
+			},
+			TimeThreshold: restapi.MobileAppTimeThreshold{
+				Type:       "violationsInSequence",
+				TimeWindow: &timeWindow,
+			},
+		}, result)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldMapTerraformResourceStateWithStatusCodeRuleToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		test.setRequiredValues(t, resourceData)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldRule, []interface{}{
+			map[string]interface{}{
+				MobileAppAlertConfigFieldRuleStatusCode: []interface{}{
+					map[string]interface{}{
+						MobileAppAlertConfigFieldRuleMetricName: "httpStatusCode",
+						MobileAppAlertConfigFieldRuleOperator:   string(restapi.EqualsOperator),
+						MobileAppAlertConfigFieldRuleValue:      "500",
+					},
+				},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		operator := restapi.EqualsOperator
+		value := "500"
+		require.NoError(t, err)
+		require.Equal(t, restapi.MobileAppAlertRule{
+			AlertType:  "statusCode",
+			MetricName: "httpStatusCode",
+			Operator:   &operator,
+			Value:      &value,
+		}, result.Rule)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		test.setRequiredValues(t, resourceData)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldSeverity, "invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Equal(t, "invalid is not a valid severity", err.Error())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		test.setRequiredValues(t, resourceData)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTagFilter, "mobileBeacon.platform bla bla bla")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) setRequiredValues(t *testing.T, resourceData *schema.ResourceData) {
+	setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldName, "mobile-app-alert-config-name")
+	setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldDescription, "mobile-app-alert-config-description")
+	setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation())
+	setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldMobileAppID, "mobile-app-id")
+	setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldAlertChannelIDs, []interface{}{"channel-1"})
+	setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldGranularity, int(restapi.Granularity600000))
+	setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldRule, []interface{}{
+		map[string]interface{}{
+			MobileAppAlertConfigFieldRuleSlowness: []interface{}{
+				map[string]interface{}{
+					MobileAppAlertConfigFieldRuleMetricName:  "httpLatency",
+					MobileAppAlertConfigFieldRuleAggregation: string(restapi.Percentile90Aggregation),
+				},
+			},
+		},
+	})
+	setValueOnResourceData(t, resourceData, ResourceFieldThreshold, []interface{}{
+		map[string]interface{}{
+			ResourceFieldThresholdStatic: []interface{}{
+				map[string]interface{}{
+					ResourceFieldThresholdOperator:    string(restapi.ThresholdOperatorGreaterThan),
+					ResourceFieldThresholdStaticValue: 12.3,
+				},
+			},
+		},
+	})
+	setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTimeThreshold, []interface{}{
+		map[string]interface{}{
+			MobileAppAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+				map[string]interface{}{
+					MobileAppAlertConfigFieldTimeThresholdTimeWindow: 600000,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,18 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+// ResourceInstanaMobileAppGeoLocationConfig the name of the terraform-provider-instana resource to manage the geo location configuration of mobile apps
+const ResourceInstanaMobileAppGeoLocationConfig = "instana_mobile_app_geo_location_config"
+
+// MobileAppGeoLocationConfigFieldMobileAppID constant value for field mobile_app_id of resource instana_mobile_app_geo_location_config
+const MobileAppGeoLocationConfigFieldMobileAppID = "mobile_app_id"
+
+// NewMobileAppGeoLocationConfigResourceHandle creates the resource handle for the geo location configuration of mobile apps
+func NewMobileAppGeoLocationConfigResourceHandle() ResourceHandle[*restapi.GeoLocationConfig] {
+	return newGeoLocationConfigResourceHandle(ResourceInstanaMobileAppGeoLocationConfig, MobileAppGeoLocationConfigFieldMobileAppID, "The ID of the mobile app monitoring configuration", func(api restapi.InstanaAPI) restapi.RestResource[*restapi.GeoLocationConfig] {
+		return api.MobileAppGeoLocationConfigs()
+	})
+}
@@ -0,0 +1,55 @@
+package instana_test
+
+import (
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestMobileAppGeoLocationConfigShouldHaveCorrectResourceNameAndUseMobileAppIDAsResourceID(t *testing.T) {
+	sut := NewMobileAppGeoLocationConfigResourceHandle()
+
+	require.Equal(t, "instana_mobile_app_geo_location_config", sut.MetaData().ResourceName)
+	require.True(t, sut.MetaData().SkipIDGeneration)
+	require.Equal(t, MobileAppGeoLocationConfigFieldMobileAppID, *sut.MetaData().ResourceIDField)
+	require.True(t, sut.MetaData().Schema[MobileAppGeoLocationConfigFieldMobileAppID].ForceNew)
+}
+
+func TestMobileAppGeoLocationConfigShouldMapStateToModelAndBack(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GeoLocationConfig](t)
+	sut := NewMobileAppGeoLocationConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	setValueOnResourceData(t, resourceData, MobileAppGeoLocationConfigFieldMobileAppID, "mobile-app-id")
+	setValueOnResourceData(t, resourceData, GeoLocationConfigFieldGeoDetailRemoval, "REMOVE_ALL")
+	setValueOnResourceData(t, resourceData, GeoLocationConfigFieldGeoMappingRule, []interface{}{
+		map[string]interface{}{
+			GeoMappingRuleFieldCidr:    "192.168.0.0/16",
+			GeoMappingRuleFieldCountry: "Germany",
+		},
+	})
+
+	result, err := sut.MapStateToDataObject(resourceData)
+
+	country := "Germany"
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GeoLocationConfig{
+		ID:               "mobile-app-id",
+		GeoDetailRemoval: restapi.GeoDetailRemovalRemoveAll,
+		GeoMappingRules: []restapi.GeoMappingRule{
+			{Cidr: "192.168.0.0/16", Country: &country, Subdivisions: []restapi.GeoSubdivision{}},
+		},
+	}, result)
+
+	resourceData = testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	err = sut.UpdateState(resourceData, result)
+
+	require.NoError(t, err)
+	require.Equal(t, "mobile-app-id", resourceData.Id())
+	require.Equal(t, "mobile-app-id", resourceData.Get(MobileAppGeoLocationConfigFieldMobileAppID))
+	require.Equal(t, "REMOVE_ALL", resourceData.Get(GeoLocationConfigFieldGeoDetailRemoval))
+	require.Equal(t, "192.168.0.0/16", resourceData.Get(GeoLocationConfigFieldGeoMappingRule+".0."+GeoMappingRuleFieldCidr))
+	require.Equal(t, "Germany", resourceData.Get(GeoLocationConfigFieldGeoMappingRule+".0."+GeoMappingRuleFieldCountry))
+}
@@ -0,0 +1,18 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+// ResourceInstanaMobileAppIPMaskingConfig the name of the terraform-provider-instana resource to manage the ip masking configuration of mobile apps
+const ResourceInstanaMobileAppIPMaskingConfig = "instana_mobile_app_ip_masking_config"
+
+// MobileAppIPMaskingConfigFieldMobileAppID constant value for field mobile_app_id of resource instana_mobile_app_ip_masking_config
+const MobileAppIPMaskingConfigFieldMobileAppID = "mobile_app_id"
+
+// NewMobileAppIPMaskingConfigResourceHandle creates the resource handle for the ip masking configuration of mobile apps
+func NewMobileAppIPMaskingConfigResourceHandle() ResourceHandle[*restapi.IPMaskingConfig] {
+	return newIPMaskingConfigResourceHandle(ResourceInstanaMobileAppIPMaskingConfig, MobileAppIPMaskingConfigFieldMobileAppID, "The ID of the mobile app monitoring configuration", func(api restapi.InstanaAPI) restapi.RestResource[*restapi.IPMaskingConfig] {
+		return api.MobileAppIPMaskingConfigs()
+	})
+}
@@ -0,0 +1,40 @@
+package instana_test
+
+import (
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestMobileAppIPMaskingConfigShouldHaveCorrectResourceNameAndUseMobileAppIDAsResourceID(t *testing.T) {
+	sut := NewMobileAppIPMaskingConfigResourceHandle()
+
+	require.Equal(t, "instana_mobile_app_ip_masking_config", sut.MetaData().ResourceName)
+	require.True(t, sut.MetaData().SkipIDGeneration)
+	require.Equal(t, MobileAppIPMaskingConfigFieldMobileAppID, *sut.MetaData().ResourceIDField)
+	require.True(t, sut.MetaData().Schema[MobileAppIPMaskingConfigFieldMobileAppID].ForceNew)
+}
+
+func TestMobileAppIPMaskingConfigShouldMapStateToModelAndBack(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.IPMaskingConfig](t)
+	sut := NewMobileAppIPMaskingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	setValueOnResourceData(t, resourceData, MobileAppIPMaskingConfigFieldMobileAppID, "mobile-app-id")
+	setValueOnResourceData(t, resourceData, IPMaskingConfigFieldIPMasking, "STRICT")
+
+	result, err := sut.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.IPMaskingConfig{ID: "mobile-app-id", IPMasking: restapi.IPMaskingStrict}, result)
+
+	resourceData = testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	err = sut.UpdateState(resourceData, result)
+
+	require.NoError(t, err)
+	require.Equal(t, "mobile-app-id", resourceData.Id())
+	require.Equal(t, "mobile-app-id", resourceData.Get(MobileAppIPMaskingConfigFieldMobileAppID))
+	require.Equal(t, "STRICT", resourceData.Get(IPMaskingConfigFieldIPMasking))
+}
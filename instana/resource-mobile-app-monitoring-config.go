@@ -0,0 +1,78 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaMobileAppMonitoringConfig the name of the terraform-provider-instana resource to manage mobile app monitoring configurations
+const ResourceInstanaMobileAppMonitoringConfig = "instana_mobile_app_monitoring_config"
+
+const (
+	//MobileAppMonitoringConfigFieldName constant value for the schema field name
+	MobileAppMonitoringConfigFieldName = "name"
+	//MobileAppMonitoringConfigFieldAppKey constant value for the schema field app_key
+	MobileAppMonitoringConfigFieldAppKey = "app_key"
+)
+
+// NewMobileAppMonitoringConfigResourceHandle creates the resource handle for Mobile App Monitoring Configuration
+func NewMobileAppMonitoringConfigResourceHandle() ResourceHandle[*restapi.MobileAppMonitoringConfig] {
+	return &mobileAppMonitoringConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaMobileAppMonitoringConfig,
+			Schema: map[string]*schema.Schema{
+				MobileAppMonitoringConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+					Description:  "Configures the name of the mobile app monitoring configuration",
+				},
+				MobileAppMonitoringConfigFieldAppKey: {
+					Type:        schema.TypeString,
+					Required:    false,
+					Computed:    true,
+					Description: "The computed app key of the mobile app which is used to configure the Instana mobile agent",
+				},
+			},
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type mobileAppMonitoringConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *mobileAppMonitoringConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *mobileAppMonitoringConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *mobileAppMonitoringConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.MobileAppMonitoringConfig] {
+	return api.MobileAppMonitoringConfigs()
+}
+
+func (r *mobileAppMonitoringConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *mobileAppMonitoringConfigResource) UpdateState(d *schema.ResourceData, config *restapi.MobileAppMonitoringConfig) error {
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		MobileAppMonitoringConfigFieldName:   config.Name,
+		MobileAppMonitoringConfigFieldAppKey: config.ID,
+	})
+}
+
+func (r *mobileAppMonitoringConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.MobileAppMonitoringConfig, error) {
+	return &restapi.MobileAppMonitoringConfig{
+		ID:   d.Id(),
+		Name: d.Get(MobileAppMonitoringConfigFieldName).(string),
+	}, nil
+}
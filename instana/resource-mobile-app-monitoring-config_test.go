@@ -0,0 +1,164 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestMobileAppMonitoringConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaMobileAppMonitoringConfig + ".example"
+	inst := &mobileAppMonitoringConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewMobileAppMonitoringConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type mobileAppMonitoringConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.MobileAppMonitoringConfig]
+}
+
+var mobileAppMonitoringConfigTerraformTemplate = `
+resource "instana_mobile_app_monitoring_config" "example" {
+	name = "name %d"
+}
+`
+
+func (test *mobileAppMonitoringConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaMobileAppMonitoringConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaMobileAppMonitoringConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaMobileAppMonitoringConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaMobileAppMonitoringConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should have correct schema", ResourceInstanaMobileAppMonitoringConfig), test.createTestResourceShouldHaveCorrectSchema())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaMobileAppMonitoringConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaMobileAppMonitoringConfig), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *mobileAppMonitoringConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var serverState *restapi.MobileAppMonitoringConfig
+		writeJSON := func(w http.ResponseWriter, r *http.Request, data interface{}) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(data)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.MobileAppMonitoringConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			serverState = &restapi.MobileAppMonitoringConfig{ID: RandomID(), Name: r.URL.Query().Get("name")}
+			writeJSON(w, r, serverState)
+		})
+		httpServer.AddRoute(http.MethodPut, restapi.MobileAppMonitoringConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if serverState == nil || mux.Vars(r)["id"] != serverState.ID {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			serverState.Name = r.URL.Query().Get("name")
+			writeJSON(w, r, serverState)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.MobileAppMonitoringConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			serverState = nil
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.MobileAppMonitoringConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			configs := make([]*restapi.MobileAppMonitoringConfig, 0)
+			if serverState != nil {
+				configs = append(configs, serverState)
+			}
+			writeJSON(w, r, configs)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *mobileAppMonitoringConfigTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(mobileAppMonitoringConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, "id"),
+			resource.TestCheckResourceAttrPair(test.terraformResourceInstanceName, "id", test.terraformResourceInstanceName, MobileAppMonitoringConfigFieldAppKey),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppMonitoringConfigFieldName, formatResourceName(iteration)),
+		),
+	}
+}
+
+func (test *mobileAppMonitoringConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *mobileAppMonitoringConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *mobileAppMonitoringConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_mobile_app_monitoring_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *mobileAppMonitoringConfigTest) createTestResourceShouldHaveCorrectSchema() func(t *testing.T) {
+	return func(t *testing.T) {
+		schemaAssert := testutils.NewTerraformSchemaAssert(test.resourceHandle.MetaData().Schema, t)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppMonitoringConfigFieldName)
+		schemaAssert.AssertSchemaIsComputedAndOfTypeString(MobileAppMonitoringConfigFieldAppKey)
+	}
+}
+
+func (test *mobileAppMonitoringConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppMonitoringConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &restapi.MobileAppMonitoringConfig{ID: "mobile-app-id", Name: resourceName})
+
+		require.NoError(t, err)
+		require.Equal(t, "mobile-app-id", resourceData.Id())
+		require.Equal(t, resourceName, resourceData.Get(MobileAppMonitoringConfigFieldName))
+		require.Equal(t, "mobile-app-id", resourceData.Get(MobileAppMonitoringConfigFieldAppKey))
+	}
+}
+
+func (test *mobileAppMonitoringConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppMonitoringConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("mobile-app-id")
+		setValueOnResourceData(t, resourceData, MobileAppMonitoringConfigFieldName, resourceName)
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.MobileAppMonitoringConfig{ID: "mobile-app-id", Name: resourceName}, result)
+	}
+}
@@ -0,0 +1,64 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaRBACIdentityProviderConfig the name of the terraform-provider-instana resource to manage the identity provider configuration of the Instana tenant
+const ResourceInstanaRBACIdentityProviderConfig = "instana_rbac_identity_provider_config"
+
+// RBACIdentityProviderConfigFieldRestrictEmptyIdpGroups constant value for the schema field restrict_empty_idp_groups
+const RBACIdentityProviderConfigFieldRestrictEmptyIdpGroups = "restrict_empty_idp_groups"
+
+// NewRBACIdentityProviderConfigResourceHandle creates the resource handle for the identity provider configuration of the Instana tenant
+func NewRBACIdentityProviderConfigResourceHandle() ResourceHandle[*restapi.IdentityProviderConfig] {
+	return &rbacIdentityProviderConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaRBACIdentityProviderConfig,
+			Schema: map[string]*schema.Schema{
+				RBACIdentityProviderConfigFieldRestrictEmptyIdpGroups: {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Flag to indicate whether access is denied for users without any matching group mapping of the identity provider",
+				},
+			},
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type rbacIdentityProviderConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *rbacIdentityProviderConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *rbacIdentityProviderConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *rbacIdentityProviderConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.IdentityProviderConfig] {
+	return api.IdentityProviderConfig()
+}
+
+func (r *rbacIdentityProviderConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *rbacIdentityProviderConfigResource) UpdateState(d *schema.ResourceData, config *restapi.IdentityProviderConfig) error {
+	d.SetId(config.GetIDForResourcePath())
+	return tfutils.UpdateState(d, map[string]interface{}{
+		RBACIdentityProviderConfigFieldRestrictEmptyIdpGroups: config.RestrictEmptyIdpGroups,
+	})
+}
+
+func (r *rbacIdentityProviderConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.IdentityProviderConfig, error) {
+	return &restapi.IdentityProviderConfig{
+		RestrictEmptyIdpGroups: d.Get(RBACIdentityProviderConfigFieldRestrictEmptyIdpGroups).(bool),
+	}, nil
+}
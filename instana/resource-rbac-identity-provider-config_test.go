@@ -0,0 +1,136 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestRBACIdentityProviderConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaRBACIdentityProviderConfig + ".example"
+	inst := &rbacIdentityProviderConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewRBACIdentityProviderConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type rbacIdentityProviderConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.IdentityProviderConfig]
+}
+
+var rbacIdentityProviderConfigTerraformTemplate = `
+resource "instana_rbac_identity_provider_config" "example" {
+	restrict_empty_idp_groups = %t
+}
+`
+
+func (test *rbacIdentityProviderConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaRBACIdentityProviderConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaRBACIdentityProviderConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaRBACIdentityProviderConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaRBACIdentityProviderConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaRBACIdentityProviderConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaRBACIdentityProviderConfig), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *rbacIdentityProviderConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		serverState := restapi.NewDefaultIdentityProviderConfig()
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, restapi.IdentityProviderConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.IdentityProviderConfig{}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, config)
+			serverState = config
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.IdentityProviderConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(serverState)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), true),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.IdentityProviderConfigID),
+				test.createIntegrationTestStep(httpServer.GetPort(), false),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.IdentityProviderConfigID),
+			},
+		})
+	}
+}
+
+func (test *rbacIdentityProviderConfigTest) createIntegrationTestStep(httpPort int, restrictEmptyIdpGroups bool) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(rbacIdentityProviderConfigTerraformTemplate, restrictEmptyIdpGroups), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", restapi.IdentityProviderConfigID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, RBACIdentityProviderConfigFieldRestrictEmptyIdpGroups, fmt.Sprintf("%t", restrictEmptyIdpGroups)),
+		),
+	}
+}
+
+func (test *rbacIdentityProviderConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *rbacIdentityProviderConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *rbacIdentityProviderConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_rbac_identity_provider_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *rbacIdentityProviderConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.IdentityProviderConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &restapi.IdentityProviderConfig{RestrictEmptyIdpGroups: true})
+
+		require.NoError(t, err)
+		require.Equal(t, restapi.IdentityProviderConfigID, resourceData.Id())
+		require.True(t, resourceData.Get(RBACIdentityProviderConfigFieldRestrictEmptyIdpGroups).(bool))
+	}
+}
+
+func (test *rbacIdentityProviderConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.IdentityProviderConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, RBACIdentityProviderConfigFieldRestrictEmptyIdpGroups, true)
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.IdentityProviderConfig{RestrictEmptyIdpGroups: true}, result)
+	}
+}
@@ -0,0 +1,88 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaRBACMapping the name of the terraform-provider-instana resource to manage mappings of identity provider groups to Instana groups
+const ResourceInstanaRBACMapping = "instana_rbac_mapping"
+
+const (
+	//RBACMappingFieldGroupID constant value for the schema field group_id
+	RBACMappingFieldGroupID = "group_id"
+	//RBACMappingFieldKey constant value for the schema field key
+	RBACMappingFieldKey = "key"
+	//RBACMappingFieldValue constant value for the schema field value
+	RBACMappingFieldValue = "value"
+)
+
+// NewRBACMappingResourceHandle creates the resource handle for mappings of identity provider groups to Instana groups
+func NewRBACMappingResourceHandle() ResourceHandle[*restapi.GroupMapping] {
+	return &rbacMappingResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaRBACMapping,
+			Schema: map[string]*schema.Schema{
+				RBACMappingFieldGroupID: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The ID of the Instana group (instana_rbac_group) to which users are assigned when the mapping matches",
+				},
+				RBACMappingFieldKey: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(0, 65536),
+					Description:  "The key of the attribute sent by the identity provider, e.g. memberOf",
+				},
+				RBACMappingFieldValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(0, 65536),
+					Description:  "The value of the attribute sent by the identity provider which needs to match, e.g. the name of the identity provider group",
+				},
+			},
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type rbacMappingResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *rbacMappingResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *rbacMappingResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *rbacMappingResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GroupMapping] {
+	return api.GroupMappings()
+}
+
+func (r *rbacMappingResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *rbacMappingResource) UpdateState(d *schema.ResourceData, mapping *restapi.GroupMapping) error {
+	d.SetId(mapping.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		RBACMappingFieldGroupID: mapping.GroupID,
+		RBACMappingFieldKey:     mapping.Key,
+		RBACMappingFieldValue:   mapping.Value,
+	})
+}
+
+func (r *rbacMappingResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GroupMapping, error) {
+	return &restapi.GroupMapping{
+		ID:      d.Id(),
+		GroupID: d.Get(RBACMappingFieldGroupID).(string),
+		Key:     d.Get(RBACMappingFieldKey).(string),
+		Value:   d.Get(RBACMappingFieldValue).(string),
+	}, nil
+}
@@ -0,0 +1,170 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestRBACMapping(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaRBACMapping + ".example"
+	inst := &rbacMappingTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewRBACMappingResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type rbacMappingTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.GroupMapping]
+}
+
+var rbacMappingTerraformTemplate = `
+resource "instana_rbac_mapping" "example" {
+	group_id = "group-id-%d"
+	key      = "memberOf"
+	value    = "idp-group-%d"
+}
+`
+
+func (test *rbacMappingTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaRBACMapping), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaRBACMapping), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaRBACMapping), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaRBACMapping), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaRBACMapping), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaRBACMapping), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *rbacMappingTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var serverState *restapi.GroupMapping
+		writeJSON := func(w http.ResponseWriter, r *http.Request, data interface{}) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(data)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+		readMapping := func(r *http.Request) *restapi.GroupMapping {
+			mapping := &restapi.GroupMapping{}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, mapping)
+			return mapping
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.RBACMappingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			serverState = readMapping(r)
+			serverState.ID = RandomID()
+			writeJSON(w, r, serverState)
+		})
+		httpServer.AddRoute(http.MethodPut, restapi.RBACMappingsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if serverState == nil || mux.Vars(r)["id"] != serverState.ID {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			serverState = readMapping(r)
+			writeJSON(w, r, serverState)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.RBACMappingsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			serverState = nil
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.RBACMappingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mappings := make([]*restapi.GroupMapping, 0)
+			if serverState != nil {
+				mappings = append(mappings, serverState)
+			}
+			writeJSON(w, r, mappings)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *rbacMappingTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(rbacMappingTerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, "id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, RBACMappingFieldGroupID, fmt.Sprintf("group-id-%d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, RBACMappingFieldKey, "memberOf"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, RBACMappingFieldValue, fmt.Sprintf("idp-group-%d", iteration)),
+		),
+	}
+}
+
+func (test *rbacMappingTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *rbacMappingTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *rbacMappingTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_rbac_mapping", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *rbacMappingTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		mapping := &restapi.GroupMapping{ID: "mapping-id", GroupID: "group-id", Key: "memberOf", Value: "idp-group"}
+		testHelper := NewTestHelper[*restapi.GroupMapping](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, mapping)
+
+		require.NoError(t, err)
+		require.Equal(t, "mapping-id", resourceData.Id())
+		require.Equal(t, "group-id", resourceData.Get(RBACMappingFieldGroupID))
+		require.Equal(t, "memberOf", resourceData.Get(RBACMappingFieldKey))
+		require.Equal(t, "idp-group", resourceData.Get(RBACMappingFieldValue))
+	}
+}
+
+func (test *rbacMappingTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.GroupMapping](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("mapping-id")
+		setValueOnResourceData(t, resourceData, RBACMappingFieldGroupID, "group-id")
+		setValueOnResourceData(t, resourceData, RBACMappingFieldKey, "memberOf")
+		setValueOnResourceData(t, resourceData, RBACMappingFieldValue, "idp-group")
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.GroupMapping{ID: "mapping-id", GroupID: "group-id", Key: "memberOf", Value: "idp-group"}, result)
+	}
+}
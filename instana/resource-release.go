@@ -0,0 +1,182 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaRelease the name of the terraform-provider-instana resource to manage releases
+const ResourceInstanaRelease = "instana_release"
+
+const (
+	//ReleaseFieldName constant value for the schema field name
+	ReleaseFieldName = "name"
+	//ReleaseFieldStart constant value for the schema field start
+	ReleaseFieldStart = "start"
+	//ReleaseFieldApplication constant value for the schema field application
+	ReleaseFieldApplication = "application"
+	//ReleaseFieldService constant value for the schema field service
+	ReleaseFieldService = "service"
+	//ReleaseFieldScopeName constant value for the schema field application.name and service.name
+	ReleaseFieldScopeName = "name"
+	//ReleaseFieldServiceApplicationNames constant value for the schema field service.application_names
+	ReleaseFieldServiceApplicationNames = "application_names"
+)
+
+// NewReleaseResourceHandle creates the resource handle for releases
+func NewReleaseResourceHandle() ResourceHandle[*restapi.Release] {
+	return &releaseResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaRelease,
+			Schema: map[string]*schema.Schema{
+				ReleaseFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+					Description:  "The name of the release",
+				},
+				ReleaseFieldStart: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The start timestamp of the release in milliseconds since epoch",
+				},
+				ReleaseFieldApplication: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 10,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ReleaseFieldScopeName: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(0, 256),
+								Description:  "The name of the application perspective",
+							},
+						},
+					},
+					Description: "The application perspectives to which the release is scoped",
+				},
+				ReleaseFieldService: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 10,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ReleaseFieldScopeName: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(0, 256),
+								Description:  "The name of the service",
+							},
+							ReleaseFieldServiceApplicationNames: {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 10,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(0, 256),
+								},
+								Description: "The names of the application perspectives to which the service scope is limited",
+							},
+						},
+					},
+					Description: "The services to which the release is scoped",
+				},
+			},
+			SchemaVersion: 0,
+		},
+	}
+}
+
+type releaseResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *releaseResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *releaseResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *releaseResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.Release] {
+	return api.Releases()
+}
+
+func (r *releaseResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *releaseResource) UpdateState(d *schema.ResourceData, release *restapi.Release) error {
+	applications := make([]interface{}, len(release.Applications))
+	for i, application := range release.Applications {
+		applications[i] = map[string]interface{}{
+			ReleaseFieldScopeName: application.Name,
+		}
+	}
+
+	services := make([]interface{}, len(release.Services))
+	for i, service := range release.Services {
+		applicationNames := make([]interface{}, 0)
+		if service.ScopedTo != nil {
+			for _, application := range service.ScopedTo.Applications {
+				applicationNames = append(applicationNames, application.Name)
+			}
+		}
+		services[i] = map[string]interface{}{
+			ReleaseFieldScopeName:               service.Name,
+			ReleaseFieldServiceApplicationNames: applicationNames,
+		}
+	}
+
+	d.SetId(release.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ReleaseFieldName:        release.Name,
+		ReleaseFieldStart:       release.Start,
+		ReleaseFieldApplication: applications,
+		ReleaseFieldService:     services,
+	})
+}
+
+func (r *releaseResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.Release, error) {
+	applicationsState := d.Get(ReleaseFieldApplication).([]interface{})
+	applications := make([]restapi.ReleaseApplicationScope, len(applicationsState))
+	for i, v := range applicationsState {
+		application := v.(map[string]interface{})
+		applications[i] = restapi.ReleaseApplicationScope{Name: application[ReleaseFieldScopeName].(string)}
+	}
+
+	servicesState := d.Get(ReleaseFieldService).([]interface{})
+	services := make([]restapi.ReleaseServiceScope, len(servicesState))
+	for i, v := range servicesState {
+		service := v.(map[string]interface{})
+		services[i] = restapi.ReleaseServiceScope{
+			Name:     service[ReleaseFieldScopeName].(string),
+			ScopedTo: r.mapServiceScopedToFromState(service),
+		}
+	}
+
+	return &restapi.Release{
+		ID:           d.Id(),
+		Name:         d.Get(ReleaseFieldName).(string),
+		Start:        int64(d.Get(ReleaseFieldStart).(int)),
+		Applications: applications,
+		Services:     services,
+	}, nil
+}
+
+func (r *releaseResource) mapServiceScopedToFromState(service map[string]interface{}) *restapi.ReleaseServiceScopedTo {
+	applicationNames := ReadArrayParameterFromMap[string](service, ReleaseFieldServiceApplicationNames)
+	if len(applicationNames) == 0 {
+		return nil
+	}
+	applications := make([]restapi.ReleaseApplicationScope, len(applicationNames))
+	for i, name := range applicationNames {
+		applications[i] = restapi.ReleaseApplicationScope{Name: name}
+	}
+	return &restapi.ReleaseServiceScopedTo{Applications: applications}
+}
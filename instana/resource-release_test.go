@@ -0,0 +1,244 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestRelease(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaRelease + ".example"
+	inst := &releaseTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewReleaseResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type releaseTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.Release]
+}
+
+var releaseTerraformTemplate = `
+resource "instana_release" "example" {
+	name  = "release-%d"
+	start = 170000000000%d
+
+	application {
+		name = "app-1"
+	}
+
+	service {
+		name              = "service-1"
+		application_names = [ "app-1", "app-2" ]
+	}
+
+	service {
+		name = "service-2"
+	}
+}
+`
+
+func (test *releaseTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaRelease), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaRelease), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaRelease), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaRelease), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaRelease), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should update terraform state from model without scopes", ResourceInstanaRelease), test.createTestShouldUpdateTerraformResourceStateFromModelWithoutScopes())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaRelease), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *releaseTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var serverState *restapi.Release
+		writeJSON := func(w http.ResponseWriter, r *http.Request, data interface{}) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(data)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+		readRelease := func(r *http.Request) *restapi.Release {
+			release := &restapi.Release{}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, release)
+			return release
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.ReleaseResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			serverState = readRelease(r)
+			serverState.ID = RandomID()
+			writeJSON(w, r, serverState)
+		})
+		httpServer.AddRoute(http.MethodPut, restapi.ReleaseResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if serverState == nil || mux.Vars(r)["id"] != serverState.ID {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			id := serverState.ID
+			serverState = readRelease(r)
+			serverState.ID = id
+			writeJSON(w, r, serverState)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.ReleaseResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			serverState = nil
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.ReleaseResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if serverState == nil || mux.Vars(r)["id"] != serverState.ID {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeJSON(w, r, serverState)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *releaseTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(releaseTerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, "id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ReleaseFieldName, fmt.Sprintf("release-%d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ReleaseFieldStart, fmt.Sprintf("170000000000%d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.#", ReleaseFieldApplication), "1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.0.%s", ReleaseFieldApplication, ReleaseFieldScopeName), "app-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.#", ReleaseFieldService), "2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.0.%s", ReleaseFieldService, ReleaseFieldScopeName), "service-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.0.%s.0", ReleaseFieldService, ReleaseFieldServiceApplicationNames), "app-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.0.%s.1", ReleaseFieldService, ReleaseFieldServiceApplicationNames), "app-2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.1.%s", ReleaseFieldService, ReleaseFieldScopeName), "service-2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.1.%s.#", ReleaseFieldService, ReleaseFieldServiceApplicationNames), "0"),
+		),
+	}
+}
+
+func (test *releaseTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *releaseTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *releaseTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_release", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *releaseTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		release := &restapi.Release{
+			ID:           "release-id",
+			Name:         "release",
+			Start:        1700000000000,
+			Applications: []restapi.ReleaseApplicationScope{{ID: "app-id-1", Name: "app-1"}},
+			Services: []restapi.ReleaseServiceScope{
+				{
+					ID:       "service-id-1",
+					Name:     "service-1",
+					ScopedTo: &restapi.ReleaseServiceScopedTo{Applications: []restapi.ReleaseApplicationScope{{ID: "app-id-1", Name: "app-1"}, {ID: "app-id-2", Name: "app-2"}}},
+				},
+				{ID: "service-id-2", Name: "service-2"},
+			},
+		}
+		testHelper := NewTestHelper[*restapi.Release](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, release)
+
+		require.NoError(t, err)
+		require.Equal(t, "release-id", resourceData.Id())
+		require.Equal(t, "release", resourceData.Get(ReleaseFieldName))
+		require.Equal(t, 1700000000000, resourceData.Get(ReleaseFieldStart))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{ReleaseFieldScopeName: "app-1"},
+		}, resourceData.Get(ReleaseFieldApplication))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{ReleaseFieldScopeName: "service-1", ReleaseFieldServiceApplicationNames: []interface{}{"app-1", "app-2"}},
+			map[string]interface{}{ReleaseFieldScopeName: "service-2", ReleaseFieldServiceApplicationNames: []interface{}{}},
+		}, resourceData.Get(ReleaseFieldService))
+	}
+}
+
+func (test *releaseTest) createTestShouldUpdateTerraformResourceStateFromModelWithoutScopes() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.Release](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &restapi.Release{ID: "release-id", Name: "release", Start: 1700000000000})
+
+		require.NoError(t, err)
+		require.Equal(t, "release-id", resourceData.Id())
+		require.Empty(t, resourceData.Get(ReleaseFieldApplication))
+		require.Empty(t, resourceData.Get(ReleaseFieldService))
+	}
+}
+
+func (test *releaseTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.Release](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("release-id")
+		setValueOnResourceData(t, resourceData, ReleaseFieldName, "release")
+		setValueOnResourceData(t, resourceData, ReleaseFieldStart, 1700000000000)
+		setValueOnResourceData(t, resourceData, ReleaseFieldApplication, []interface{}{
+			map[string]interface{}{ReleaseFieldScopeName: "app-1"},
+		})
+		setValueOnResourceData(t, resourceData, ReleaseFieldService, []interface{}{
+			map[string]interface{}{ReleaseFieldScopeName: "service-1", ReleaseFieldServiceApplicationNames: []interface{}{"app-1", "app-2"}},
+			map[string]interface{}{ReleaseFieldScopeName: "service-2"},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.Release{
+			ID:           "release-id",
+			Name:         "release",
+			Start:        1700000000000,
+			Applications: []restapi.ReleaseApplicationScope{{Name: "app-1"}},
+			Services: []restapi.ReleaseServiceScope{
+				{
+					Name:     "service-1",
+					ScopedTo: &restapi.ReleaseServiceScopedTo{Applications: []restapi.ReleaseApplicationScope{{Name: "app-1"}, {Name: "app-2"}}},
+				},
+				{Name: "service-2"},
+			},
+		}, result)
+	}
+}
@@ -0,0 +1,87 @@
+package instana
+
+import (
+	"regexp"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaSyntheticCredential the name of the terraform-provider-instana resource to manage credentials of synthetic tests
+const ResourceInstanaSyntheticCredential = "instana_synthetic_credential"
+
+const (
+	//SyntheticCredentialFieldName constant value for the schema field name
+	SyntheticCredentialFieldName = "name"
+	//SyntheticCredentialFieldValue constant value for the schema field value
+	SyntheticCredentialFieldValue = "value"
+)
+
+var syntheticCredentialNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+// NewSyntheticCredentialResourceHandle creates the resource handle for credentials of synthetic tests
+func NewSyntheticCredentialResourceHandle() ResourceHandle[*restapi.SyntheticCredential] {
+	nameField := SyntheticCredentialFieldName
+	return &syntheticCredentialResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSyntheticCredential,
+			Schema: map[string]*schema.Schema{
+				SyntheticCredentialFieldName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 64),
+						validation.StringMatch(syntheticCredentialNameRegex, "must start with a letter and contain only letters, digits and underscores"),
+					),
+					Description: "The name of the credential which is used to reference the credential in synthetic test scripts",
+				},
+				SyntheticCredentialFieldValue: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Sensitive:   true,
+					Description: "The secret value of the credential. The value is write-only and cannot be read from Instana. Changes of the value result in a re-creation of the credential",
+				},
+			},
+			SkipIDGeneration: true,
+			ResourceIDField:  &nameField,
+			CreateOnly:       true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type syntheticCredentialResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *syntheticCredentialResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *syntheticCredentialResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *syntheticCredentialResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SyntheticCredential] {
+	return api.SyntheticCredentials()
+}
+
+func (r *syntheticCredentialResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *syntheticCredentialResource) UpdateState(d *schema.ResourceData, credential *restapi.SyntheticCredential) error {
+	d.SetId(credential.Name)
+	//the value is not provided by the Instana API and therefore kept as configured
+	return d.Set(SyntheticCredentialFieldName, credential.Name)
+}
+
+func (r *syntheticCredentialResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SyntheticCredential, error) {
+	return &restapi.SyntheticCredential{
+		Name:  d.Get(SyntheticCredentialFieldName).(string),
+		Value: d.Get(SyntheticCredentialFieldValue).(string),
+	}, nil
+}
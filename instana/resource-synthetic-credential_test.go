@@ -0,0 +1,208 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestSyntheticCredential(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaSyntheticCredential + ".example"
+	inst := &syntheticCredentialTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewSyntheticCredentialResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type syntheticCredentialTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.SyntheticCredential]
+}
+
+const syntheticCredentialName = "my_credential"
+
+var syntheticCredentialTerraformTemplate = `
+resource "instana_synthetic_credential" "example" {
+	name  = "my_credential"
+	value = "secret-%d"
+}
+`
+
+func (test *syntheticCredentialTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaSyntheticCredential), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaSyntheticCredential), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaSyntheticCredential), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaSyntheticCredential), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should be create only", ResourceInstanaSyntheticCredential), test.createTestResourceShouldBeCreateOnly())
+	t.Run(fmt.Sprintf("%s should mark value as sensitive", ResourceInstanaSyntheticCredential), test.createTestResourceShouldMarkValueAsSensitive())
+	t.Run(fmt.Sprintf("%s should reject invalid credential names", ResourceInstanaSyntheticCredential), test.createTestResourceShouldRejectInvalidCredentialNames())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaSyntheticCredential), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaSyntheticCredential), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *syntheticCredentialTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		serverState := make(map[string]string)
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.SyntheticCredentialResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			credential := &restapi.SyntheticCredential{}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, credential)
+			if _, ok := serverState[credential.Name]; ok {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			serverState[credential.Name] = credential.Value
+			w.WriteHeader(http.StatusCreated)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.SyntheticCredentialResourcePath+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+			delete(serverState, mux.Vars(r)["name"])
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.SyntheticCredentialResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			names := make([]string, 0)
+			for name := range serverState {
+				names = append(names, name)
+			}
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(names)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, serverState),
+				test.createImportTestStep(),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, serverState),
+				test.createImportTestStep(),
+			},
+		})
+	}
+}
+
+func (test *syntheticCredentialTest) createIntegrationTestStep(httpPort int, iteration int, serverState map[string]string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(syntheticCredentialTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", syntheticCredentialName),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticCredentialFieldName, syntheticCredentialName),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticCredentialFieldValue, fmt.Sprintf("secret-%d", iteration)),
+			func(_ *terraform.State) error {
+				if serverState[syntheticCredentialName] != fmt.Sprintf("secret-%d", iteration) {
+					return fmt.Errorf("credential value %s not stored at server", fmt.Sprintf("secret-%d", iteration))
+				}
+				return nil
+			},
+		),
+	}
+}
+
+func (test *syntheticCredentialTest) createImportTestStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            test.terraformResourceInstanceName,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateId:           syntheticCredentialName,
+		ImportStateVerifyIgnore: []string{SyntheticCredentialFieldValue},
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_synthetic_credential", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldBeCreateOnly() func(t *testing.T) {
+	return func(t *testing.T) {
+		metaData := test.resourceHandle.MetaData()
+		require.True(t, metaData.CreateOnly)
+		require.True(t, metaData.Schema[SyntheticCredentialFieldName].ForceNew)
+		require.True(t, metaData.Schema[SyntheticCredentialFieldValue].ForceNew)
+		require.Nil(t, NewTerraformResource(test.resourceHandle).ToSchemaResource().UpdateContext)
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldMarkValueAsSensitive() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.True(t, test.resourceHandle.MetaData().Schema[SyntheticCredentialFieldValue].Sensitive)
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldRejectInvalidCredentialNames() func(t *testing.T) {
+	return func(t *testing.T) {
+		validateFunc := test.resourceHandle.MetaData().Schema[SyntheticCredentialFieldName].ValidateFunc
+
+		for _, name := range []string{"", "1abc", "_abc", "ab-c", "ab c", "a" + strings.Repeat("b", 64)} {
+			_, errs := validateFunc(name, SyntheticCredentialFieldName)
+			require.NotEmpty(t, errs, "expected name '%s' to be rejected", name)
+		}
+		for _, name := range []string{"a", "abc_123", "ABC", "a" + strings.Repeat("b", 63)} {
+			_, errs := validateFunc(name, SyntheticCredentialFieldName)
+			require.Empty(t, errs, "expected name '%s' to be accepted", name)
+		}
+	}
+}
+
+func (test *syntheticCredentialTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticCredential](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, SyntheticCredentialFieldValue, "secret")
+
+		err := sut.UpdateState(resourceData, &restapi.SyntheticCredential{Name: syntheticCredentialName})
+
+		require.NoError(t, err)
+		require.Equal(t, syntheticCredentialName, resourceData.Id())
+		require.Equal(t, syntheticCredentialName, resourceData.Get(SyntheticCredentialFieldName))
+		require.Equal(t, "secret", resourceData.Get(SyntheticCredentialFieldValue))
+	}
+}
+
+func (test *syntheticCredentialTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticCredential](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId(syntheticCredentialName)
+		setValueOnResourceData(t, resourceData, SyntheticCredentialFieldName, syntheticCredentialName)
+		setValueOnResourceData(t, resourceData, SyntheticCredentialFieldValue, "secret")
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.SyntheticCredential{Name: syntheticCredentialName, Value: "secret"}, result)
+	}
+}
@@ -33,6 +33,14 @@ const (
 	SyntheticTestFieldConfigHttpScript = "http_script"
 	//SyntheticTestFieldConfigHttpAction constant value for the schema field configuration.http_action
 	SyntheticTestFieldConfigHttpAction = "http_action"
+	//SyntheticTestFieldConfigBrowserScript constant value for the schema field configuration.browser_script
+	SyntheticTestFieldConfigBrowserScript = "browser_script"
+	//SyntheticTestFieldConfigWebpageAction constant value for the schema field configuration.webpage_action
+	SyntheticTestFieldConfigWebpageAction = "webpage_action"
+	//SyntheticTestFieldConfigWebpageScript constant value for the schema field configuration.webpage_script
+	SyntheticTestFieldConfigWebpageScript = "webpage_script"
+	//SyntheticTestFieldConfigDNS constant value for the schema field configuration.dns
+	SyntheticTestFieldConfigDNS = "dns"
 
 	//SyntheticTestFieldConfigMarkSyntheticCall constant value for the schema field configuration.mark_synthetic_call
 	SyntheticTestFieldConfigMarkSyntheticCall = "mark_synthetic_call"
@@ -62,15 +70,55 @@ const (
 	SyntheticTestFieldConfigExpectMatch = "expect_match"
 	//SyntheticTestFieldConfigScript constant value for the schema field configuration.script
 	SyntheticTestFieldConfigScript = "script"
+	//SyntheticTestFieldConfigScriptType constant value for the schema field configuration.script_type
+	SyntheticTestFieldConfigScriptType = "script_type"
+	//SyntheticTestFieldConfigScripts constant value for the schema field configuration.scripts
+	SyntheticTestFieldConfigScripts = "scripts"
+	//SyntheticTestFieldConfigScriptsBundle constant value for the schema field configuration.scripts.bundle
+	SyntheticTestFieldConfigScriptsBundle = "bundle"
+	//SyntheticTestFieldConfigScriptsScriptFile constant value for the schema field configuration.scripts.script_file
+	SyntheticTestFieldConfigScriptsScriptFile = "script_file"
+	//SyntheticTestFieldConfigBrowser constant value for the schema field configuration.browser
+	SyntheticTestFieldConfigBrowser = "browser"
+	//SyntheticTestFieldConfigRecordVideo constant value for the schema field configuration.record_video
+	SyntheticTestFieldConfigRecordVideo = "record_video"
+	//SyntheticTestFieldConfigLookup constant value for the schema field configuration.lookup
+	SyntheticTestFieldConfigLookup = "lookup"
+	//SyntheticTestFieldConfigServer constant value for the schema field configuration.server
+	SyntheticTestFieldConfigServer = "server"
+	//SyntheticTestFieldConfigPort constant value for the schema field configuration.port
+	SyntheticTestFieldConfigPort = "port"
+	//SyntheticTestFieldConfigQueryType constant value for the schema field configuration.query_type
+	SyntheticTestFieldConfigQueryType = "query_type"
+	//SyntheticTestFieldConfigAcceptCNAME constant value for the schema field configuration.accept_cname
+	SyntheticTestFieldConfigAcceptCNAME = "accept_cname"
+	//SyntheticTestFieldConfigLookupServerName constant value for the schema field configuration.lookup_server_name
+	SyntheticTestFieldConfigLookupServerName = "lookup_server_name"
+	//SyntheticTestFieldConfigRecursiveLookups constant value for the schema field configuration.recursive_lookups
+	SyntheticTestFieldConfigRecursiveLookups = "recursive_lookups"
+	//SyntheticTestFieldConfigServerRetries constant value for the schema field configuration.server_retries
+	SyntheticTestFieldConfigServerRetries = "server_retries"
 )
 
 var syntheticTestConfigurationOptions = []string{
-	"http_script",
-	"http_action",
+	SyntheticTestFieldConfigHttpScript,
+	SyntheticTestFieldConfigHttpAction,
+	SyntheticTestFieldConfigBrowserScript,
+	SyntheticTestFieldConfigWebpageAction,
+	SyntheticTestFieldConfigWebpageScript,
+	SyntheticTestFieldConfigDNS,
 }
 
 const SyntheticCheckTypeHttpAction = "HTTPAction"
 const SyntheticCheckTypeHttpScript = "HTTPScript"
+const SyntheticCheckTypeBrowserScript = "BrowserScript"
+const SyntheticCheckTypeWebpageAction = "WebpageAction"
+const SyntheticCheckTypeWebpageScript = "WebpageScript"
+const SyntheticCheckTypeDNSAction = "DNSAction"
+
+var supportedSyntheticTestBrowsers = []string{"chrome", "firefox"}
+var supportedSyntheticTestScriptTypes = []string{"Basic", "Jest"}
+var supportedSyntheticTestDNSQueryTypes = []string{"A", "AAAA", "ANY", "AXFR", "CNAME", "HINFO", "MAILB", "MAILA", "MINFO", "MB", "MD", "MF", "MG", "MR", "MX", "NULL", "NS", "PTR", "SOA", "TXT", "WKS"}
 
 var (
 	syntheticTestSchemaConfigMarkSyntheticCall = &schema.Schema{
@@ -98,10 +146,67 @@ var (
 		Optional:    true,
 		Description: "The timeout to be used by the PoP playback engines running the test",
 	}
+	syntheticTestSchemaConfigScriptType = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The type of the script (Basic or Jest)",
+		ValidateFunc: validation.StringInSlice(supportedSyntheticTestScriptTypes, false),
+	}
+	syntheticTestSchemaConfigBrowser = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "chrome",
+		Description:  "The browser used to execute the test (chrome or firefox)",
+		ValidateFunc: validation.StringInSlice(supportedSyntheticTestBrowsers, false),
+	}
+	syntheticTestSchemaConfigRecordVideo = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Flag to indicate whether a video of the test execution is recorded",
+	}
 )
 
+func newSyntheticTestSchemaConfigScriptAndScripts(configField string) (*schema.Schema, *schema.Schema) {
+	exactlyOneOf := []string{
+		fmt.Sprintf("%s.0.%s", configField, SyntheticTestFieldConfigScript),
+		fmt.Sprintf("%s.0.%s", configField, SyntheticTestFieldConfigScripts),
+	}
+	script := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The Javascript content in plain text. Either script or scripts must be provided",
+		ExactlyOneOf: exactlyOneOf,
+	}
+	scripts := &schema.Schema{
+		Type:         schema.TypeList,
+		MinItems:     0,
+		MaxItems:     1,
+		Optional:     true,
+		Description:  "The configuration of a test composed of multiple script files. Either script or scripts must be provided",
+		ExactlyOneOf: exactlyOneOf,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				SyntheticTestFieldConfigScriptsBundle: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The base64 encoded zip archive containing the script files",
+				},
+				SyntheticTestFieldConfigScriptsScriptFile: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the script file within the bundle which is executed",
+				},
+			},
+		},
+	}
+	return script, scripts
+}
+
 // NewSyntheticTestResourceHandle creates the resource handle Synthetic Tests
 func NewSyntheticTestResourceHandle() ResourceHandle[*restapi.SyntheticTest] {
+	httpScriptScript, httpScriptScripts := newSyntheticTestSchemaConfigScriptAndScripts(SyntheticTestFieldConfigHttpScript)
+	browserScriptScript, browserScriptScripts := newSyntheticTestSchemaConfigScriptAndScripts(SyntheticTestFieldConfigBrowserScript)
 	return &syntheticTestResource{
 		metaData: ResourceMetaData{
 			ResourceName: ResourceInstanaSyntheticTest,
@@ -205,6 +310,70 @@ func NewSyntheticTestResourceHandle() ResourceHandle[*restapi.SyntheticTest] {
 					Optional:     true,
 					Description:  "The configuration of the synthetic alert of type http action",
 					ExactlyOneOf: syntheticTestConfigurationOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticTestFieldConfigMarkSyntheticCall: syntheticTestSchemaConfigMarkSyntheticCall,
+							SyntheticTestFieldConfigRetries:           syntheticTestSchemaConfigRetries,
+							SyntheticTestFieldConfigRetryInterval:     syntheticTestSchemaConfigRetryInterval,
+							SyntheticTestFieldConfigTimeout:           syntheticTestSchemaConfigTimeout,
+							SyntheticTestFieldConfigScript:            httpScriptScript,
+							SyntheticTestFieldConfigScriptType:        syntheticTestSchemaConfigScriptType,
+							SyntheticTestFieldConfigScripts:           httpScriptScripts,
+						},
+					},
+				},
+				SyntheticTestFieldConfigBrowserScript: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The configuration of the synthetic test of type browser script",
+					ExactlyOneOf: syntheticTestConfigurationOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticTestFieldConfigMarkSyntheticCall: syntheticTestSchemaConfigMarkSyntheticCall,
+							SyntheticTestFieldConfigRetries:           syntheticTestSchemaConfigRetries,
+							SyntheticTestFieldConfigRetryInterval:     syntheticTestSchemaConfigRetryInterval,
+							SyntheticTestFieldConfigTimeout:           syntheticTestSchemaConfigTimeout,
+							SyntheticTestFieldConfigScript:            browserScriptScript,
+							SyntheticTestFieldConfigScriptType:        syntheticTestSchemaConfigScriptType,
+							SyntheticTestFieldConfigScripts:           browserScriptScripts,
+							SyntheticTestFieldConfigBrowser:           syntheticTestSchemaConfigBrowser,
+							SyntheticTestFieldConfigRecordVideo:       syntheticTestSchemaConfigRecordVideo,
+						},
+					},
+				},
+				SyntheticTestFieldConfigWebpageAction: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The configuration of the synthetic test of type webpage action",
+					ExactlyOneOf: syntheticTestConfigurationOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticTestFieldConfigMarkSyntheticCall: syntheticTestSchemaConfigMarkSyntheticCall,
+							SyntheticTestFieldConfigRetries:           syntheticTestSchemaConfigRetries,
+							SyntheticTestFieldConfigRetryInterval:     syntheticTestSchemaConfigRetryInterval,
+							SyntheticTestFieldConfigTimeout:           syntheticTestSchemaConfigTimeout,
+							SyntheticTestFieldConfigUrl: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The URL of the webpage which is being tested",
+								ValidateFunc: validation.All(validation.IsURLWithHTTPorHTTPS, validation.StringLenBetween(0, 2047)),
+							},
+							SyntheticTestFieldConfigBrowser:     syntheticTestSchemaConfigBrowser,
+							SyntheticTestFieldConfigRecordVideo: syntheticTestSchemaConfigRecordVideo,
+						},
+					},
+				},
+				SyntheticTestFieldConfigWebpageScript: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The configuration of the synthetic test of type webpage script",
+					ExactlyOneOf: syntheticTestConfigurationOptions,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							SyntheticTestFieldConfigMarkSyntheticCall: syntheticTestSchemaConfigMarkSyntheticCall,
@@ -212,9 +381,76 @@ func NewSyntheticTestResourceHandle() ResourceHandle[*restapi.SyntheticTest] {
 							SyntheticTestFieldConfigRetryInterval:     syntheticTestSchemaConfigRetryInterval,
 							SyntheticTestFieldConfigTimeout:           syntheticTestSchemaConfigTimeout,
 							SyntheticTestFieldConfigScript: {
-								Type:        schema.TypeString,
-								Required:    true,
-								Description: "The Javascript content in plain text",
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The Selenium IDE script content in plain text",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							SyntheticTestFieldConfigBrowser:     syntheticTestSchemaConfigBrowser,
+							SyntheticTestFieldConfigRecordVideo: syntheticTestSchemaConfigRecordVideo,
+						},
+					},
+				},
+				SyntheticTestFieldConfigDNS: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The configuration of the synthetic test of type DNS action",
+					ExactlyOneOf: syntheticTestConfigurationOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticTestFieldConfigMarkSyntheticCall: syntheticTestSchemaConfigMarkSyntheticCall,
+							SyntheticTestFieldConfigRetries:           syntheticTestSchemaConfigRetries,
+							SyntheticTestFieldConfigRetryInterval:     syntheticTestSchemaConfigRetryInterval,
+							SyntheticTestFieldConfigTimeout:           syntheticTestSchemaConfigTimeout,
+							SyntheticTestFieldConfigLookup: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The name or IP address of the host which is looked up",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							SyntheticTestFieldConfigServer: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The IP address of the DNS server which is queried",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							SyntheticTestFieldConfigPort: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "The port of the DNS server",
+								ValidateFunc: validation.IsPortNumber,
+							},
+							SyntheticTestFieldConfigQueryType: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "The DNS query type",
+								ValidateFunc: validation.StringInSlice(supportedSyntheticTestDNSQueryTypes, false),
+							},
+							SyntheticTestFieldConfigAcceptCNAME: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Flag to indicate whether CNAME records are accepted as valid response",
+							},
+							SyntheticTestFieldConfigLookupServerName: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Flag to indicate whether the server name is looked up",
+							},
+							SyntheticTestFieldConfigRecursiveLookups: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Flag to indicate whether recursive lookups are enabled",
+							},
+							SyntheticTestFieldConfigServerRetries: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "The number of retries when the DNS server does not respond",
+								ValidateFunc: validation.IntAtLeast(0),
 							},
 						},
 					},
@@ -281,21 +517,30 @@ func (r *syntheticTestResource) UpdateState(d *schema.ResourceData, syntheticTes
 	}
 	d.SetId(syntheticTest.ID)
 	return tfutils.UpdateState(d, map[string]interface{}{
-		SyntheticTestFieldLabel:            syntheticTest.Label,
-		SyntheticTestFieldActive:           syntheticTest.Active,
-		SyntheticTestFieldDescription:      syntheticTest.Description,
-		SyntheticTestFieldApplicationID:    syntheticTest.ApplicationID,
-		SyntheticTestFieldCustomProperties: syntheticTest.CustomProperties,
-		SyntheticTestFieldLocations:        syntheticTest.Locations,
-		SyntheticTestFieldPlaybackMode:     syntheticTest.PlaybackMode,
-		SyntheticTestFieldTestFrequency:    syntheticTest.TestFrequency,
-		SyntheticTestFieldConfigHttpAction: r.mapHttpActionConfig(&syntheticTest.Configuration),
-		SyntheticTestFieldConfigHttpScript: r.mapHttpScriptConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldLabel:               syntheticTest.Label,
+		SyntheticTestFieldActive:              syntheticTest.Active,
+		SyntheticTestFieldDescription:         syntheticTest.Description,
+		SyntheticTestFieldApplicationID:       syntheticTest.ApplicationID,
+		SyntheticTestFieldCustomProperties:    syntheticTest.CustomProperties,
+		SyntheticTestFieldLocations:           syntheticTest.Locations,
+		SyntheticTestFieldPlaybackMode:        syntheticTest.PlaybackMode,
+		SyntheticTestFieldTestFrequency:       syntheticTest.TestFrequency,
+		SyntheticTestFieldConfigHttpAction:    r.mapHttpActionConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigHttpScript:    r.mapHttpScriptConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigBrowserScript: r.mapBrowserScriptConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigWebpageAction: r.mapWebpageActionConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigWebpageScript: r.mapWebpageScriptConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigDNS:           r.mapDNSActionConfig(&syntheticTest.Configuration),
 	})
 }
 
 func (r *syntheticTestResource) isSupportedConfigurationProvided(config *restapi.SyntheticTestConfig) bool {
-	return config.SyntheticType != SyntheticCheckTypeHttpAction && config.SyntheticType != SyntheticCheckTypeHttpScript
+	switch config.SyntheticType {
+	case SyntheticCheckTypeHttpAction, SyntheticCheckTypeHttpScript, SyntheticCheckTypeBrowserScript, SyntheticCheckTypeWebpageAction, SyntheticCheckTypeWebpageScript, SyntheticCheckTypeDNSAction:
+		return false
+	default:
+		return true
+	}
 }
 
 func (r *syntheticTestResource) mapHttpActionConfig(config *restapi.SyntheticTestConfig) []interface{} {
@@ -319,6 +564,71 @@ func (r *syntheticTestResource) mapHttpScriptConfig(config *restapi.SyntheticTes
 	if config.SyntheticType == SyntheticCheckTypeHttpScript {
 		configuration := r.mapCommonConfigurationOptions(config)
 		configuration[SyntheticTestFieldConfigScript] = config.Script
+		configuration[SyntheticTestFieldConfigScriptType] = config.ScriptType
+		configuration[SyntheticTestFieldConfigScripts] = r.mapMultipleScriptsConfig(config.Scripts)
+		return []interface{}{configuration}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapBrowserScriptConfig(config *restapi.SyntheticTestConfig) []interface{} {
+	if config.SyntheticType == SyntheticCheckTypeBrowserScript {
+		configuration := r.mapCommonConfigurationOptions(config)
+		configuration[SyntheticTestFieldConfigScript] = config.Script
+		configuration[SyntheticTestFieldConfigScriptType] = config.ScriptType
+		configuration[SyntheticTestFieldConfigScripts] = r.mapMultipleScriptsConfig(config.Scripts)
+		configuration[SyntheticTestFieldConfigBrowser] = config.Browser
+		configuration[SyntheticTestFieldConfigRecordVideo] = config.RecordVideo
+		return []interface{}{configuration}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapMultipleScriptsConfig(scripts *restapi.MultipleScriptsConfiguration) []interface{} {
+	if scripts != nil {
+		return []interface{}{
+			map[string]interface{}{
+				SyntheticTestFieldConfigScriptsBundle:     scripts.Bundle,
+				SyntheticTestFieldConfigScriptsScriptFile: scripts.ScriptFile,
+			},
+		}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapWebpageActionConfig(config *restapi.SyntheticTestConfig) []interface{} {
+	if config.SyntheticType == SyntheticCheckTypeWebpageAction {
+		configuration := r.mapCommonConfigurationOptions(config)
+		configuration[SyntheticTestFieldConfigUrl] = config.URL
+		configuration[SyntheticTestFieldConfigBrowser] = config.Browser
+		configuration[SyntheticTestFieldConfigRecordVideo] = config.RecordVideo
+		return []interface{}{configuration}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapWebpageScriptConfig(config *restapi.SyntheticTestConfig) []interface{} {
+	if config.SyntheticType == SyntheticCheckTypeWebpageScript {
+		configuration := r.mapCommonConfigurationOptions(config)
+		configuration[SyntheticTestFieldConfigScript] = config.Script
+		configuration[SyntheticTestFieldConfigBrowser] = config.Browser
+		configuration[SyntheticTestFieldConfigRecordVideo] = config.RecordVideo
+		return []interface{}{configuration}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapDNSActionConfig(config *restapi.SyntheticTestConfig) []interface{} {
+	if config.SyntheticType == SyntheticCheckTypeDNSAction {
+		configuration := r.mapCommonConfigurationOptions(config)
+		configuration[SyntheticTestFieldConfigLookup] = config.Lookup
+		configuration[SyntheticTestFieldConfigServer] = config.Server
+		configuration[SyntheticTestFieldConfigPort] = config.Port
+		configuration[SyntheticTestFieldConfigQueryType] = config.QueryType
+		configuration[SyntheticTestFieldConfigAcceptCNAME] = config.AcceptCNAME
+		configuration[SyntheticTestFieldConfigLookupServerName] = config.LookupServerName
+		configuration[SyntheticTestFieldConfigRecursiveLookups] = config.RecursiveLookups
+		configuration[SyntheticTestFieldConfigServerRetries] = config.ServerRetries
 		return []interface{}{configuration}
 	}
 	return []interface{}{}
@@ -367,16 +677,22 @@ func (r *syntheticTestResource) mapConfigurationFromSchema(d *schema.ResourceDat
 	} else if val, ok := d.GetOk(SyntheticTestFieldConfigHttpScript); ok && len(val.([]interface{})) == 1 {
 		syntheticTestType = SyntheticCheckTypeHttpScript
 		syntheticTestConfigData = val.([]interface{})[0].(map[string]interface{})
+	} else if val, ok := d.GetOk(SyntheticTestFieldConfigBrowserScript); ok && len(val.([]interface{})) == 1 {
+		syntheticTestType = SyntheticCheckTypeBrowserScript
+		syntheticTestConfigData = val.([]interface{})[0].(map[string]interface{})
+	} else if val, ok := d.GetOk(SyntheticTestFieldConfigWebpageAction); ok && len(val.([]interface{})) == 1 {
+		syntheticTestType = SyntheticCheckTypeWebpageAction
+		syntheticTestConfigData = val.([]interface{})[0].(map[string]interface{})
+	} else if val, ok := d.GetOk(SyntheticTestFieldConfigWebpageScript); ok && len(val.([]interface{})) == 1 {
+		syntheticTestType = SyntheticCheckTypeWebpageScript
+		syntheticTestConfigData = val.([]interface{})[0].(map[string]interface{})
+	} else if val, ok := d.GetOk(SyntheticTestFieldConfigDNS); ok && len(val.([]interface{})) == 1 {
+		syntheticTestType = SyntheticCheckTypeDNSAction
+		syntheticTestConfigData = val.([]interface{})[0].(map[string]interface{})
 	} else {
 		return restapi.SyntheticTestConfig{}, errors.New("no supported synthetic test configuration provided")
 	}
 
-	expectedStatusAsInt := GetPointerFromMap[int](syntheticTestConfigData, SyntheticTestFieldConfigExpectStatus)
-	var expectedStatus *int32
-	if expectedStatusAsInt != nil {
-		v := int32(*expectedStatusAsInt)
-		expectedStatus = &v
-	}
 	headersRaw, ok := syntheticTestConfigData[SyntheticTestFieldConfigHeaders]
 	var headers map[string]interface{}
 	if ok {
@@ -395,8 +711,40 @@ func (r *syntheticTestResource) mapConfigurationFromSchema(d *schema.ResourceDat
 		ValidationString:  GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigValidationString),
 		FollowRedirect:    GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigFollowRedirect),
 		AllowInsecure:     GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigAllowInsecure),
-		ExpectStatus:      expectedStatus,
+		ExpectStatus:      r.getInt32PointerFromMap(syntheticTestConfigData, SyntheticTestFieldConfigExpectStatus),
 		ExpectMatch:       GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigExpectMatch),
 		Script:            GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigScript),
+		ScriptType:        GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigScriptType),
+		Scripts:           r.mapMultipleScriptsConfigFromSchema(syntheticTestConfigData),
+		Browser:           GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigBrowser),
+		RecordVideo:       GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigRecordVideo),
+		Lookup:            GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigLookup),
+		Server:            GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigServer),
+		Port:              r.getInt32PointerFromMap(syntheticTestConfigData, SyntheticTestFieldConfigPort),
+		QueryType:         GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigQueryType),
+		AcceptCNAME:       GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigAcceptCNAME),
+		LookupServerName:  GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigLookupServerName),
+		RecursiveLookups:  GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigRecursiveLookups),
+		ServerRetries:     r.getInt32PointerFromMap(syntheticTestConfigData, SyntheticTestFieldConfigServerRetries),
 	}, nil
 }
+
+func (r *syntheticTestResource) getInt32PointerFromMap(data map[string]interface{}, key string) *int32 {
+	valueAsInt := GetPointerFromMap[int](data, key)
+	if valueAsInt != nil {
+		v := int32(*valueAsInt)
+		return &v
+	}
+	return nil
+}
+
+func (r *syntheticTestResource) mapMultipleScriptsConfigFromSchema(syntheticTestConfigData map[string]interface{}) *restapi.MultipleScriptsConfiguration {
+	if val, ok := syntheticTestConfigData[SyntheticTestFieldConfigScripts]; ok && val != nil && len(val.([]interface{})) == 1 && val.([]interface{})[0] != nil {
+		scriptsData := val.([]interface{})[0].(map[string]interface{})
+		return &restapi.MultipleScriptsConfiguration{
+			Bundle:     GetPointerFromMap[string](scriptsData, SyntheticTestFieldConfigScriptsBundle),
+			ScriptFile: GetPointerFromMap[string](scriptsData, SyntheticTestFieldConfigScriptsScriptFile),
+		}
+	}
+	return nil
+}
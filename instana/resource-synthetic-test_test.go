@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
@@ -21,15 +23,30 @@ func TestSyntheticTestResource(t *testing.T) {
 	t.Run("CRUD integration test with HTTP Action configuration without application id", syntheticTestHttpActionWithoutApplicationIdIntegrationTest().testCrud)
 	t.Run("CRUD integration test with HTTP Action configuration with application id", syntheticTestHttpActionWithApplicationIdIntegrationTest().testCrud)
 	t.Run("CRUD integration test with HTTP Script", syntheticTestHttpScriptIntegrationTest().testCrud)
+	t.Run("CRUD integration test with Browser Script", syntheticTestBrowserScriptIntegrationTest().testCrud)
+	t.Run("CRUD integration test with Webpage Action", syntheticTestWebpageActionIntegrationTest().testCrud)
+	t.Run("CRUD integration test with Webpage Script", syntheticTestWebpageScriptIntegrationTest().testCrud)
+	t.Run("CRUD integration test with DNS", syntheticTestDNSIntegrationTest().testCrud)
 	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
 	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
 	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
 	t.Run("should have schema no state upgrader", ut.shouldHaveNoStateUpgrader)
 	t.Run("should update resource state for http script config", ut.shouldUpdateResourceStateForHttpScript)
 	t.Run("should update resource state for http action config", ut.shouldUpdateResourceStateForHttpAction)
+	t.Run("should update resource state for browser script config", ut.shouldUpdateResourceStateForBrowserScript)
+	t.Run("should update resource state for webpage action config", ut.shouldUpdateResourceStateForWebpageAction)
+	t.Run("should update resource state for webpage script config", ut.shouldUpdateResourceStateForWebpageScript)
+	t.Run("should update resource state for dns config", ut.shouldUpdateResourceStateForDNS)
 	t.Run("should return error when trying to update state and config type is not supported", ut.shouldReturnErrorWhenTryingToUpdateStateAndConfigTypeIsNotSupported)
 	t.Run("should map state to data model with http action config", ut.shouldMapStateToDataModelWithConfigOfTypeHttpAction)
 	t.Run("should map state to data model with http script config", ut.shouldMapStateToDataModelWithConfigOfTypeHttpScript)
+	t.Run("should map state to data model with http script config using multiple scripts", ut.shouldMapStateToDataModelWithConfigOfTypeHttpScriptUsingMultipleScripts)
+	t.Run("should reject empty scripts block of http script config", ut.shouldRejectEmptyScriptsBlockOfHttpScriptConfig)
+	t.Run("should accept complete scripts block of http script config", ut.shouldAcceptCompleteScriptsBlockOfHttpScriptConfig)
+	t.Run("should map state to data model with browser script config", ut.shouldMapStateToDataModelWithConfigOfTypeBrowserScript)
+	t.Run("should map state to data model with webpage action config", ut.shouldMapStateToDataModelWithConfigOfTypeWebpageAction)
+	t.Run("should map state to data model with webpage script config", ut.shouldMapStateToDataModelWithConfigOfTypeWebpageScript)
+	t.Run("should map state to data model with dns config", ut.shouldMapStateToDataModelWithConfigOfTypeDNS)
 	t.Run("should return errror when trying to map state to model when no configuration is provided", ut.shouldReturnErrorWhenTryingToMapStateToModelWhenNoConfigurationIsProvided)
 }
 
@@ -248,6 +265,283 @@ resource "instana_synthetic_test" "example" {
 	return newSyntheticTestIntegrationTest(terraformTemplate, serverResponseTemplate, checks)
 }
 
+func syntheticTestBrowserScriptIntegrationTest() *syntheticTestResourceIntegrationTest {
+	const terraformTemplate = `
+resource "instana_synthetic_test" "example" {
+	label          = "label %d"
+	active         = true
+	locations      = ["location-id"]
+	test_frequency = 10
+	playback_mode  = "Staggered"
+
+	browser_script {
+		mark_synthetic_call = true
+		retries             = 0
+		retry_interval      = 1
+		timeout             = "3m"
+		script_type         = "Jest"
+		browser             = "firefox"
+		record_video        = true
+
+		scripts {
+			bundle      = "my-bundle"
+			script_file = "index.js"
+		}
+	}
+
+	custom_properties = {
+		"key1" = "val1"
+		"key2" = "val2"
+	}
+}
+`
+
+	const serverResponseTemplate = `
+{
+    "id": "%s",
+    "label": "label %d",
+    "active": true,
+    "locations": ["location-id"],
+    "testFrequency": 10,
+    "playbackMode": "Staggered",
+
+    "configuration": {
+        "syntheticType": "BrowserScript",
+        "markSyntheticCall": true,
+		"retryInterval": 1,
+		"timeout": "3m",
+		"scriptType": "Jest",
+		"browser": "firefox",
+		"recordVideo": true,
+		"scripts": {
+			"bundle": "my-bundle",
+			"scriptFile": "index.js"
+		}
+    },
+
+	"customProperties": {
+		"key1": "val1",
+		"key2": "val2"
+	}
+}
+`
+	var checks = []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigMarkSyntheticCall), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigRetries), "0"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigRetryInterval), "1"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigTimeout), "3m"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigScriptType), "Jest"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigBrowser), "firefox"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigRecordVideo), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf("%s.0.%s.0.%s", SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigScripts, SyntheticTestFieldConfigScriptsBundle), "my-bundle"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf("%s.0.%s.0.%s", SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigScripts, SyntheticTestFieldConfigScriptsScriptFile), "index.js"),
+	}
+	return newSyntheticTestIntegrationTest(terraformTemplate, serverResponseTemplate, checks)
+}
+
+func syntheticTestWebpageActionIntegrationTest() *syntheticTestResourceIntegrationTest {
+	const terraformTemplate = `
+resource "instana_synthetic_test" "example" {
+	label          = "label %d"
+	active         = true
+	locations      = ["location-id"]
+	test_frequency = 10
+	playback_mode  = "Staggered"
+
+	webpage_action {
+		mark_synthetic_call = true
+		retries             = 0
+		retry_interval      = 1
+		timeout             = "3m"
+		url                 = "https://example.com"
+		browser             = "chrome"
+	}
+
+	custom_properties = {
+		"key1" = "val1"
+		"key2" = "val2"
+	}
+}
+`
+
+	const serverResponseTemplate = `
+{
+    "id": "%s",
+    "label": "label %d",
+    "active": true,
+    "locations": ["location-id"],
+    "testFrequency": 10,
+    "playbackMode": "Staggered",
+
+    "configuration": {
+        "syntheticType": "WebpageAction",
+        "markSyntheticCall": true,
+		"retryInterval": 1,
+		"timeout": "3m",
+		"url": "https://example.com",
+		"browser": "chrome",
+		"recordVideo": false
+    },
+
+	"customProperties": {
+		"key1": "val1",
+		"key2": "val2"
+	}
+}
+`
+	var checks = []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageAction, SyntheticTestFieldConfigMarkSyntheticCall), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageAction, SyntheticTestFieldConfigRetries), "0"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageAction, SyntheticTestFieldConfigRetryInterval), "1"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageAction, SyntheticTestFieldConfigTimeout), "3m"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageAction, SyntheticTestFieldConfigUrl), syntheticTestUrl),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageAction, SyntheticTestFieldConfigBrowser), "chrome"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageAction, SyntheticTestFieldConfigRecordVideo), "false"),
+	}
+	return newSyntheticTestIntegrationTest(terraformTemplate, serverResponseTemplate, checks)
+}
+
+func syntheticTestWebpageScriptIntegrationTest() *syntheticTestResourceIntegrationTest {
+	const terraformTemplate = `
+resource "instana_synthetic_test" "example" {
+	label          = "label %d"
+	active         = true
+	locations      = ["location-id"]
+	test_frequency = 10
+	playback_mode  = "Staggered"
+
+	webpage_script {
+		mark_synthetic_call = true
+		retries             = 0
+		retry_interval      = 1
+		timeout             = "3m"
+		script              = "my-side-script"
+		browser             = "firefox"
+		record_video        = true
+	}
+
+	custom_properties = {
+		"key1" = "val1"
+		"key2" = "val2"
+	}
+}
+`
+
+	const serverResponseTemplate = `
+{
+    "id": "%s",
+    "label": "label %d",
+    "active": true,
+    "locations": ["location-id"],
+    "testFrequency": 10,
+    "playbackMode": "Staggered",
+
+    "configuration": {
+        "syntheticType": "WebpageScript",
+        "markSyntheticCall": true,
+		"retryInterval": 1,
+		"timeout": "3m",
+		"script": "my-side-script",
+		"browser": "firefox",
+		"recordVideo": true
+    },
+
+	"customProperties": {
+		"key1": "val1",
+		"key2": "val2"
+	}
+}
+`
+	var checks = []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageScript, SyntheticTestFieldConfigMarkSyntheticCall), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageScript, SyntheticTestFieldConfigRetries), "0"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageScript, SyntheticTestFieldConfigRetryInterval), "1"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageScript, SyntheticTestFieldConfigTimeout), "3m"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageScript, SyntheticTestFieldConfigScript), "my-side-script"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageScript, SyntheticTestFieldConfigBrowser), "firefox"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigWebpageScript, SyntheticTestFieldConfigRecordVideo), "true"),
+	}
+	return newSyntheticTestIntegrationTest(terraformTemplate, serverResponseTemplate, checks)
+}
+
+func syntheticTestDNSIntegrationTest() *syntheticTestResourceIntegrationTest {
+	const terraformTemplate = `
+resource "instana_synthetic_test" "example" {
+	label          = "label %d"
+	active         = true
+	locations      = ["location-id"]
+	test_frequency = 10
+	playback_mode  = "Staggered"
+
+	dns {
+		mark_synthetic_call = true
+		retries             = 0
+		retry_interval      = 1
+		timeout             = "3m"
+		lookup              = "example.com"
+		server              = "8.8.8.8"
+		port                = 53
+		query_type          = "A"
+		accept_cname        = true
+		lookup_server_name  = false
+		recursive_lookups   = true
+		server_retries      = 2
+	}
+
+	custom_properties = {
+		"key1" = "val1"
+		"key2" = "val2"
+	}
+}
+`
+
+	const serverResponseTemplate = `
+{
+    "id": "%s",
+    "label": "label %d",
+    "active": true,
+    "locations": ["location-id"],
+    "testFrequency": 10,
+    "playbackMode": "Staggered",
+
+    "configuration": {
+        "syntheticType": "DNSAction",
+        "markSyntheticCall": true,
+		"retryInterval": 1,
+		"timeout": "3m",
+		"lookup": "example.com",
+		"server": "8.8.8.8",
+		"port": 53,
+		"queryType": "A",
+		"acceptCNAME": true,
+		"lookupServerName": false,
+		"recursiveLookups": true,
+		"serverRetries": 2
+    },
+
+	"customProperties": {
+		"key1": "val1",
+		"key2": "val2"
+	}
+}
+`
+	var checks = []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigMarkSyntheticCall), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigRetries), "0"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigRetryInterval), "1"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigTimeout), "3m"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigLookup), "example.com"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigServer), "8.8.8.8"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigPort), "53"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigQueryType), "A"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigAcceptCNAME), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigLookupServerName), "false"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigRecursiveLookups), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigServerRetries), "2"),
+	}
+	return newSyntheticTestIntegrationTest(terraformTemplate, serverResponseTemplate, checks)
+}
+
 func newSyntheticTestIntegrationTest(resourceTemplate string, serverResponseTemplate string, useCaseSpecificChecks []resource.TestCheckFunc) *syntheticTestResourceIntegrationTest {
 	return &syntheticTestResourceIntegrationTest{
 		resourceTemplate:       resourceTemplate,
@@ -348,7 +642,7 @@ func (ut *syntheticTestUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
 	schemaMap := resourceHandle.MetaData().Schema
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
-	require.Len(t, schemaMap, 10)
+	require.Len(t, schemaMap, 14)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldLabel)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticTestFieldDescription)
 	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldActive, true)
@@ -359,11 +653,23 @@ func (ut *syntheticTestUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SyntheticTestFieldTestFrequency)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigHttpAction)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigHttpScript)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigBrowserScript)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigWebpageAction)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigWebpageScript)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigDNS)
 
 	httpActionSchema := schemaMap[SyntheticTestFieldConfigHttpAction].Elem.(*schema.Resource).Schema
 	ut.verifyHttpActionSchema(t, httpActionSchema)
 	httpScriptSchema := schemaMap[SyntheticTestFieldConfigHttpScript].Elem.(*schema.Resource).Schema
 	ut.verifyHttpScriptSchema(t, httpScriptSchema)
+	browserScriptSchema := schemaMap[SyntheticTestFieldConfigBrowserScript].Elem.(*schema.Resource).Schema
+	ut.verifyBrowserScriptSchema(t, browserScriptSchema)
+	webpageActionSchema := schemaMap[SyntheticTestFieldConfigWebpageAction].Elem.(*schema.Resource).Schema
+	ut.verifyWebpageActionSchema(t, webpageActionSchema)
+	webpageScriptSchema := schemaMap[SyntheticTestFieldConfigWebpageScript].Elem.(*schema.Resource).Schema
+	ut.verifyWebpageScriptSchema(t, webpageScriptSchema)
+	dnsSchema := schemaMap[SyntheticTestFieldConfigDNS].Elem.(*schema.Resource).Schema
+	ut.verifyDNSSchema(t, dnsSchema)
 }
 
 func (ut *syntheticTestUnitTest) verifyHttpActionSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
@@ -383,9 +689,64 @@ func (ut *syntheticTestUnitTest) verifyHttpActionSchema(t *testing.T, schemaMap
 
 func (ut *syntheticTestUnitTest) verifyHttpScriptSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
-	require.Len(t, schemaMap, 5)
+	require.Len(t, schemaMap, 7)
+	ut.verifyCommonConfigurationFields(schemaAssert)
+	ut.verifyScriptConfigurationFields(t, schemaAssert, schemaMap)
+}
+
+func (ut *syntheticTestUnitTest) verifyBrowserScriptSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 9)
+	ut.verifyCommonConfigurationFields(schemaAssert)
+	ut.verifyScriptConfigurationFields(t, schemaAssert, schemaMap)
+	ut.verifyBrowserConfigurationFields(schemaAssert)
+}
+
+func (ut *syntheticTestUnitTest) verifyScriptConfigurationFields(t *testing.T, schemaAssert testutils.TerraformSchemaAssert, schemaMap map[string]*schema.Schema) {
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticTestFieldConfigScript)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticTestFieldConfigScriptType)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigScripts)
+
+	scriptsSchema := schemaMap[SyntheticTestFieldConfigScripts].Elem.(*schema.Resource).Schema
+	scriptsSchemaAssert := testutils.NewTerraformSchemaAssert(scriptsSchema, t)
+	require.Len(t, scriptsSchema, 2)
+	scriptsSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigScriptsBundle)
+	scriptsSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigScriptsScriptFile)
+}
+
+func (ut *syntheticTestUnitTest) verifyWebpageActionSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 7)
+	ut.verifyCommonConfigurationFields(schemaAssert)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigUrl)
+	ut.verifyBrowserConfigurationFields(schemaAssert)
+}
+
+func (ut *syntheticTestUnitTest) verifyWebpageScriptSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 7)
 	ut.verifyCommonConfigurationFields(schemaAssert)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigScript)
+	ut.verifyBrowserConfigurationFields(schemaAssert)
+}
+
+func (ut *syntheticTestUnitTest) verifyBrowserConfigurationFields(schemaAssert testutils.TerraformSchemaAssert) {
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SyntheticTestFieldConfigBrowser, "chrome")
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldConfigRecordVideo, false)
+}
+
+func (ut *syntheticTestUnitTest) verifyDNSSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 12)
+	ut.verifyCommonConfigurationFields(schemaAssert)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigLookup)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigServer)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SyntheticTestFieldConfigPort)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticTestFieldConfigQueryType)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldConfigAcceptCNAME, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldConfigLookupServerName, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldConfigRecursiveLookups, false)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SyntheticTestFieldConfigServerRetries)
 }
 
 func (ut *syntheticTestUnitTest) verifyCommonConfigurationFields(schemaAssert testutils.TerraformSchemaAssert) {
@@ -467,12 +828,191 @@ func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForHttpScript(t *testi
 	require.IsType(t, map[string]interface{}{}, httpScriptConfigs[0])
 
 	httpScriptConfig := httpScriptConfigs[0].(map[string]interface{})
-	require.Len(t, httpScriptConfig, 5)
+	require.Len(t, httpScriptConfig, 7)
 	require.Equal(t, true, httpScriptConfig[SyntheticTestFieldConfigMarkSyntheticCall])
 	require.Equal(t, 5, httpScriptConfig[SyntheticTestFieldConfigRetries])
 	require.Equal(t, 10, httpScriptConfig[SyntheticTestFieldConfigRetryInterval])
 	require.Equal(t, timeout, httpScriptConfig[SyntheticTestFieldConfigTimeout])
 	require.Equal(t, script, httpScriptConfig[SyntheticTestFieldConfigScript])
+	require.Equal(t, "", httpScriptConfig[SyntheticTestFieldConfigScriptType])
+	require.Len(t, httpScriptConfig[SyntheticTestFieldConfigScripts], 0)
+}
+
+func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForBrowserScript(t *testing.T) {
+	timeout := "20s"
+	scriptType := "Jest"
+	bundle := "my-bundle"
+	scriptFile := "index.js"
+	browser := "firefox"
+	recordVideo := true
+	config := restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeBrowserScript,
+		MarkSyntheticCall: true,
+		Retries:           5,
+		RetryInterval:     10,
+		Timeout:           &timeout,
+		ScriptType:        &scriptType,
+		Scripts: &restapi.MultipleScriptsConfiguration{
+			Bundle:     &bundle,
+			ScriptFile: &scriptFile,
+		},
+		Browser:     &browser,
+		RecordVideo: &recordVideo,
+	}
+
+	browserScriptConfig := ut.updateStateAndGetConfiguration(t, config, SyntheticTestFieldConfigBrowserScript)
+
+	require.Len(t, browserScriptConfig, 9)
+	require.Equal(t, true, browserScriptConfig[SyntheticTestFieldConfigMarkSyntheticCall])
+	require.Equal(t, 5, browserScriptConfig[SyntheticTestFieldConfigRetries])
+	require.Equal(t, 10, browserScriptConfig[SyntheticTestFieldConfigRetryInterval])
+	require.Equal(t, timeout, browserScriptConfig[SyntheticTestFieldConfigTimeout])
+	require.Equal(t, "", browserScriptConfig[SyntheticTestFieldConfigScript])
+	require.Equal(t, scriptType, browserScriptConfig[SyntheticTestFieldConfigScriptType])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			SyntheticTestFieldConfigScriptsBundle:     bundle,
+			SyntheticTestFieldConfigScriptsScriptFile: scriptFile,
+		},
+	}, browserScriptConfig[SyntheticTestFieldConfigScripts])
+	require.Equal(t, browser, browserScriptConfig[SyntheticTestFieldConfigBrowser])
+	require.Equal(t, recordVideo, browserScriptConfig[SyntheticTestFieldConfigRecordVideo])
+}
+
+func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForWebpageAction(t *testing.T) {
+	timeout := "20s"
+	url := "https://app.example.com"
+	browser := "chrome"
+	recordVideo := false
+	config := restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeWebpageAction,
+		MarkSyntheticCall: true,
+		Retries:           5,
+		RetryInterval:     10,
+		Timeout:           &timeout,
+		URL:               &url,
+		Browser:           &browser,
+		RecordVideo:       &recordVideo,
+	}
+
+	webpageActionConfig := ut.updateStateAndGetConfiguration(t, config, SyntheticTestFieldConfigWebpageAction)
+
+	require.Len(t, webpageActionConfig, 7)
+	require.Equal(t, true, webpageActionConfig[SyntheticTestFieldConfigMarkSyntheticCall])
+	require.Equal(t, 5, webpageActionConfig[SyntheticTestFieldConfigRetries])
+	require.Equal(t, 10, webpageActionConfig[SyntheticTestFieldConfigRetryInterval])
+	require.Equal(t, timeout, webpageActionConfig[SyntheticTestFieldConfigTimeout])
+	require.Equal(t, url, webpageActionConfig[SyntheticTestFieldConfigUrl])
+	require.Equal(t, browser, webpageActionConfig[SyntheticTestFieldConfigBrowser])
+	require.Equal(t, recordVideo, webpageActionConfig[SyntheticTestFieldConfigRecordVideo])
+}
+
+func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForWebpageScript(t *testing.T) {
+	timeout := "20s"
+	script := "my-side-script"
+	browser := "firefox"
+	recordVideo := true
+	config := restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeWebpageScript,
+		MarkSyntheticCall: true,
+		Retries:           5,
+		RetryInterval:     10,
+		Timeout:           &timeout,
+		Script:            &script,
+		Browser:           &browser,
+		RecordVideo:       &recordVideo,
+	}
+
+	webpageScriptConfig := ut.updateStateAndGetConfiguration(t, config, SyntheticTestFieldConfigWebpageScript)
+
+	require.Len(t, webpageScriptConfig, 7)
+	require.Equal(t, true, webpageScriptConfig[SyntheticTestFieldConfigMarkSyntheticCall])
+	require.Equal(t, 5, webpageScriptConfig[SyntheticTestFieldConfigRetries])
+	require.Equal(t, 10, webpageScriptConfig[SyntheticTestFieldConfigRetryInterval])
+	require.Equal(t, timeout, webpageScriptConfig[SyntheticTestFieldConfigTimeout])
+	require.Equal(t, script, webpageScriptConfig[SyntheticTestFieldConfigScript])
+	require.Equal(t, browser, webpageScriptConfig[SyntheticTestFieldConfigBrowser])
+	require.Equal(t, recordVideo, webpageScriptConfig[SyntheticTestFieldConfigRecordVideo])
+}
+
+func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForDNS(t *testing.T) {
+	timeout := "20s"
+	lookup := "example.com"
+	server := "8.8.8.8"
+	port := int32(53)
+	queryType := "AAAA"
+	acceptCNAME := true
+	lookupServerName := false
+	recursiveLookups := true
+	serverRetries := int32(3)
+	config := restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeDNSAction,
+		MarkSyntheticCall: true,
+		Retries:           5,
+		RetryInterval:     10,
+		Timeout:           &timeout,
+		Lookup:            &lookup,
+		Server:            &server,
+		Port:              &port,
+		QueryType:         &queryType,
+		AcceptCNAME:       &acceptCNAME,
+		LookupServerName:  &lookupServerName,
+		RecursiveLookups:  &recursiveLookups,
+		ServerRetries:     &serverRetries,
+	}
+
+	dnsConfig := ut.updateStateAndGetConfiguration(t, config, SyntheticTestFieldConfigDNS)
+
+	require.Len(t, dnsConfig, 12)
+	require.Equal(t, true, dnsConfig[SyntheticTestFieldConfigMarkSyntheticCall])
+	require.Equal(t, 5, dnsConfig[SyntheticTestFieldConfigRetries])
+	require.Equal(t, 10, dnsConfig[SyntheticTestFieldConfigRetryInterval])
+	require.Equal(t, timeout, dnsConfig[SyntheticTestFieldConfigTimeout])
+	require.Equal(t, lookup, dnsConfig[SyntheticTestFieldConfigLookup])
+	require.Equal(t, server, dnsConfig[SyntheticTestFieldConfigServer])
+	require.Equal(t, int(port), dnsConfig[SyntheticTestFieldConfigPort])
+	require.Equal(t, queryType, dnsConfig[SyntheticTestFieldConfigQueryType])
+	require.Equal(t, acceptCNAME, dnsConfig[SyntheticTestFieldConfigAcceptCNAME])
+	require.Equal(t, lookupServerName, dnsConfig[SyntheticTestFieldConfigLookupServerName])
+	require.Equal(t, recursiveLookups, dnsConfig[SyntheticTestFieldConfigRecursiveLookups])
+	require.Equal(t, int(serverRetries), dnsConfig[SyntheticTestFieldConfigServerRetries])
+}
+
+func (ut *syntheticTestUnitTest) updateStateAndGetConfiguration(t *testing.T, config restapi.SyntheticTestConfig, configField string) map[string]interface{} {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	resourceHandle := NewSyntheticTestResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := restapi.SyntheticTest{
+		ID:            syntheticTestID,
+		Label:         syntheticTestLabel,
+		Active:        syntheticTestActive,
+		Configuration: config,
+		Locations:     []string{"loc1"},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.Nil(t, err)
+	require.Equal(t, syntheticTestID, resourceData.Id())
+	configOptions := []string{
+		SyntheticTestFieldConfigHttpAction,
+		SyntheticTestFieldConfigHttpScript,
+		SyntheticTestFieldConfigBrowserScript,
+		SyntheticTestFieldConfigWebpageAction,
+		SyntheticTestFieldConfigWebpageScript,
+		SyntheticTestFieldConfigDNS,
+	}
+	for _, option := range configOptions {
+		require.IsType(t, []interface{}{}, resourceData.Get(option))
+		if option != configField {
+			require.Len(t, resourceData.Get(option).([]interface{}), 0)
+		}
+	}
+
+	configs := resourceData.Get(configField).([]interface{})
+	require.Len(t, configs, 1)
+	require.IsType(t, map[string]interface{}{}, configs[0])
+	return configs[0].(map[string]interface{})
 }
 
 func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForHttpAction(t *testing.T) {
@@ -730,6 +1270,222 @@ func (ut *syntheticTestUnitTest) shouldMapStateToDataModelWithConfigOfTypeHttpSc
 	}, model)
 }
 
+func (ut *syntheticTestUnitTest) shouldMapStateToDataModelWithConfigOfTypeHttpScriptUsingMultipleScripts(t *testing.T) {
+	timeout := "20s"
+	scriptType := "Jest"
+	bundle := "my-bundle"
+	scriptFile := "index.js"
+
+	config := ut.mapStateAndGetConfiguration(t, SyntheticTestFieldConfigHttpScript, map[string]interface{}{
+		SyntheticTestFieldConfigMarkSyntheticCall: true,
+		SyntheticTestFieldConfigRetries:           5,
+		SyntheticTestFieldConfigRetryInterval:     10,
+		SyntheticTestFieldConfigTimeout:           timeout,
+		SyntheticTestFieldConfigScriptType:        scriptType,
+		SyntheticTestFieldConfigScripts: []interface{}{
+			map[string]interface{}{
+				SyntheticTestFieldConfigScriptsBundle:     bundle,
+				SyntheticTestFieldConfigScriptsScriptFile: scriptFile,
+			},
+		},
+	})
+
+	require.Equal(t, restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeHttpScript,
+		MarkSyntheticCall: true,
+		Retries:           5,
+		RetryInterval:     10,
+		Timeout:           &timeout,
+		ScriptType:        &scriptType,
+		Scripts: &restapi.MultipleScriptsConfiguration{
+			Bundle:     &bundle,
+			ScriptFile: &scriptFile,
+		},
+	}, config)
+}
+
+func (ut *syntheticTestUnitTest) shouldRejectEmptyScriptsBlockOfHttpScriptConfig(t *testing.T) {
+	diags := ut.validateHttpScriptConfig(map[string]interface{}{
+		SyntheticTestFieldConfigScripts: []interface{}{map[string]interface{}{}},
+	})
+
+	require.True(t, diags.HasError())
+	var errorDetails []string
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			require.Equal(t, "Missing required argument", d.Summary)
+			errorDetails = append(errorDetails, d.Detail)
+		}
+	}
+	require.Len(t, errorDetails, 2)
+	require.Contains(t, errorDetails[0]+errorDetails[1], "http_script.0.scripts.0.bundle")
+	require.Contains(t, errorDetails[0]+errorDetails[1], "http_script.0.scripts.0.script_file")
+}
+
+func (ut *syntheticTestUnitTest) shouldAcceptCompleteScriptsBlockOfHttpScriptConfig(t *testing.T) {
+	diags := ut.validateHttpScriptConfig(map[string]interface{}{
+		SyntheticTestFieldConfigScripts: []interface{}{map[string]interface{}{
+			SyntheticTestFieldConfigScriptsBundle:     "my-bundle",
+			SyntheticTestFieldConfigScriptsScriptFile: "index.js",
+		}},
+	})
+
+	require.False(t, diags.HasError())
+}
+
+func (ut *syntheticTestUnitTest) validateHttpScriptConfig(httpScriptConfig map[string]interface{}) diag.Diagnostics {
+	sut := NewTerraformResource(NewSyntheticTestResourceHandle()).ToSchemaResource()
+	return sut.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		SyntheticTestFieldLabel:            syntheticTestLabel,
+		SyntheticTestFieldLocations:        []interface{}{"location-id"},
+		SyntheticTestFieldConfigHttpScript: []interface{}{httpScriptConfig},
+	}))
+}
+
+func (ut *syntheticTestUnitTest) shouldMapStateToDataModelWithConfigOfTypeBrowserScript(t *testing.T) {
+	timeout := "20s"
+	script := "my-script"
+	scriptType := "Basic"
+	browser := "firefox"
+	recordVideo := true
+
+	config := ut.mapStateAndGetConfiguration(t, SyntheticTestFieldConfigBrowserScript, map[string]interface{}{
+		SyntheticTestFieldConfigMarkSyntheticCall: true,
+		SyntheticTestFieldConfigRetries:           5,
+		SyntheticTestFieldConfigRetryInterval:     10,
+		SyntheticTestFieldConfigTimeout:           timeout,
+		SyntheticTestFieldConfigScript:            script,
+		SyntheticTestFieldConfigScriptType:        scriptType,
+		SyntheticTestFieldConfigBrowser:           browser,
+		SyntheticTestFieldConfigRecordVideo:       recordVideo,
+	})
+
+	require.Equal(t, restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeBrowserScript,
+		MarkSyntheticCall: true,
+		Retries:           5,
+		RetryInterval:     10,
+		Timeout:           &timeout,
+		Script:            &script,
+		ScriptType:        &scriptType,
+		Browser:           &browser,
+		RecordVideo:       &recordVideo,
+	}, config)
+}
+
+func (ut *syntheticTestUnitTest) shouldMapStateToDataModelWithConfigOfTypeWebpageAction(t *testing.T) {
+	timeout := "20s"
+	url := "https://app.example.com"
+	browser := "chrome"
+
+	config := ut.mapStateAndGetConfiguration(t, SyntheticTestFieldConfigWebpageAction, map[string]interface{}{
+		SyntheticTestFieldConfigMarkSyntheticCall: true,
+		SyntheticTestFieldConfigRetries:           5,
+		SyntheticTestFieldConfigRetryInterval:     10,
+		SyntheticTestFieldConfigTimeout:           timeout,
+		SyntheticTestFieldConfigUrl:               url,
+		SyntheticTestFieldConfigBrowser:           browser,
+		SyntheticTestFieldConfigRecordVideo:       false,
+	})
+
+	require.Equal(t, restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeWebpageAction,
+		MarkSyntheticCall: true,
+		Retries:           5,
+		RetryInterval:     10,
+		Timeout:           &timeout,
+		URL:               &url,
+		Browser:           &browser,
+	}, config)
+}
+
+func (ut *syntheticTestUnitTest) shouldMapStateToDataModelWithConfigOfTypeWebpageScript(t *testing.T) {
+	timeout := "20s"
+	script := "my-side-script"
+	browser := "firefox"
+	recordVideo := true
+
+	config := ut.mapStateAndGetConfiguration(t, SyntheticTestFieldConfigWebpageScript, map[string]interface{}{
+		SyntheticTestFieldConfigMarkSyntheticCall: true,
+		SyntheticTestFieldConfigRetries:           5,
+		SyntheticTestFieldConfigRetryInterval:     10,
+		SyntheticTestFieldConfigTimeout:           timeout,
+		SyntheticTestFieldConfigScript:            script,
+		SyntheticTestFieldConfigBrowser:           browser,
+		SyntheticTestFieldConfigRecordVideo:       recordVideo,
+	})
+
+	require.Equal(t, restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeWebpageScript,
+		MarkSyntheticCall: true,
+		Retries:           5,
+		RetryInterval:     10,
+		Timeout:           &timeout,
+		Script:            &script,
+		Browser:           &browser,
+		RecordVideo:       &recordVideo,
+	}, config)
+}
+
+func (ut *syntheticTestUnitTest) shouldMapStateToDataModelWithConfigOfTypeDNS(t *testing.T) {
+	timeout := "20s"
+	lookup := "example.com"
+	server := "8.8.8.8"
+	port := int32(53)
+	queryType := "MX"
+	acceptCNAME := true
+	recursiveLookups := true
+	serverRetries := int32(3)
+
+	config := ut.mapStateAndGetConfiguration(t, SyntheticTestFieldConfigDNS, map[string]interface{}{
+		SyntheticTestFieldConfigMarkSyntheticCall: true,
+		SyntheticTestFieldConfigRetries:           5,
+		SyntheticTestFieldConfigRetryInterval:     10,
+		SyntheticTestFieldConfigTimeout:           timeout,
+		SyntheticTestFieldConfigLookup:            lookup,
+		SyntheticTestFieldConfigServer:            server,
+		SyntheticTestFieldConfigPort:              int(port),
+		SyntheticTestFieldConfigQueryType:         queryType,
+		SyntheticTestFieldConfigAcceptCNAME:       acceptCNAME,
+		SyntheticTestFieldConfigLookupServerName:  false,
+		SyntheticTestFieldConfigRecursiveLookups:  recursiveLookups,
+		SyntheticTestFieldConfigServerRetries:     int(serverRetries),
+	})
+
+	require.Equal(t, restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeDNSAction,
+		MarkSyntheticCall: true,
+		Retries:           5,
+		RetryInterval:     10,
+		Timeout:           &timeout,
+		Lookup:            &lookup,
+		Server:            &server,
+		Port:              &port,
+		QueryType:         &queryType,
+		AcceptCNAME:       &acceptCNAME,
+		RecursiveLookups:  &recursiveLookups,
+		ServerRetries:     &serverRetries,
+	}, config)
+}
+
+func (ut *syntheticTestUnitTest) mapStateAndGetConfiguration(t *testing.T, configField string, configData map[string]interface{}) restapi.SyntheticTestConfig {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	resourceHandle := NewSyntheticTestResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	resourceData.SetId(syntheticTestID)
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldActive, syntheticTestActive)
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldLabel, syntheticTestLabel)
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldLocations, []interface{}{"loc1"})
+	setValueOnResourceData(t, resourceData, configField, []interface{}{configData})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, syntheticTestID, model.ID)
+	return model.Configuration
+}
+
 func (ut *syntheticTestUnitTest) shouldReturnErrorWhenTryingToMapStateToModelWhenNoConfigurationIsProvided(t *testing.T) {
 	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
 	resourceHandle := NewSyntheticTestResourceHandle()
@@ -738,6 +1494,10 @@ func (ut *syntheticTestUnitTest) shouldReturnErrorWhenTryingToMapStateToModelWhe
 	resourceData.SetId(syntheticTestID)
 	setValueOnResourceData(t, resourceData, SyntheticTestFieldConfigHttpScript, []interface{}{})
 	setValueOnResourceData(t, resourceData, SyntheticTestFieldConfigHttpAction, []interface{}{})
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldConfigBrowserScript, []interface{}{})
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldConfigWebpageAction, []interface{}{})
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldConfigWebpageScript, []interface{}{})
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldConfigDNS, []interface{}{})
 
 	_, err := resourceHandle.MapStateToDataObject(resourceData)
 
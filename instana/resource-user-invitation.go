@@ -0,0 +1,102 @@
+package instana
+
+import (
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaUserInvitation the name of the terraform-provider-instana resource to invite users
+const ResourceInstanaUserInvitation = "instana_user_invitation"
+
+const (
+	//UserInvitationFieldEmail constant value for the schema field email
+	UserInvitationFieldEmail = "email"
+	//UserInvitationFieldGroupID constant value for the schema field group_id
+	UserInvitationFieldGroupID = "group_id"
+	//UserInvitationFieldUserID constant value for the computed schema field user_id
+	UserInvitationFieldUserID = "user_id"
+)
+
+// NewUserInvitationResourceHandle creates the resource handle for user invitations
+func NewUserInvitationResourceHandle() ResourceHandle[*restapi.UserInvitation] {
+	emailField := UserInvitationFieldEmail
+	return &userInvitationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaUserInvitation,
+			Schema: map[string]*schema.Schema{
+				UserInvitationFieldEmail: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The email address of the user who is invited",
+				},
+				UserInvitationFieldGroupID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the group the user is added to when the invitation is accepted",
+				},
+				UserInvitationFieldUserID: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the user. The ID is only available once the invitation is accepted",
+				},
+			},
+			SkipIDGeneration: true,
+			ResourceIDField:  &emailField,
+			CreateOnly:       true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type userInvitationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *userInvitationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *userInvitationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *userInvitationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.UserInvitation] {
+	return api.UserInvitations()
+}
+
+func (r *userInvitationResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *userInvitationResource) UpdateState(d *schema.ResourceData, invitation *restapi.UserInvitation) error {
+	email := invitation.Email
+	if configuredEmail, ok := d.GetOk(UserInvitationFieldEmail); ok && strings.EqualFold(configuredEmail.(string), email) {
+		email = configuredEmail.(string)
+	}
+	data := map[string]interface{}{
+		UserInvitationFieldEmail:  email,
+		UserInvitationFieldUserID: invitation.UserID,
+	}
+	//the group is not provided by the Instana API once the invitation is accepted and therefore kept as configured
+	if len(invitation.GroupID) > 0 {
+		data[UserInvitationFieldGroupID] = invitation.GroupID
+	}
+	d.SetId(email)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *userInvitationResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.UserInvitation, error) {
+	return &restapi.UserInvitation{
+		Email:   d.Get(UserInvitationFieldEmail).(string),
+		GroupID: d.Get(UserInvitationFieldGroupID).(string),
+		UserID:  d.Get(UserInvitationFieldUserID).(string),
+	}, nil
+}
@@ -0,0 +1,218 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestUserInvitation(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaUserInvitation + ".example"
+	inst := &userInvitationTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewUserInvitationResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type userInvitationTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.UserInvitation]
+}
+
+const (
+	userInvitationEmail   = "new.hire@example.com"
+	userInvitationGroupID = "group-id"
+	userInvitationUserID  = "user-id"
+)
+
+var userInvitationTerraformTemplate = `
+resource "instana_user_invitation" "example" {
+	email    = "new.hire@example.com"
+	group_id = "group-id-%d"
+}
+`
+
+func (test *userInvitationTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaUserInvitation), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaUserInvitation), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaUserInvitation), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaUserInvitation), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should be create only", ResourceInstanaUserInvitation), test.createTestResourceShouldBeCreateOnly())
+	t.Run(fmt.Sprintf("%s should update terraform state from pending invitation", ResourceInstanaUserInvitation), test.createTestShouldUpdateTerraformResourceStateFromPendingInvitation())
+	t.Run(fmt.Sprintf("%s should keep group and configured email when invitation is accepted", ResourceInstanaUserInvitation), test.createTestShouldKeepGroupAndConfiguredEmailWhenInvitationIsAccepted())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaUserInvitation), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *userInvitationTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		pendingInvitations := make(map[string]*restapi.PendingInvitation)
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			invitations := make([]*restapi.UserInvitation, 0)
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &invitations)
+			response := &restapi.InvitationResponse{InvitationResults: make([]*restapi.InvitationResult, 0)}
+			for _, invitation := range invitations {
+				status := restapi.InvitationStatusSuccess
+				if _, ok := pendingInvitations[invitation.Email]; ok {
+					status = "FAILURE_USER_ALREADY_EXISTS"
+				} else {
+					pendingInvitations[invitation.Email] = &restapi.PendingInvitation{ID: RandomID(), Email: invitation.Email, GroupID: invitation.GroupID}
+				}
+				response.InvitationResults = append(response.InvitationResults, &restapi.InvitationResult{UserEmail: invitation.Email, InvitationStatus: status})
+			}
+			test.writeJSONResponse(w, r, response)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			delete(pendingInvitations, r.URL.Query().Get("email"))
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.UsersOverviewResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			overview := &restapi.UsersOverview{Users: make([]*restapi.User, 0), Invitations: make([]*restapi.PendingInvitation, 0)}
+			for _, invitation := range pendingInvitations {
+				overview.Invitations = append(overview.Invitations, invitation)
+			}
+			test.writeJSONResponse(w, r, overview)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, pendingInvitations),
+				test.createImportTestStep(),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, pendingInvitations),
+				test.createImportTestStep(),
+			},
+		})
+	}
+}
+
+func (test *userInvitationTest) writeJSONResponse(w http.ResponseWriter, r *http.Request, data interface{}) {
+	w.Header().Set(contentType, r.Header.Get(contentType))
+	w.WriteHeader(http.StatusOK)
+	err := json.NewEncoder(w).Encode(data)
+	if err != nil {
+		fmt.Printf("failed to encode json; %s\n", err)
+	}
+}
+
+func (test *userInvitationTest) createIntegrationTestStep(httpPort int, iteration int, pendingInvitations map[string]*restapi.PendingInvitation) resource.TestStep {
+	groupID := fmt.Sprintf("group-id-%d", iteration)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(userInvitationTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", userInvitationEmail),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, UserInvitationFieldEmail, userInvitationEmail),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, UserInvitationFieldGroupID, groupID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, UserInvitationFieldUserID, ""),
+			func(_ *terraform.State) error {
+				invitation, ok := pendingInvitations[userInvitationEmail]
+				if !ok || invitation.GroupID != groupID {
+					return fmt.Errorf("invitation of %s to group %s not stored at server", userInvitationEmail, groupID)
+				}
+				return nil
+			},
+		),
+	}
+}
+
+func (test *userInvitationTest) createImportTestStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      test.terraformResourceInstanceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateId:     userInvitationEmail,
+	}
+}
+
+func (test *userInvitationTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *userInvitationTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *userInvitationTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_user_invitation", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *userInvitationTest) createTestResourceShouldBeCreateOnly() func(t *testing.T) {
+	return func(t *testing.T) {
+		metaData := test.resourceHandle.MetaData()
+		require.True(t, metaData.CreateOnly)
+		require.True(t, metaData.Schema[UserInvitationFieldEmail].ForceNew)
+		require.True(t, metaData.Schema[UserInvitationFieldGroupID].ForceNew)
+		require.True(t, metaData.Schema[UserInvitationFieldUserID].Computed)
+		require.Nil(t, NewTerraformResource(test.resourceHandle).ToSchemaResource().UpdateContext)
+	}
+}
+
+func (test *userInvitationTest) createTestShouldUpdateTerraformResourceStateFromPendingInvitation() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.UserInvitation](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &restapi.UserInvitation{Email: userInvitationEmail, GroupID: userInvitationGroupID})
+
+		require.NoError(t, err)
+		require.Equal(t, userInvitationEmail, resourceData.Id())
+		require.Equal(t, userInvitationEmail, resourceData.Get(UserInvitationFieldEmail))
+		require.Equal(t, userInvitationGroupID, resourceData.Get(UserInvitationFieldGroupID))
+		require.Equal(t, "", resourceData.Get(UserInvitationFieldUserID))
+	}
+}
+
+func (test *userInvitationTest) createTestShouldKeepGroupAndConfiguredEmailWhenInvitationIsAccepted() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.UserInvitation](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, UserInvitationFieldEmail, "New.Hire@example.com")
+		setValueOnResourceData(t, resourceData, UserInvitationFieldGroupID, userInvitationGroupID)
+
+		err := sut.UpdateState(resourceData, &restapi.UserInvitation{Email: userInvitationEmail, UserID: userInvitationUserID})
+
+		require.NoError(t, err)
+		require.Equal(t, "New.Hire@example.com", resourceData.Id())
+		require.Equal(t, "New.Hire@example.com", resourceData.Get(UserInvitationFieldEmail))
+		require.Equal(t, userInvitationGroupID, resourceData.Get(UserInvitationFieldGroupID))
+		require.Equal(t, userInvitationUserID, resourceData.Get(UserInvitationFieldUserID))
+	}
+}
+
+func (test *userInvitationTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.UserInvitation](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId(userInvitationEmail)
+		setValueOnResourceData(t, resourceData, UserInvitationFieldEmail, userInvitationEmail)
+		setValueOnResourceData(t, resourceData, UserInvitationFieldGroupID, userInvitationGroupID)
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.UserInvitation{Email: userInvitationEmail, GroupID: userInvitationGroupID}, result)
+	}
+}
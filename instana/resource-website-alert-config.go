@@ -17,8 +17,12 @@ const (
 	WebsiteAlertConfigFieldAlertChannelIDs = "alert_channel_ids"
 	//WebsiteAlertConfigFieldWebsiteID constant value for field websites.website_id of resource instana_website_alert_config
 	WebsiteAlertConfigFieldWebsiteID = "website_id"
+	//WebsiteAlertConfigFieldBaselineUpdateTrigger constant value for field baseline_update_trigger of resource instana_website_alert_config
+	WebsiteAlertConfigFieldBaselineUpdateTrigger = "baseline_update_trigger"
 	//WebsiteAlertConfigFieldDescription constant value for field description of resource instana_website_alert_config
 	WebsiteAlertConfigFieldDescription = "description"
+	//WebsiteAlertConfigFieldEnabled constant value for field enabled of resource instana_website_alert_config
+	WebsiteAlertConfigFieldEnabled = "enabled"
 	//WebsiteAlertConfigFieldGranularity constant value for field granularity of resource instana_website_alert_config
 	WebsiteAlertConfigFieldGranularity = "granularity"
 	//WebsiteAlertConfigFieldName constant value for field name of resource instana_website_alert_config
@@ -89,6 +93,17 @@ var (
 		Description:  "The description text of the website alert config",
 		ValidateFunc: validation.StringLenBetween(0, 65536),
 	}
+	websiteAlertConfigSchemaBaselineUpdateTrigger = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Optional arbitrary value which triggers the recalculation of the historic baseline of the website alert config whenever it is changed",
+	}
+	websiteAlertConfigSchemaEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Optional flag to indicate whether the website alert config is enabled. Disabled alert configs do not raise any alerts. The default is true",
+	}
 	websiteAlertConfigSchemaGranularity = &schema.Schema{
 		Type:         schema.TypeInt,
 		Optional:     true,
@@ -321,18 +336,20 @@ var (
 )
 
 var websiteAlertConfigResourceSchema = map[string]*schema.Schema{
-	WebsiteAlertConfigFieldAlertChannelIDs: websiteAlertConfigSchemaAlertChannelIDs,
-	DefaultCustomPayloadFieldsName:         buildCustomPayloadFields(),
-	WebsiteAlertConfigFieldDescription:     websiteAlertConfigSchemaDescription,
-	WebsiteAlertConfigFieldGranularity:     websiteAlertConfigSchemaGranularity,
-	WebsiteAlertConfigFieldName:            websiteAlertConfigSchemaName,
-	WebsiteAlertConfigFieldRule:            websiteAlertConfigSchemaRule,
-	WebsiteAlertConfigFieldSeverity:        websiteAlertConfigSchemaSeverity,
-	WebsiteAlertConfigFieldTagFilter:       websiteAlertConfigSchemaTagFilter,
-	ResourceFieldThreshold:                 thresholdSchema,
-	WebsiteAlertConfigFieldTimeThreshold:   websiteAlertConfigSchemaTimeThreshold,
-	WebsiteAlertConfigFieldTriggering:      websiteAlertConfigSchemaTriggering,
-	WebsiteAlertConfigFieldWebsiteID:       websiteAlertConfigSchemaWebsiteID,
+	WebsiteAlertConfigFieldAlertChannelIDs:       websiteAlertConfigSchemaAlertChannelIDs,
+	WebsiteAlertConfigFieldBaselineUpdateTrigger: websiteAlertConfigSchemaBaselineUpdateTrigger,
+	DefaultCustomPayloadFieldsName:               buildCustomPayloadFields(),
+	WebsiteAlertConfigFieldDescription:           websiteAlertConfigSchemaDescription,
+	WebsiteAlertConfigFieldEnabled:               websiteAlertConfigSchemaEnabled,
+	WebsiteAlertConfigFieldGranularity:           websiteAlertConfigSchemaGranularity,
+	WebsiteAlertConfigFieldName:                  websiteAlertConfigSchemaName,
+	WebsiteAlertConfigFieldRule:                  websiteAlertConfigSchemaRule,
+	WebsiteAlertConfigFieldSeverity:              websiteAlertConfigSchemaSeverity,
+	WebsiteAlertConfigFieldTagFilter:             websiteAlertConfigSchemaTagFilter,
+	ResourceFieldThreshold:                       thresholdSchema,
+	WebsiteAlertConfigFieldTimeThreshold:         websiteAlertConfigSchemaTimeThreshold,
+	WebsiteAlertConfigFieldTriggering:            websiteAlertConfigSchemaTriggering,
+	WebsiteAlertConfigFieldWebsiteID:             websiteAlertConfigSchemaWebsiteID,
 }
 
 // NewWebsiteAlertConfigResourceHandle creates the resource handle for Website Alert Configs
@@ -391,6 +408,7 @@ func (r *websiteAlertConfigResource) UpdateState(d *schema.ResourceData, config
 		WebsiteAlertConfigFieldAlertChannelIDs: config.AlertChannelIDs,
 		DefaultCustomPayloadFieldsName:         mapCustomPayloadFieldsToSchema(config),
 		WebsiteAlertConfigFieldDescription:     config.Description,
+		WebsiteAlertConfigFieldEnabled:         config.Enabled,
 		WebsiteAlertConfigFieldGranularity:     config.Granularity,
 		WebsiteAlertConfigFieldName:            config.Name,
 		WebsiteAlertConfigFieldRule:            r.mapRuleToSchema(config),
@@ -498,6 +516,7 @@ func (r *websiteAlertConfigResource) MapStateToDataObject(d *schema.ResourceData
 		AlertChannelIDs:       ReadStringSetParameterFromResource(d, WebsiteAlertConfigFieldAlertChannelIDs),
 		CustomerPayloadFields: customPayloadFields,
 		Description:           d.Get(WebsiteAlertConfigFieldDescription).(string),
+		Enabled:               d.Get(WebsiteAlertConfigFieldEnabled).(bool),
 		Granularity:           restapi.Granularity(d.Get(WebsiteAlertConfigFieldGranularity).(int)),
 		Name:                  d.Get(WebsiteMonitoringConfigFieldName).(string),
 		Rule:                  *r.mapRuleFromSchema(d),
@@ -507,6 +526,7 @@ func (r *websiteAlertConfigResource) MapStateToDataObject(d *schema.ResourceData
 		TimeThreshold:         *r.mapTimeThresholdFromSchema(d),
 		Triggering:            d.Get(WebsiteAlertConfigFieldTriggering).(bool),
 		WebsiteID:             d.Get(WebsiteAlertConfigFieldWebsiteID).(string),
+		UpdateBaseline:        !d.IsNewResource() && d.HasChange(WebsiteAlertConfigFieldBaselineUpdateTrigger),
 	}, nil
 }
 
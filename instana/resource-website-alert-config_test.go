@@ -84,6 +84,7 @@ var websiteAlertConfigServerResponseTemplate = `
     "websiteId": "website-id",
     "severity": 5,
     "triggering": false,
+    "enabled": true,
     "tagFilters": [],
     "tagFilterExpression": {
       "type": "TAG_FILTER",
@@ -149,6 +150,9 @@ func (test *websiteAlertConfigTest) run(t *testing.T) {
 	t.Run(fmt.Sprintf("%s should fail to map state to model when severity is invalid", ResourceInstanaWebsiteAlertConfig), test.createTestCaseShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid())
 	t.Run(fmt.Sprintf("%s should fail to map state to model when tag filter expression is invalid", ResourceInstanaWebsiteAlertConfig), test.createTestCaseShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
 	t.Run(fmt.Sprintf("%s should return errr when converting state to data model and custom field is not valid", ResourceInstanaWebsiteAlertConfig), test.shouldReturnErrorWhenConvertingStateToDataModelAndCustomFieldIsNotValid)
+	t.Run(fmt.Sprintf("%s should request baseline update when baseline update trigger is changed", ResourceInstanaWebsiteAlertConfig), test.shouldRequestBaselineUpdateWhenBaselineUpdateTriggerIsChanged)
+	t.Run(fmt.Sprintf("%s should not request baseline update for new resource", ResourceInstanaWebsiteAlertConfig), test.shouldNotRequestBaselineUpdateForNewResource)
+	t.Run(fmt.Sprintf("%s should not request baseline update when baseline update trigger is not changed", ResourceInstanaWebsiteAlertConfig), test.shouldNotRequestBaselineUpdateWhenBaselineUpdateTriggerIsNotChanged)
 }
 
 func (test *websiteAlertConfigTest) createIntegrationTest() func(t *testing.T) {
@@ -225,6 +229,7 @@ func (test *websiteAlertConfigTest) createIntegrationTestStep(httpPort int, iter
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldDescription, "test-alert-description"),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldTriggering, falseAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldEnabled, trueAsString),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldAlertChannelIDs+".1", "alert-channel-id-2"),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldGranularity, "600000"),
@@ -557,6 +562,7 @@ func (test *websiteAlertConfigTest) createTestShouldUpdateTerraformResourceState
 			Threshold:           thresholdTestPair.input,
 			TimeThreshold:       timeThresholdTestPair.input,
 			Triggering:          true,
+			Enabled:             false,
 		}
 
 		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
@@ -589,6 +595,7 @@ func (test *websiteAlertConfigTest) createTestShouldUpdateTerraformResourceState
 		test.requireWebsiteAlertConfigThresholdSetOnSchema(t, thresholdTestPair.expected, resourceData)
 		require.Equal(t, timeThresholdTestPair.expected, resourceData.Get(WebsiteAlertConfigFieldTimeThreshold))
 		require.True(t, resourceData.Get(WebsiteAlertConfigFieldTriggering).(bool))
+		require.False(t, resourceData.Get(WebsiteAlertConfigFieldEnabled).(bool))
 	}
 }
 
@@ -941,6 +948,7 @@ func (test *websiteAlertConfigTest) createTestShouldMapTerraformResourceStateToM
 			Threshold:           thresholdTestPair.expected,
 			TimeThreshold:       timeThresholdTestPair.expected,
 			Triggering:          true,
+			Enabled:             true,
 		}
 
 		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
@@ -968,6 +976,7 @@ func (test *websiteAlertConfigTest) createTestShouldMapTerraformResourceStateToM
 		setValueOnResourceData(t, resourceData, ResourceFieldThreshold, thresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldTimeThreshold, timeThresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldTriggering, true)
+		setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldEnabled, true)
 		resourceData.SetId(websiteAlertConfigID)
 
 		result, err := sut.MapStateToDataObject(resourceData)
@@ -1033,3 +1042,79 @@ func (test *websiteAlertConfigTest) shouldReturnErrorWhenConvertingStateToDataMo
 	require.Error(t, err)
 	require.ErrorContains(t, err, "either a static string value or a dynamic value must")
 }
+
+func (test *websiteAlertConfigTest) shouldRequestBaselineUpdateWhenBaselineUpdateTriggerIsChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+	sut := test.resourceHandle
+	resourceData := testHelper.CreateResourceDataForResourceHandle(sut, map[string]interface{}{
+		WebsiteAlertConfigFieldBaselineUpdateTrigger: "trigger",
+	})
+	test.setMinimalStateOnResourceData(t, resourceData)
+	resourceData.SetId("website-alert-config-id")
+
+	result, err := sut.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.True(t, result.UpdateBaseline)
+}
+
+func (test *websiteAlertConfigTest) shouldNotRequestBaselineUpdateForNewResource(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+	sut := test.resourceHandle
+	resourceData := testHelper.CreateResourceDataForResourceHandle(sut, map[string]interface{}{
+		WebsiteAlertConfigFieldBaselineUpdateTrigger: "trigger",
+	})
+	test.setMinimalStateOnResourceData(t, resourceData)
+	resourceData.MarkNewResource()
+
+	result, err := sut.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.False(t, result.UpdateBaseline)
+}
+
+func (test *websiteAlertConfigTest) shouldNotRequestBaselineUpdateWhenBaselineUpdateTriggerIsNotChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+	sut := test.resourceHandle
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	test.setMinimalStateOnResourceData(t, resourceData)
+	resourceData.SetId("website-alert-config-id")
+
+	result, err := sut.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.False(t, result.UpdateBaseline)
+}
+
+func (test *websiteAlertConfigTest) setMinimalStateOnResourceData(t *testing.T, resourceData *schema.ResourceData) {
+	setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation())
+	setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldRule, []interface{}{
+		map[string]interface{}{
+			WebsiteAlertConfigFieldRuleSlowness: []interface{}{
+				map[string]interface{}{
+					WebsiteAlertConfigFieldRuleMetricName:  "latency",
+					WebsiteAlertConfigFieldRuleAggregation: "P90",
+				},
+			},
+		},
+	})
+	setValueOnResourceData(t, resourceData, ResourceFieldThreshold, []interface{}{
+		map[string]interface{}{
+			ResourceFieldThresholdStatic: []interface{}{
+				map[string]interface{}{
+					ResourceFieldThresholdOperator:    ">=",
+					ResourceFieldThresholdStaticValue: 5.0,
+				},
+			},
+		},
+	})
+	setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldTimeThreshold, []interface{}{
+		map[string]interface{}{
+			WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+				map[string]interface{}{
+					WebsiteAlertConfigFieldTimeThresholdTimeWindow: 600000,
+				},
+			},
+		},
+	})
+}
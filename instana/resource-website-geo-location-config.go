@@ -0,0 +1,18 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+// ResourceInstanaWebsiteGeoLocationConfig the name of the terraform-provider-instana resource to manage the geo location configuration of websites
+const ResourceInstanaWebsiteGeoLocationConfig = "instana_website_geo_location_config"
+
+// WebsiteGeoLocationConfigFieldWebsiteID constant value for field website_id of resource instana_website_geo_location_config
+const WebsiteGeoLocationConfigFieldWebsiteID = "website_id"
+
+// NewWebsiteGeoLocationConfigResourceHandle creates the resource handle for the geo location configuration of websites
+func NewWebsiteGeoLocationConfigResourceHandle() ResourceHandle[*restapi.GeoLocationConfig] {
+	return newGeoLocationConfigResourceHandle(ResourceInstanaWebsiteGeoLocationConfig, WebsiteGeoLocationConfigFieldWebsiteID, "The ID of the website monitoring configuration", func(api restapi.InstanaAPI) restapi.RestResource[*restapi.GeoLocationConfig] {
+		return api.WebsiteGeoLocationConfigs()
+	})
+}
@@ -0,0 +1,260 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestWebsiteGeoLocationConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaWebsiteGeoLocationConfig + ".example"
+	inst := &websiteGeoLocationConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewWebsiteGeoLocationConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type websiteGeoLocationConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.GeoLocationConfig]
+}
+
+var websiteGeoLocationConfigTerraformTemplate = `
+resource "instana_website_geo_location_config" "example" {
+	website_id         = "%s"
+	geo_detail_removal = "REMOVE_CITY"
+
+	geo_mapping_rule {
+		cidr            = "10.0.0.0/8"
+		accuracy_radius = 10
+		city            = "city %d"
+		country         = "Germany"
+		country_code    = "DE"
+		latitude        = 48.1
+		longitude       = 11.5
+
+		subdivision {
+			code = "BY"
+			name = "Bavaria"
+		}
+	}
+}
+`
+
+var websiteGeoLocationConfigServerResponseTemplate = `
+{
+	"geoDetailRemoval": "REMOVE_CITY",
+	"geoMappingRules": [
+		{
+			"cidr": "10.0.0.0/8",
+			"accuracyRadius": 10,
+			"city": "city %d",
+			"country": "Germany",
+			"countryCode": "DE",
+			"latitude": 48.1,
+			"longitude": 11.5,
+			"subdivisions": [
+				{
+					"code": "BY",
+					"name": "Bavaria"
+				}
+			]
+		}
+	]
+}
+`
+
+func (test *websiteGeoLocationConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaWebsiteGeoLocationConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaWebsiteGeoLocationConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaWebsiteGeoLocationConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaWebsiteGeoLocationConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaWebsiteGeoLocationConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaWebsiteGeoLocationConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state without geo mapping rules to model", ResourceInstanaWebsiteGeoLocationConfig), test.createTestShouldMapTerraformResourceStateWithoutGeoMappingRulesToModel())
+}
+
+func (test *websiteGeoLocationConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		websiteID := RandomID()
+		subResourcePath := restapi.WebsiteMonitoringConfigResourcePath + "/{website-id}/" + restapi.GeoLocationConfigSubResourcePath
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, subResourcePath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, subResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPut, restapi.WebsiteMonitoringConfigResourcePath+"/"+websiteID+"/"+restapi.GeoLocationConfigSubResourcePath)
+			jsonData := fmt.Sprintf(websiteGeoLocationConfigServerResponseTemplate, modCount-1)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, websiteID),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, websiteID),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, websiteID),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, websiteID),
+			},
+		})
+	}
+}
+
+func (test *websiteGeoLocationConfigTest) createIntegrationTestStep(httpPort int, iteration int, websiteID string) resource.TestStep {
+	rulePath := GeoLocationConfigFieldGeoMappingRule + ".0."
+	subdivisionPath := rulePath + GeoMappingRuleFieldSubdivision + ".0."
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(websiteGeoLocationConfigTerraformTemplate, websiteID, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", websiteID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteGeoLocationConfigFieldWebsiteID, websiteID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GeoLocationConfigFieldGeoDetailRemoval, "REMOVE_CITY"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, rulePath+GeoMappingRuleFieldCidr, "10.0.0.0/8"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, rulePath+GeoMappingRuleFieldAccuracyRadius, "10"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, rulePath+GeoMappingRuleFieldCity, fmt.Sprintf("city %d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, rulePath+GeoMappingRuleFieldCountry, "Germany"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, rulePath+GeoMappingRuleFieldCountryCode, "DE"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, rulePath+GeoMappingRuleFieldLatitude, "48.1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, rulePath+GeoMappingRuleFieldLongitude, "11.5"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, subdivisionPath+GeoMappingRuleFieldSubdivisionCode, "BY"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, subdivisionPath+GeoMappingRuleFieldSubdivisionName, "Bavaria"),
+		),
+	}
+}
+
+func (test *websiteGeoLocationConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *websiteGeoLocationConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *websiteGeoLocationConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_website_geo_location_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *websiteGeoLocationConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := test.createFullModel()
+
+		testHelper := NewTestHelper[*restapi.GeoLocationConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "website-id", resourceData.Id())
+		require.Equal(t, "website-id", resourceData.Get(WebsiteGeoLocationConfigFieldWebsiteID))
+		require.Equal(t, "REMOVE_COORDINATES", resourceData.Get(GeoLocationConfigFieldGeoDetailRemoval))
+		require.Equal(t, []interface{}{test.createFullRuleSchemaData()}, resourceData.Get(GeoLocationConfigFieldGeoMappingRule))
+	}
+}
+
+func (test *websiteGeoLocationConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.GeoLocationConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, WebsiteGeoLocationConfigFieldWebsiteID, "website-id")
+		setValueOnResourceData(t, resourceData, GeoLocationConfigFieldGeoDetailRemoval, "REMOVE_COORDINATES")
+		setValueOnResourceData(t, resourceData, GeoLocationConfigFieldGeoMappingRule, []interface{}{test.createFullRuleSchemaData()})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, test.createFullModel(), result)
+	}
+}
+
+func (test *websiteGeoLocationConfigTest) createTestShouldMapTerraformResourceStateWithoutGeoMappingRulesToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.GeoLocationConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, WebsiteGeoLocationConfigFieldWebsiteID, "website-id")
+		setValueOnResourceData(t, resourceData, GeoLocationConfigFieldGeoDetailRemoval, "NO_REMOVAL")
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.GeoLocationConfig{
+			ID:               "website-id",
+			GeoDetailRemoval: restapi.GeoDetailRemovalNoRemoval,
+			GeoMappingRules:  []restapi.GeoMappingRule{},
+		}, result)
+	}
+}
+
+func (test *websiteGeoLocationConfigTest) createFullModel() *restapi.GeoLocationConfig {
+	accuracyRadius := int64(10)
+	city := "Munich"
+	continent := "Europe"
+	continentCode := "EU"
+	country := "Germany"
+	countryCode := "DE"
+	latitude := 48.1
+	longitude := 11.5
+	subdivisionCode := "BY"
+	return &restapi.GeoLocationConfig{
+		ID:               "website-id",
+		GeoDetailRemoval: restapi.GeoDetailRemovalRemoveCoordinates,
+		GeoMappingRules: []restapi.GeoMappingRule{
+			{
+				Cidr:           "10.0.0.0/8",
+				AccuracyRadius: &accuracyRadius,
+				City:           &city,
+				Continent:      &continent,
+				ContinentCode:  &continentCode,
+				Country:        &country,
+				CountryCode:    &countryCode,
+				Latitude:       &latitude,
+				Longitude:      &longitude,
+				Subdivisions: []restapi.GeoSubdivision{
+					{Code: &subdivisionCode, Name: "Bavaria"},
+				},
+			},
+		},
+	}
+}
+
+func (test *websiteGeoLocationConfigTest) createFullRuleSchemaData() map[string]interface{} {
+	return map[string]interface{}{
+		GeoMappingRuleFieldCidr:           "10.0.0.0/8",
+		GeoMappingRuleFieldAccuracyRadius: 10,
+		GeoMappingRuleFieldCity:           "Munich",
+		GeoMappingRuleFieldContinent:      "Europe",
+		GeoMappingRuleFieldContinentCode:  "EU",
+		GeoMappingRuleFieldCountry:        "Germany",
+		GeoMappingRuleFieldCountryCode:    "DE",
+		GeoMappingRuleFieldLatitude:       48.1,
+		GeoMappingRuleFieldLongitude:      11.5,
+		GeoMappingRuleFieldSubdivision: []interface{}{
+			map[string]interface{}{
+				GeoMappingRuleFieldSubdivisionCode: "BY",
+				GeoMappingRuleFieldSubdivisionName: "Bavaria",
+			},
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+// ResourceInstanaWebsiteIPMaskingConfig the name of the terraform-provider-instana resource to manage the ip masking configuration of websites
+const ResourceInstanaWebsiteIPMaskingConfig = "instana_website_ip_masking_config"
+
+// WebsiteIPMaskingConfigFieldWebsiteID constant value for field website_id of resource instana_website_ip_masking_config
+const WebsiteIPMaskingConfigFieldWebsiteID = "website_id"
+
+// NewWebsiteIPMaskingConfigResourceHandle creates the resource handle for the ip masking configuration of websites
+func NewWebsiteIPMaskingConfigResourceHandle() ResourceHandle[*restapi.IPMaskingConfig] {
+	return newIPMaskingConfigResourceHandle(ResourceInstanaWebsiteIPMaskingConfig, WebsiteIPMaskingConfigFieldWebsiteID, "The ID of the website monitoring configuration", func(api restapi.InstanaAPI) restapi.RestResource[*restapi.IPMaskingConfig] {
+		return api.WebsiteIPMaskingConfigs()
+	})
+}
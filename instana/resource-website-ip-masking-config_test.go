@@ -0,0 +1,158 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestWebsiteIPMaskingConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaWebsiteIPMaskingConfig + ".example"
+	inst := &websiteIPMaskingConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewWebsiteIPMaskingConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type websiteIPMaskingConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.IPMaskingConfig]
+}
+
+var websiteIPMaskingConfigTerraformTemplate = `
+resource "instana_website_ip_masking_config" "example" {
+	website_id = "%s"
+	ip_masking = "%s"
+}
+`
+
+func (test *websiteIPMaskingConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaWebsiteIPMaskingConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaWebsiteIPMaskingConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaWebsiteIPMaskingConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaWebsiteIPMaskingConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should use website id as resource id", ResourceInstanaWebsiteIPMaskingConfig), test.createTestResourceShouldUseWebsiteIDAsResourceID())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaWebsiteIPMaskingConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaWebsiteIPMaskingConfig), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *websiteIPMaskingConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		websiteID := RandomID()
+		subResourcePath := restapi.WebsiteMonitoringConfigResourcePath + "/{website-id}/" + restapi.IPMaskingConfigSubResourcePath
+		ipMasking := restapi.IPMaskingStrict
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, subResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.IPMaskingConfig{}
+			err := json.NewDecoder(r.Body).Decode(config)
+			if err == nil {
+				ipMasking = config.IPMasking
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+		httpServer.AddRoute(http.MethodGet, subResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(fmt.Sprintf(`{ "ipMasking": "%s" }`, ipMasking)))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), websiteID, restapi.IPMaskingStrict),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, websiteID),
+				test.createIntegrationTestStep(httpServer.GetPort(), websiteID, restapi.IPMaskingRemoveAllDetails),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, websiteID),
+			},
+		})
+	}
+}
+
+func (test *websiteIPMaskingConfigTest) createIntegrationTestStep(httpPort int, websiteID string, ipMasking restapi.IPMasking) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(websiteIPMaskingConfigTerraformTemplate, websiteID, ipMasking), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", websiteID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteIPMaskingConfigFieldWebsiteID, websiteID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, IPMaskingConfigFieldIPMasking, string(ipMasking)),
+		),
+	}
+}
+
+func (test *websiteIPMaskingConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *websiteIPMaskingConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *websiteIPMaskingConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_website_ip_masking_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *websiteIPMaskingConfigTest) createTestResourceShouldUseWebsiteIDAsResourceID() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.True(t, test.resourceHandle.MetaData().SkipIDGeneration)
+		require.Equal(t, WebsiteIPMaskingConfigFieldWebsiteID, *test.resourceHandle.MetaData().ResourceIDField)
+	}
+}
+
+func (test *websiteIPMaskingConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := restapi.IPMaskingConfig{
+			ID:        "website-id",
+			IPMasking: restapi.IPMaskingStrict,
+		}
+
+		testHelper := NewTestHelper[*restapi.IPMaskingConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.NoError(t, err)
+		require.Equal(t, "website-id", resourceData.Id())
+		require.Equal(t, "website-id", resourceData.Get(WebsiteIPMaskingConfigFieldWebsiteID))
+		require.Equal(t, "STRICT", resourceData.Get(IPMaskingConfigFieldIPMasking))
+	}
+}
+
+func (test *websiteIPMaskingConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.IPMaskingConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, WebsiteIPMaskingConfigFieldWebsiteID, "website-id")
+		setValueOnResourceData(t, resourceData, IPMaskingConfigFieldIPMasking, "REMOVE_ALL_DETAILS")
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.IPMaskingConfig{
+			ID:        "website-id",
+			IPMasking: restapi.IPMaskingRemoveAllDetails,
+		}, result)
+	}
+}
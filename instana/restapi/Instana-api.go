@@ -15,6 +15,8 @@ const (
 	RBACSettingsBasePath = SettingsBasePath + "/rbac"
 	//WebsiteMonitoringResourcePath path to website monitoring
 	WebsiteMonitoringResourcePath = InstanaAPIBasePath + "/website-monitoring"
+	//MobileAppMonitoringResourcePath path to mobile app monitoring
+	MobileAppMonitoringResourcePath = InstanaAPIBasePath + "/mobile-app-monitoring"
 	//SyntheticSettingsBasePath path to synthetic monitoring
 	SyntheticSettingsBasePath = InstanaAPIBasePath + "/synthetics" + settingsPathElement
 	//SyntheticTestResourcePath path to synthetic monitoring tests
@@ -40,6 +42,42 @@ type InstanaAPI interface {
 	CustomDashboards() RestResource[*CustomDashboard]
 	SyntheticTest() RestResource[*SyntheticTest]
 	SyntheticLocation() ReadOnlyRestResource[*SyntheticLocation]
+	InfraAlertConfigs() RestResource[*InfraAlertConfig]
+	MobileAppAlertConfigs() RestResource[*MobileAppAlertConfig]
+	SyntheticAlertConfigs() RestResource[*SyntheticAlertConfig]
+	MaintenanceWindows() RestResource[*MaintenanceWindow]
+	WebsiteGeoLocationConfigs() RestResource[*GeoLocationConfig]
+	WebsiteIPMaskingConfigs() RestResource[*IPMaskingConfig]
+	MobileAppMonitoringConfigs() RestResource[*MobileAppMonitoringConfig]
+	MobileAppGeoLocationConfigs() RestResource[*GeoLocationConfig]
+	MobileAppIPMaskingConfigs() RestResource[*IPMaskingConfig]
+	ServiceConfigs() RestResource[*ServiceConfig]
+	ManualServiceConfigs() RestResource[*ManualServiceConfig]
+	HttpEndpointConfigs() RestResource[*HttpEndpointConfig]
+	GroupMappings() RestResource[*GroupMapping]
+	IdentityProviderConfig() RestResource[*IdentityProviderConfig]
+	SyntheticCredentials() RestResource[*SyntheticCredential]
+	ApdexConfigs() RestResource[*ApdexConfig]
+	ApdexReports() ApdexReportResource
+	Releases() RestResource[*Release]
+	CustomPayloadConfiguration() RestResource[*CustomPayloadConfiguration]
+	BuiltinEventSpecificationStates() RestResource[*BuiltinEventSpecificationState]
+	ApplicationAlertConfigVersions() AlertConfigVersionResource
+	GlobalApplicationAlertConfigVersions() AlertConfigVersionResource
+	WebsiteAlertConfigVersions() AlertConfigVersionResource
+	AlertConfigVersionRestores() RestResource[*AlertConfigVersionRestore]
+	Users() ReadOnlyRestResource[*User]
+	UsersOverview() UsersOverviewResource
+	UserInvitations() RestResource[*UserInvitation]
+	ApplicationCatalogTags() ReadOnlyRestResource[*CatalogTag]
+	ApplicationCatalogMetrics() ReadOnlyRestResource[*CatalogMetric]
+	WebsiteCatalogTags() ReadOnlyRestResource[*CatalogTag]
+	WebsiteCatalogMetrics() ReadOnlyRestResource[*CatalogMetric]
+	MobileAppCatalogTags() ReadOnlyRestResource[*CatalogTag]
+	MobileAppCatalogMetrics() ReadOnlyRestResource[*CatalogMetric]
+	Applications() DiscoveryResource[*Application]
+	Services() DiscoveryResource[*Service]
+	Endpoints() DiscoveryResource[*Endpoint]
 }
 
 // NewInstanaAPI creates a new instance of the instana API
@@ -74,17 +112,21 @@ func (api *baseInstanaAPI) ApplicationConfigs() RestResource[*ApplicationConfig]
 
 // ApplicationAlertConfigs implementation of InstanaAPI interface
 func (api *baseInstanaAPI) ApplicationAlertConfigs() RestResource[*ApplicationAlertConfig] {
-	return NewCreatePOSTUpdatePOSTRestResource(ApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+	delegate := NewCreatePOSTUpdatePOSTRestResource(ApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+	enabledAware := NewEnabledAwareRestResource(delegate, ApplicationAlertConfigsResourcePath, api.client)
+	return NewBaselineUpdateAwareRestResource(enabledAware, ApplicationAlertConfigsResourcePath, api.client)
 }
 
 // GlobalApplicationAlertConfigs implementation of InstanaAPI interface
 func (api *baseInstanaAPI) GlobalApplicationAlertConfigs() RestResource[*ApplicationAlertConfig] {
-	return NewCreatePOSTUpdatePOSTRestResource(GlobalApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+	delegate := NewCreatePOSTUpdatePOSTRestResource(GlobalApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+	return NewEnabledAwareRestResource(delegate, GlobalApplicationAlertConfigsResourcePath, api.client)
 }
 
 // AlertingChannels implementation of InstanaAPI interface
 func (api *baseInstanaAPI) AlertingChannels() RestResource[*AlertingChannel] {
-	return NewCreatePUTUpdatePUTRestResource(AlertingChannelsResourcePath, NewDefaultJSONUnmarshaller(&AlertingChannel{}), api.client)
+	delegate := NewCreatePUTUpdatePUTRestResource(AlertingChannelsResourcePath, NewDefaultJSONUnmarshaller(&AlertingChannel{}), api.client)
+	return NewVerifyingAlertingChannelRestResource(delegate, api.client)
 }
 
 // AlertingConfigurations implementation of InstanaAPI interface
@@ -101,7 +143,9 @@ func (api *baseInstanaAPI) WebsiteMonitoringConfig() RestResource[*WebsiteMonito
 }
 
 func (api *baseInstanaAPI) WebsiteAlertConfig() RestResource[*WebsiteAlertConfig] {
-	return NewCreatePOSTUpdatePOSTRestResource(WebsiteAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&WebsiteAlertConfig{})), api.client)
+	delegate := NewCreatePOSTUpdatePOSTRestResource(WebsiteAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&WebsiteAlertConfig{})), api.client)
+	enabledAware := NewEnabledAwareRestResource(delegate, WebsiteAlertConfigResourcePath, api.client)
+	return NewBaselineUpdateAwareRestResource(enabledAware, WebsiteAlertConfigResourcePath, api.client)
 }
 
 func (api *baseInstanaAPI) Groups() RestResource[*Group] {
@@ -120,3 +164,187 @@ func (api *baseInstanaAPI) SyntheticTest() RestResource[*SyntheticTest] {
 func (api *baseInstanaAPI) SyntheticLocation() ReadOnlyRestResource[*SyntheticLocation] {
 	return NewReadOnlyRestResource(SyntheticLocationResourcePath, NewDefaultJSONUnmarshaller(&SyntheticLocation{}), api.client)
 }
+
+// InfraAlertConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) InfraAlertConfigs() RestResource[*InfraAlertConfig] {
+	return NewCreatePOSTUpdatePOSTRestResource(InfraAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&InfraAlertConfig{})), api.client)
+}
+
+// MobileAppAlertConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppAlertConfigs() RestResource[*MobileAppAlertConfig] {
+	return NewCreatePOSTUpdatePOSTRestResource(MobileAppAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&MobileAppAlertConfig{})), api.client)
+}
+
+// SyntheticAlertConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SyntheticAlertConfigs() RestResource[*SyntheticAlertConfig] {
+	return NewCreatePOSTUpdatePOSTRestResource(SyntheticAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&SyntheticAlertConfig{})), api.client)
+}
+
+// MaintenanceWindows implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MaintenanceWindows() RestResource[*MaintenanceWindow] {
+	return NewMaintenanceWindowRestResource(NewDefaultJSONUnmarshaller(&MaintenanceWindow{}), api.client)
+}
+
+// WebsiteGeoLocationConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteGeoLocationConfigs() RestResource[*GeoLocationConfig] {
+	return NewSubResourceConfigRestResource(WebsiteMonitoringConfigResourcePath, GeoLocationConfigSubResourcePath, NewDefaultJSONUnmarshaller(&GeoLocationConfig{}), api.client, NewDefaultGeoLocationConfig)
+}
+
+// WebsiteIPMaskingConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteIPMaskingConfigs() RestResource[*IPMaskingConfig] {
+	return NewSubResourceConfigRestResource(WebsiteMonitoringConfigResourcePath, IPMaskingConfigSubResourcePath, NewDefaultJSONUnmarshaller(&IPMaskingConfig{}), api.client, NewDefaultIPMaskingConfig)
+}
+
+// MobileAppMonitoringConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppMonitoringConfigs() RestResource[*MobileAppMonitoringConfig] {
+	return NewMobileAppMonitoringConfigRestResource(NewDefaultJSONUnmarshaller(&MobileAppMonitoringConfig{}), api.client)
+}
+
+// MobileAppGeoLocationConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppGeoLocationConfigs() RestResource[*GeoLocationConfig] {
+	return NewSubResourceConfigRestResource(MobileAppMonitoringConfigResourcePath, GeoLocationConfigSubResourcePath, NewDefaultJSONUnmarshaller(&GeoLocationConfig{}), api.client, NewDefaultGeoLocationConfig)
+}
+
+// MobileAppIPMaskingConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppIPMaskingConfigs() RestResource[*IPMaskingConfig] {
+	return NewSubResourceConfigRestResource(MobileAppMonitoringConfigResourcePath, IPMaskingConfigSubResourcePath, NewDefaultJSONUnmarshaller(&IPMaskingConfig{}), api.client, NewDefaultIPMaskingConfig)
+}
+
+// ServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ServiceConfigs() RestResource[*ServiceConfig] {
+	return NewServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ServiceConfig{}), api.client)
+}
+
+// ManualServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ManualServiceConfigs() RestResource[*ManualServiceConfig] {
+	return NewListLookupRestResource(NewCreatePOSTUpdatePUTRestResource(ManualServiceConfigResourcePath, NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), api.client))
+}
+
+// HttpEndpointConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) HttpEndpointConfigs() RestResource[*HttpEndpointConfig] {
+	return NewCreatePOSTUpdatePUTRestResource(HttpEndpointConfigResourcePath, NewDefaultJSONUnmarshaller(&HttpEndpointConfig{}), api.client)
+}
+
+// GroupMappings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupMappings() RestResource[*GroupMapping] {
+	return NewListLookupRestResource(NewCreatePOSTUpdatePUTRestResource(RBACMappingsResourcePath, NewDefaultJSONUnmarshaller(&GroupMapping{}), api.client))
+}
+
+// IdentityProviderConfig implementation of InstanaAPI interface
+func (api *baseInstanaAPI) IdentityProviderConfig() RestResource[*IdentityProviderConfig] {
+	return NewSingletonConfigRestResource(IdentityProviderConfigResourcePath, NewDefaultJSONUnmarshaller(&IdentityProviderConfig{}), api.client, NewDefaultIdentityProviderConfig)
+}
+
+// SyntheticCredentials implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SyntheticCredentials() RestResource[*SyntheticCredential] {
+	return NewSyntheticCredentialRestResource(api.client)
+}
+
+// ApdexConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApdexConfigs() RestResource[*ApdexConfig] {
+	return NewCreatePOSTUpdateNotSupportedRestResource(ApdexConfigResourcePath, NewDefaultJSONUnmarshaller(&ApdexConfig{}), api.client)
+}
+
+// ApdexReports implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApdexReports() ApdexReportResource {
+	return NewApdexReportResource(api.client)
+}
+
+// Releases implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Releases() RestResource[*Release] {
+	return NewCreatePOSTUpdatePUTRestResource(ReleaseResourcePath, NewDefaultJSONUnmarshaller(&Release{}), api.client)
+}
+
+// CustomPayloadConfiguration implementation of InstanaAPI interface
+func (api *baseInstanaAPI) CustomPayloadConfiguration() RestResource[*CustomPayloadConfiguration] {
+	return NewDeletableSingletonConfigRestResource(CustomPayloadConfigurationResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&CustomPayloadConfiguration{})), api.client)
+}
+
+// BuiltinEventSpecificationStates implementation of InstanaAPI interface
+func (api *baseInstanaAPI) BuiltinEventSpecificationStates() RestResource[*BuiltinEventSpecificationState] {
+	return NewBuiltinEventSpecificationStateRestResource(api.client)
+}
+
+// ApplicationAlertConfigVersions implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApplicationAlertConfigVersions() AlertConfigVersionResource {
+	return NewAlertConfigVersionResource(ApplicationAlertConfigsResourcePath, api.client)
+}
+
+// GlobalApplicationAlertConfigVersions implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GlobalApplicationAlertConfigVersions() AlertConfigVersionResource {
+	return NewAlertConfigVersionResource(GlobalApplicationAlertConfigsResourcePath, api.client)
+}
+
+// WebsiteAlertConfigVersions implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteAlertConfigVersions() AlertConfigVersionResource {
+	return NewAlertConfigVersionResource(WebsiteAlertConfigResourcePath, api.client)
+}
+
+// AlertConfigVersionRestores implementation of InstanaAPI interface
+func (api *baseInstanaAPI) AlertConfigVersionRestores() RestResource[*AlertConfigVersionRestore] {
+	return NewAlertConfigVersionRestoreRestResource(map[AlertConfigType]AlertConfigVersionResource{
+		AlertConfigTypeApplication:       api.ApplicationAlertConfigVersions(),
+		AlertConfigTypeGlobalApplication: api.GlobalApplicationAlertConfigVersions(),
+		AlertConfigTypeWebsite:           api.WebsiteAlertConfigVersions(),
+	})
+}
+
+// Users implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Users() ReadOnlyRestResource[*User] {
+	return NewReadOnlyRestResource(UsersResourcePath, NewDefaultJSONUnmarshaller(&User{}), api.client)
+}
+
+// UsersOverview implementation of InstanaAPI interface
+func (api *baseInstanaAPI) UsersOverview() UsersOverviewResource {
+	return NewUsersOverviewResource(api.client)
+}
+
+// UserInvitations implementation of InstanaAPI interface
+func (api *baseInstanaAPI) UserInvitations() RestResource[*UserInvitation] {
+	return NewUserInvitationRestResource(api.UsersOverview(), api.client)
+}
+
+// ApplicationCatalogTags implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApplicationCatalogTags() ReadOnlyRestResource[*CatalogTag] {
+	return NewReadOnlyRestResource(ApplicationCatalogTagsResourcePath, NewDefaultJSONUnmarshaller(&CatalogTag{}), api.client)
+}
+
+// ApplicationCatalogMetrics implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApplicationCatalogMetrics() ReadOnlyRestResource[*CatalogMetric] {
+	return NewReadOnlyRestResource(ApplicationCatalogMetricsResourcePath, NewDefaultJSONUnmarshaller(&CatalogMetric{}), api.client)
+}
+
+// WebsiteCatalogTags implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteCatalogTags() ReadOnlyRestResource[*CatalogTag] {
+	return NewReadOnlyRestResource(WebsiteCatalogTagsResourcePath, NewDefaultJSONUnmarshaller(&CatalogTag{}), api.client)
+}
+
+// WebsiteCatalogMetrics implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteCatalogMetrics() ReadOnlyRestResource[*CatalogMetric] {
+	return NewReadOnlyRestResource(WebsiteCatalogMetricsResourcePath, NewDefaultJSONUnmarshaller(&CatalogMetric{}), api.client)
+}
+
+// MobileAppCatalogTags implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppCatalogTags() ReadOnlyRestResource[*CatalogTag] {
+	return NewReadOnlyRestResource(MobileAppCatalogTagsResourcePath, NewDefaultJSONUnmarshaller(&CatalogTag{}), api.client)
+}
+
+// MobileAppCatalogMetrics implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppCatalogMetrics() ReadOnlyRestResource[*CatalogMetric] {
+	return NewReadOnlyRestResource(MobileAppCatalogMetricsResourcePath, NewDefaultJSONUnmarshaller(&CatalogMetric{}), api.client)
+}
+
+// Applications implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Applications() DiscoveryResource[*Application] {
+	return NewDiscoveryResource[*Application](ApplicationsResourcePath, api.client)
+}
+
+// Services implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Services() DiscoveryResource[*Service] {
+	return NewDiscoveryResource[*Service](ServicesResourcePath, api.client)
+}
+
+// Endpoints implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Endpoints() DiscoveryResource[*Endpoint] {
+	return NewDiscoveryResource[*Endpoint](EndpointsResourcePath, api.client)
+}
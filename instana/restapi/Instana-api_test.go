@@ -86,5 +86,185 @@ func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return InfraAlertConfig instance", func(t *testing.T) {
+		resource := api.InfraAlertConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppAlertConfig instance", func(t *testing.T) {
+		resource := api.MobileAppAlertConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SyntheticAlertConfig instance", func(t *testing.T) {
+		resource := api.SyntheticAlertConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MaintenanceWindow instance", func(t *testing.T) {
+		resource := api.MaintenanceWindows()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteGeoLocationConfig instance", func(t *testing.T) {
+		resource := api.WebsiteGeoLocationConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteIPMaskingConfig instance", func(t *testing.T) {
+		resource := api.WebsiteIPMaskingConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppMonitoringConfig instance", func(t *testing.T) {
+		resource := api.MobileAppMonitoringConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppGeoLocationConfig instance", func(t *testing.T) {
+		resource := api.MobileAppGeoLocationConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppIPMaskingConfig instance", func(t *testing.T) {
+		resource := api.MobileAppIPMaskingConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ServiceConfig instance", func(t *testing.T) {
+		resource := api.ServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ManualServiceConfig instance", func(t *testing.T) {
+		resource := api.ManualServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return HttpEndpointConfig instance", func(t *testing.T) {
+		resource := api.HttpEndpointConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GroupMapping instance", func(t *testing.T) {
+		resource := api.GroupMappings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return IdentityProviderConfig instance", func(t *testing.T) {
+		resource := api.IdentityProviderConfig()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SyntheticCredential instance", func(t *testing.T) {
+		resource := api.SyntheticCredentials()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApdexConfig instance", func(t *testing.T) {
+		resource := api.ApdexConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApdexReport instance", func(t *testing.T) {
+		resource := api.ApdexReports()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Release instance", func(t *testing.T) {
+		resource := api.Releases()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return CustomPayloadConfiguration instance", func(t *testing.T) {
+		resource := api.CustomPayloadConfiguration()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return BuiltinEventSpecificationStates instance", func(t *testing.T) {
+		resource := api.BuiltinEventSpecificationStates()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApplicationAlertConfigVersions instance", func(t *testing.T) {
+		resource := api.ApplicationAlertConfigVersions()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GlobalApplicationAlertConfigVersions instance", func(t *testing.T) {
+		resource := api.GlobalApplicationAlertConfigVersions()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteAlertConfigVersions instance", func(t *testing.T) {
+		resource := api.WebsiteAlertConfigVersions()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return AlertConfigVersionRestores instance", func(t *testing.T) {
+		resource := api.AlertConfigVersionRestores()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Users instance", func(t *testing.T) {
+		resource := api.Users()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return UsersOverview instance", func(t *testing.T) {
+		resource := api.UsersOverview()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return UserInvitations instance", func(t *testing.T) {
+		resource := api.UserInvitations()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApplicationCatalogTags instance", func(t *testing.T) {
+		resource := api.ApplicationCatalogTags()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApplicationCatalogMetrics instance", func(t *testing.T) {
+		resource := api.ApplicationCatalogMetrics()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteCatalogTags instance", func(t *testing.T) {
+		resource := api.WebsiteCatalogTags()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteCatalogMetrics instance", func(t *testing.T) {
+		resource := api.WebsiteCatalogMetrics()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppCatalogTags instance", func(t *testing.T) {
+		resource := api.MobileAppCatalogTags()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppCatalogMetrics instance", func(t *testing.T) {
+		resource := api.MobileAppCatalogMetrics()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Applications instance", func(t *testing.T) {
+		resource := api.Applications()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Services instance", func(t *testing.T) {
+		resource := api.Services()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Endpoints instance", func(t *testing.T) {
+		resource := api.Endpoints()
+
+		require.NotNil(t, resource)
+	})
 
 }
@@ -0,0 +1,197 @@
+package restapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	//AlertConfigVersionsSubResourcePath the sub resource path of the Instana API to list the versions of an alert config
+	AlertConfigVersionsSubResourcePath = "versions"
+	//AlertConfigRestoreSubResourcePath the sub resource path of the Instana API to restore a version of an alert config
+	AlertConfigRestoreSubResourcePath = "restore"
+
+	alertConfigVersionRestoreIDSeparator = ":"
+)
+
+// AlertConfigType custom type for the type of alert configs which keep a version history
+type AlertConfigType string
+
+// AlertConfigTypes custom type for a slice of AlertConfigType
+type AlertConfigTypes []AlertConfigType
+
+// ToStringSlice Returns the corresponding string representations
+func (types AlertConfigTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, v := range types {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//AlertConfigTypeApplication constant value for the alert config type of application alert configs
+	AlertConfigTypeApplication = AlertConfigType("application")
+	//AlertConfigTypeGlobalApplication constant value for the alert config type of global application alert configs
+	AlertConfigTypeGlobalApplication = AlertConfigType("global_application")
+	//AlertConfigTypeWebsite constant value for the alert config type of website alert configs
+	AlertConfigTypeWebsite = AlertConfigType("website")
+)
+
+// SupportedAlertConfigTypes list of all supported AlertConfigType
+var SupportedAlertConfigTypes = AlertConfigTypes{AlertConfigTypeApplication, AlertConfigTypeGlobalApplication, AlertConfigTypeWebsite}
+
+// AlertConfigVersionAuthor is the representation of the author of a version of an alert config in Instana
+type AlertConfigVersionAuthor struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// AlertConfigChangeSummary is the representation of the change summary of a version of an alert config in Instana
+type AlertConfigChangeSummary struct {
+	Author     AlertConfigVersionAuthor `json:"author"`
+	ChangeType string                   `json:"changeType"`
+}
+
+// AlertConfigVersion is the representation of a version of an alert config in Instana
+type AlertConfigVersion struct {
+	ID            string                    `json:"id"`
+	Created       int64                     `json:"created"`
+	Deleted       bool                      `json:"deleted"`
+	Enabled       bool                      `json:"enabled"`
+	ChangeSummary *AlertConfigChangeSummary `json:"changeSummary"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (v *AlertConfigVersion) GetIDForResourcePath() string {
+	return v.ID
+}
+
+// AlertConfigVersionResource interface definition of the version history of alert configs. Versions are identified by their created timestamp
+type AlertConfigVersionResource interface {
+	GetVersions(alertConfigID string) (*[]*AlertConfigVersion, error)
+	Restore(alertConfigID string, created int64) error
+}
+
+// NewAlertConfigVersionResource creates a new AlertConfigVersionResource for the alert configs at the given resource path
+func NewAlertConfigVersionResource(resourcePath string, client RestClient) AlertConfigVersionResource {
+	return &alertConfigVersionResource{
+		resourcePath: resourcePath,
+		unmarshaller: NewDefaultJSONUnmarshaller(&AlertConfigVersion{}),
+		client:       client,
+	}
+}
+
+type alertConfigVersionResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*AlertConfigVersion]
+	client       RestClient
+}
+
+func (r *alertConfigVersionResource) GetVersions(alertConfigID string) (*[]*AlertConfigVersion, error) {
+	data, err := r.client.GetSubResource(r.resourcePath, alertConfigID, AlertConfigVersionsSubResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.UnmarshalArray(data)
+}
+
+func (r *alertConfigVersionResource) Restore(alertConfigID string, created int64) error {
+	_, err := r.client.PutSubResource(r.resourcePath, alertConfigID, fmt.Sprintf("%s/%d", AlertConfigRestoreSubResourcePath, created))
+	return err
+}
+
+// AlertConfigVersionRestore is the representation of the restore of a version of an alert config in Instana
+type AlertConfigVersionRestore struct {
+	AlertConfigType AlertConfigType
+	AlertConfigID   string
+	Version         int64
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The ID is composed of the alert config type, the alert config id and the version
+func (r *AlertConfigVersionRestore) GetIDForResourcePath() string {
+	return strings.Join([]string{string(r.AlertConfigType), r.AlertConfigID, strconv.FormatInt(r.Version, 10)}, alertConfigVersionRestoreIDSeparator)
+}
+
+// NewAlertConfigVersionRestoreRestResource creates a new REST resource to restore versions of alert configs. The restore is executed on create. Restores cannot be updated and deleting a restore does not change the alert config
+func NewAlertConfigVersionRestoreRestResource(versionResources map[AlertConfigType]AlertConfigVersionResource) RestResource[*AlertConfigVersionRestore] {
+	return &alertConfigVersionRestoreRestResource{versionResources: versionResources}
+}
+
+type alertConfigVersionRestoreRestResource struct {
+	versionResources map[AlertConfigType]AlertConfigVersionResource
+}
+
+func (r *alertConfigVersionRestoreRestResource) GetAll() (*[]*AlertConfigVersionRestore, error) {
+	return nil, fmt.Errorf("get all is not supported for alert config version restores")
+}
+
+func (r *alertConfigVersionRestoreRestResource) GetOne(id string) (*AlertConfigVersionRestore, error) {
+	restore, err := r.parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	versionResource, err := r.getVersionResource(restore.AlertConfigType)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := versionResource.GetVersions(restore.AlertConfigID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range *versions {
+		if v.Created == restore.Version {
+			return restore, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *alertConfigVersionRestoreRestResource) parseID(id string) (*AlertConfigVersionRestore, error) {
+	parts := strings.Split(id, alertConfigVersionRestoreIDSeparator)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid ID %s of alert config version restore; expected <alert_config_type>%s<alert_config_id>%s<version>", id, alertConfigVersionRestoreIDSeparator, alertConfigVersionRestoreIDSeparator)
+	}
+	version, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version in ID %s of alert config version restore; %s", id, err)
+	}
+	return &AlertConfigVersionRestore{
+		AlertConfigType: AlertConfigType(parts[0]),
+		AlertConfigID:   parts[1],
+		Version:         version,
+	}, nil
+}
+
+func (r *alertConfigVersionRestoreRestResource) getVersionResource(alertConfigType AlertConfigType) (AlertConfigVersionResource, error) {
+	versionResource, ok := r.versionResources[alertConfigType]
+	if !ok {
+		return nil, fmt.Errorf("alert config type %s is not supported", alertConfigType)
+	}
+	return versionResource, nil
+}
+
+func (r *alertConfigVersionRestoreRestResource) Create(data *AlertConfigVersionRestore) (*AlertConfigVersionRestore, error) {
+	versionResource, err := r.getVersionResource(data.AlertConfigType)
+	if err != nil {
+		return data, err
+	}
+	if err = versionResource.Restore(data.AlertConfigID, data.Version); err != nil {
+		return data, err
+	}
+	return data, nil
+}
+
+func (r *alertConfigVersionRestoreRestResource) Update(data *AlertConfigVersionRestore) (*AlertConfigVersionRestore, error) {
+	return data, fmt.Errorf("update is not supported for alert config version restores")
+}
+
+func (r *alertConfigVersionRestoreRestResource) Delete(_ *AlertConfigVersionRestore) error {
+	return nil
+}
+
+// DeleteByID does not change the alert config as a restore cannot be reverted
+func (r *alertConfigVersionRestoreRestResource) DeleteByID(_ string) error {
+	return nil
+}
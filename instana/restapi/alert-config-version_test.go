@@ -0,0 +1,175 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const alertConfigVersionAlertConfigID = "alert-config-id"
+
+func TestShouldReturnSupportedAlertConfigTypesAsStringSlice(t *testing.T) {
+	expected := []string{"application", "global_application", "website"}
+	require.Equal(t, expected, SupportedAlertConfigTypes.ToStringSlice())
+}
+
+func TestShouldReturnCompositeIDOfAlertConfigVersionRestore(t *testing.T) {
+	restore := &AlertConfigVersionRestore{AlertConfigType: AlertConfigTypeWebsite, AlertConfigID: alertConfigVersionAlertConfigID, Version: 1234}
+
+	require.Equal(t, "website:alert-config-id:1234", restore.GetIDForResourcePath())
+}
+
+func TestShouldGetVersionsOfAlertConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetSubResource(WebsiteAlertConfigResourcePath, alertConfigVersionAlertConfigID, "versions").Times(1).Return([]byte(`[{"id":"alert-config-id","created":1000,"deleted":false,"enabled":true,"changeSummary":{"author":{"id":"user-id","type":"USER"},"changeType":"UPDATE"}}]`), nil)
+
+	sut := NewAlertConfigVersionResource(WebsiteAlertConfigResourcePath, client)
+
+	result, err := sut.GetVersions(alertConfigVersionAlertConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*AlertConfigVersion{
+		{
+			ID:      alertConfigVersionAlertConfigID,
+			Created: 1000,
+			Enabled: true,
+			ChangeSummary: &AlertConfigChangeSummary{
+				Author:     AlertConfigVersionAuthor{ID: "user-id", Type: "USER"},
+				ChangeType: "UPDATE",
+			},
+		},
+	}, result)
+}
+
+func TestShouldFailToGetVersionsOfAlertConfigWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetSubResource(WebsiteAlertConfigResourcePath, alertConfigVersionAlertConfigID, "versions").Times(1).Return(nil, expectedError)
+
+	sut := NewAlertConfigVersionResource(WebsiteAlertConfigResourcePath, client)
+
+	_, err := sut.GetVersions(alertConfigVersionAlertConfigID)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldRestoreVersionOfAlertConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutSubResource(ApplicationAlertConfigsResourcePath, alertConfigVersionAlertConfigID, "restore/1000").Times(1).Return(nil, nil)
+
+	sut := NewAlertConfigVersionResource(ApplicationAlertConfigsResourcePath, client)
+
+	err := sut.Restore(alertConfigVersionAlertConfigID, 1000)
+
+	require.NoError(t, err)
+}
+
+func TestShouldRestoreVersionOfAlertConfigOnCreateOfAlertConfigVersionRestore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restore := &AlertConfigVersionRestore{AlertConfigType: AlertConfigTypeGlobalApplication, AlertConfigID: alertConfigVersionAlertConfigID, Version: 1000}
+	versionResource := mocks.NewMockAlertConfigVersionResource(ctrl)
+	versionResource.EXPECT().Restore(alertConfigVersionAlertConfigID, int64(1000)).Times(1).Return(nil)
+
+	sut := NewAlertConfigVersionRestoreRestResource(map[AlertConfigType]AlertConfigVersionResource{AlertConfigTypeGlobalApplication: versionResource})
+
+	result, err := sut.Create(restore)
+
+	require.NoError(t, err)
+	require.Equal(t, restore, result)
+}
+
+func TestShouldFailToCreateAlertConfigVersionRestoreWhenRestoreFails(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	versionResource := mocks.NewMockAlertConfigVersionResource(ctrl)
+	versionResource.EXPECT().Restore(alertConfigVersionAlertConfigID, int64(1000)).Times(1).Return(expectedError)
+
+	sut := NewAlertConfigVersionRestoreRestResource(map[AlertConfigType]AlertConfigVersionResource{AlertConfigTypeApplication: versionResource})
+
+	_, err := sut.Create(&AlertConfigVersionRestore{AlertConfigType: AlertConfigTypeApplication, AlertConfigID: alertConfigVersionAlertConfigID, Version: 1000})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToCreateAlertConfigVersionRestoreWhenAlertConfigTypeIsNotSupported(t *testing.T) {
+	sut := NewAlertConfigVersionRestoreRestResource(map[AlertConfigType]AlertConfigVersionResource{})
+
+	_, err := sut.Create(&AlertConfigVersionRestore{AlertConfigType: AlertConfigType("invalid"), AlertConfigID: alertConfigVersionAlertConfigID, Version: 1000})
+
+	require.ErrorContains(t, err, "alert config type invalid is not supported")
+}
+
+func TestShouldGetOneAlertConfigVersionRestoreWhenVersionExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	versionResource := mocks.NewMockAlertConfigVersionResource(ctrl)
+	versionResource.EXPECT().GetVersions(alertConfigVersionAlertConfigID).Times(1).Return(&[]*AlertConfigVersion{{ID: alertConfigVersionAlertConfigID, Created: 1000}, {ID: alertConfigVersionAlertConfigID, Created: 2000}}, nil)
+
+	sut := NewAlertConfigVersionRestoreRestResource(map[AlertConfigType]AlertConfigVersionResource{AlertConfigTypeWebsite: versionResource})
+
+	result, err := sut.GetOne("website:alert-config-id:1000")
+
+	require.NoError(t, err)
+	require.Equal(t, &AlertConfigVersionRestore{AlertConfigType: AlertConfigTypeWebsite, AlertConfigID: alertConfigVersionAlertConfigID, Version: 1000}, result)
+}
+
+func TestShouldReturnNotFoundErrorForAlertConfigVersionRestoreWhenVersionDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	versionResource := mocks.NewMockAlertConfigVersionResource(ctrl)
+	versionResource.EXPECT().GetVersions(alertConfigVersionAlertConfigID).Times(1).Return(&[]*AlertConfigVersion{{ID: alertConfigVersionAlertConfigID, Created: 2000}}, nil)
+
+	sut := NewAlertConfigVersionRestoreRestResource(map[AlertConfigType]AlertConfigVersionResource{AlertConfigTypeWebsite: versionResource})
+
+	_, err := sut.GetOne("website:alert-config-id:1000")
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneAlertConfigVersionRestoreWhenIDIsNotValid(t *testing.T) {
+	sut := NewAlertConfigVersionRestoreRestResource(map[AlertConfigType]AlertConfigVersionResource{})
+
+	_, err := sut.GetOne("invalid")
+	require.ErrorContains(t, err, "invalid ID invalid of alert config version restore")
+
+	_, err = sut.GetOne("website:alert-config-id:abc")
+	require.ErrorContains(t, err, "invalid version in ID")
+}
+
+func TestShouldNotSupportGetAllAndUpdateOfAlertConfigVersionRestore(t *testing.T) {
+	sut := NewAlertConfigVersionRestoreRestResource(map[AlertConfigType]AlertConfigVersionResource{})
+
+	_, err := sut.GetAll()
+	require.Error(t, err)
+
+	_, err = sut.Update(&AlertConfigVersionRestore{})
+	require.Error(t, err)
+}
+
+func TestShouldNotChangeAlertConfigOnDeleteOfAlertConfigVersionRestore(t *testing.T) {
+	sut := NewAlertConfigVersionRestoreRestResource(map[AlertConfigType]AlertConfigVersionResource{})
+
+	require.NoError(t, sut.Delete(&AlertConfigVersionRestore{}))
+	require.NoError(t, sut.DeleteByID("website:alert-config-id:1000"))
+}
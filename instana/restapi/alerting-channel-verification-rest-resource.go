@@ -0,0 +1,65 @@
+package restapi
+
+import "fmt"
+
+// NewVerifyingAlertingChannelRestResource creates a new REST resource which decorates the provided REST resource of alerting channels. After create and update a test notification is sent through the alerting channel when the verification is requested by the alerting channel. Alerting channels which fail the verification on create are deleted again
+func NewVerifyingAlertingChannelRestResource(delegate RestResource[*AlertingChannel], client RestClient) RestResource[*AlertingChannel] {
+	return &verifyingAlertingChannelRestResource{
+		delegate: delegate,
+		client:   client,
+	}
+}
+
+type verifyingAlertingChannelRestResource struct {
+	delegate RestResource[*AlertingChannel]
+	client   RestClient
+}
+
+func (r *verifyingAlertingChannelRestResource) GetAll() (*[]*AlertingChannel, error) {
+	return r.delegate.GetAll()
+}
+
+func (r *verifyingAlertingChannelRestResource) GetOne(id string) (*AlertingChannel, error) {
+	return r.delegate.GetOne(id)
+}
+
+func (r *verifyingAlertingChannelRestResource) Create(data *AlertingChannel) (*AlertingChannel, error) {
+	result, err := r.delegate.Create(data)
+	if err != nil || !data.VerifyOnApply {
+		return result, err
+	}
+
+	err = r.verify(result)
+	if err != nil {
+		deleteErr := r.delegate.Delete(result)
+		if deleteErr != nil {
+			return nil, fmt.Errorf("%w; failed to delete alerting channel %s after failed verification; %s", err, result.ID, deleteErr)
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *verifyingAlertingChannelRestResource) Update(data *AlertingChannel) (*AlertingChannel, error) {
+	result, err := r.delegate.Update(data)
+	if err != nil || !data.VerifyOnApply {
+		return result, err
+	}
+	return result, r.verify(result)
+}
+
+func (r *verifyingAlertingChannelRestResource) verify(channel *AlertingChannel) error {
+	_, err := r.client.PutWithoutID(channel, AlertingChannelsTestResourcePath)
+	if err != nil {
+		return fmt.Errorf("verification of alerting channel %s failed; %w", channel.Name, err)
+	}
+	return nil
+}
+
+func (r *verifyingAlertingChannelRestResource) Delete(data *AlertingChannel) error {
+	return r.delegate.Delete(data)
+}
+
+func (r *verifyingAlertingChannelRestResource) DeleteByID(id string) error {
+	return r.delegate.DeleteByID(id)
+}
@@ -0,0 +1,209 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const verifyingAlertingChannelTestID = "test-id"
+
+func TestShouldDelegateGetAllOfVerifyingAlertingChannelRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedResult := &[]*AlertingChannel{{ID: verifyingAlertingChannelTestID}}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	delegate.EXPECT().GetAll().Times(1).Return(expectedResult, nil)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResult, result)
+}
+
+func TestShouldDelegateGetOneOfVerifyingAlertingChannelRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedResult := &AlertingChannel{ID: verifyingAlertingChannelTestID}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	delegate.EXPECT().GetOne(verifyingAlertingChannelTestID).Times(1).Return(expectedResult, nil)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetOne(verifyingAlertingChannelTestID)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResult, result)
+}
+
+func TestShouldNotVerifyAlertingChannelOnCreateAndUpdateWhenVerificationIsNotRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &AlertingChannel{ID: verifyingAlertingChannelTestID, Name: "test"}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	delegate.EXPECT().Create(data).Times(1).Return(data, nil)
+	delegate.EXPECT().Update(data).Times(1).Return(data, nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, client)
+
+	result, err := sut.Create(data)
+	require.NoError(t, err)
+	require.Equal(t, data, result)
+
+	result, err = sut.Update(data)
+	require.NoError(t, err)
+	require.Equal(t, data, result)
+}
+
+func TestShouldVerifyAlertingChannelOnCreateWhenVerificationIsRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &AlertingChannel{Name: "test", VerifyOnApply: true}
+	created := &AlertingChannel{ID: verifyingAlertingChannelTestID, Name: "test"}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		delegate.EXPECT().Create(data).Times(1).Return(created, nil),
+		client.EXPECT().PutWithoutID(created, AlertingChannelsTestResourcePath).Times(1).Return(nil, nil),
+	)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, client)
+
+	result, err := sut.Create(data)
+
+	require.NoError(t, err)
+	require.Equal(t, created, result)
+}
+
+func TestShouldDeleteAlertingChannelWhenVerificationFailsOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	data := &AlertingChannel{Name: "test", VerifyOnApply: true}
+	created := &AlertingChannel{ID: verifyingAlertingChannelTestID, Name: "test"}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		delegate.EXPECT().Create(data).Times(1).Return(created, nil),
+		client.EXPECT().PutWithoutID(created, AlertingChannelsTestResourcePath).Times(1).Return(nil, expectedError),
+		delegate.EXPECT().Delete(created).Times(1).Return(nil),
+	)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, client)
+
+	result, err := sut.Create(data)
+
+	require.Nil(t, result)
+	require.ErrorIs(t, err, expectedError)
+	require.ErrorContains(t, err, "verification of alerting channel test failed")
+}
+
+func TestShouldReturnVerificationAndDeleteErrorWhenAlertingChannelCannotBeDeletedAfterFailedVerificationOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	verificationError := errors.New("verification error")
+	deleteError := errors.New("delete error")
+	data := &AlertingChannel{Name: "test", VerifyOnApply: true}
+	created := &AlertingChannel{ID: verifyingAlertingChannelTestID, Name: "test"}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		delegate.EXPECT().Create(data).Times(1).Return(created, nil),
+		client.EXPECT().PutWithoutID(created, AlertingChannelsTestResourcePath).Times(1).Return(nil, verificationError),
+		delegate.EXPECT().Delete(created).Times(1).Return(deleteError),
+	)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, client)
+
+	_, err := sut.Create(data)
+
+	require.ErrorIs(t, err, verificationError)
+	require.ErrorContains(t, err, deleteError.Error())
+}
+
+func TestShouldReturnErrorOfDelegateOnCreateOfVerifyingAlertingChannelRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	data := &AlertingChannel{Name: "test", VerifyOnApply: true}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	delegate.EXPECT().Create(data).Times(1).Return(nil, expectedError)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, client)
+
+	_, err := sut.Create(data)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldVerifyAlertingChannelOnUpdateWhenVerificationIsRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &AlertingChannel{ID: verifyingAlertingChannelTestID, Name: "test", VerifyOnApply: true}
+	updated := &AlertingChannel{ID: verifyingAlertingChannelTestID, Name: "test"}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		delegate.EXPECT().Update(data).Times(1).Return(updated, nil),
+		client.EXPECT().PutWithoutID(updated, AlertingChannelsTestResourcePath).Times(1).Return(nil, nil),
+	)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, client)
+
+	result, err := sut.Update(data)
+
+	require.NoError(t, err)
+	require.Equal(t, updated, result)
+}
+
+func TestShouldReturnErrorWhenVerificationFailsOnUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	data := &AlertingChannel{ID: verifyingAlertingChannelTestID, Name: "test", VerifyOnApply: true}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	delegate.EXPECT().Update(data).Times(1).Return(data, nil)
+	delegate.EXPECT().Delete(gomock.Any()).Times(0)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutWithoutID(data, AlertingChannelsTestResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, client)
+
+	_, err := sut.Update(data)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldDelegateDeleteOfVerifyingAlertingChannelRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &AlertingChannel{ID: verifyingAlertingChannelTestID}
+	delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	delegate.EXPECT().Delete(data).Times(1).Return(nil)
+	delegate.EXPECT().DeleteByID(verifyingAlertingChannelTestID).Times(1).Return(nil)
+
+	sut := NewVerifyingAlertingChannelRestResource(delegate, mocks.NewMockRestClient(ctrl))
+
+	require.NoError(t, sut.Delete(data))
+	require.NoError(t, sut.DeleteByID(verifyingAlertingChannelTestID))
+}
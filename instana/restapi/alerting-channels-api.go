@@ -3,6 +3,9 @@ package restapi
 // AlertingChannelsResourcePath path to Alerting channels resource of Instana RESTful API
 const AlertingChannelsResourcePath = EventSettingsBasePath + "/alertingChannels"
 
+// AlertingChannelsTestResourcePath path to the resource of the Instana RESTful API to send test notifications through an alerting channel
+const AlertingChannelsTestResourcePath = AlertingChannelsResourcePath + "/test"
+
 // AlertingChannel is the representation of an alerting channel in Instana
 type AlertingChannel struct {
 	ID                    string              `json:"id"`
@@ -21,6 +24,18 @@ type AlertingChannel struct {
 	Token                 *string             `json:"token"`
 	WebhookURLs           []string            `json:"webhookUrls"`
 	Headers               []string            `json:"headers"`
+	ServiceNowURL         *string             `json:"serviceNowUrl"`
+	Username              *string             `json:"username"`
+	Password              *string             `json:"password"`
+	SalesforceURL         *string             `json:"salesforceUrl"`
+	ClientID              *string             `json:"clientId"`
+	ClientSecret          *string             `json:"clientSecret"`
+	BearerToken           *string             `json:"bearerToken"`
+	Receiver              *string             `json:"receiver"`
+	ZChatOpsIncidentsURL  *string             `json:"zchatOpsIncidentsUrl"`
+	BearerAuthToken       *string             `json:"bearerAuthToken"`
+	Channels              []string            `json:"channels"`
+	VerifyOnApply         bool                `json:"-"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
@@ -0,0 +1,71 @@
+package restapi
+
+const (
+	//ApdexConfigResourcePath path to apdex config resource of Instana RESTful API
+	ApdexConfigResourcePath = SettingsBasePath + "/apdex"
+	//ApdexReportResourcePath path to apdex report resource of Instana RESTful API
+	ApdexReportResourcePath = InstanaAPIBasePath + "/apdex/report"
+)
+
+// ApdexEntityType custom type for the type of entity of an apdex configuration
+type ApdexEntityType string
+
+// ApdexEntityTypes custom type for a slice of ApdexEntityType
+type ApdexEntityTypes []ApdexEntityType
+
+// ToStringSlice Returns the corresponding string representations
+func (types ApdexEntityTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, v := range types {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//ApdexEntityTypeApplication constant value for the apdex entity type application
+	ApdexEntityTypeApplication = ApdexEntityType("application")
+	//ApdexEntityTypeWebsite constant value for the apdex entity type website
+	ApdexEntityTypeWebsite = ApdexEntityType("website")
+)
+
+// SupportedApdexEntityTypes list of all supported ApdexEntityType
+var SupportedApdexEntityTypes = ApdexEntityTypes{ApdexEntityTypeApplication, ApdexEntityTypeWebsite}
+
+// ApdexEntity represents the nested object apdex entity of the apdex config REST resource at Instana
+type ApdexEntity struct {
+	Type                ApdexEntityType `json:"apdexType"`
+	EntityID            string          `json:"entityId"`
+	TagFilterExpression *TagFilter      `json:"tagFilterExpression"`
+	Threshold           *int            `json:"threshold,omitempty"`
+	BoundaryScope       *string         `json:"boundaryScope,omitempty"`
+	IncludeInternal     *bool           `json:"includeInternal,omitempty"`
+	IncludeSynthetic    *bool           `json:"includeSynthetic,omitempty"`
+	BeaconType          *string         `json:"beaconType,omitempty"`
+}
+
+// ApdexConfig represents the REST resource of apdex configuration at Instana
+type ApdexConfig struct {
+	ID          string      `json:"id,omitempty"`
+	Name        string      `json:"apdexName"`
+	ApdexEntity ApdexEntity `json:"apdexEntity"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ApdexConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// ApdexReport represents the REST resource of the apdex report of an apdex configuration at Instana. The apdex score
+// is provided as list of tuples of timestamp and score
+type ApdexReport struct {
+	ApdexID    string      `json:"apdexId"`
+	ApdexScore [][]float64 `json:"apdexScore"`
+	From       int64       `json:"from"`
+	To         int64       `json:"to"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *ApdexReport) GetIDForResourcePath() string {
+	return r.ApdexID
+}
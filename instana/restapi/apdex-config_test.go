@@ -0,0 +1,13 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnSupportedApdexEntityTypesAsStringSlice(t *testing.T) {
+	expected := []string{"application", "website"}
+	require.Equal(t, expected, SupportedApdexEntityTypes.ToStringSlice())
+}
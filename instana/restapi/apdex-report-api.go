@@ -0,0 +1,52 @@
+package restapi
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	apdexReportQueryParamFrom = "from"
+	apdexReportQueryParamTo   = "to"
+)
+
+// ApdexReportResource interface definition of the resource of the Instana API to retrieve the report of an apdex configuration for a given time frame
+type ApdexReportResource interface {
+	GetReport(apdexID string, from int64, to int64) (*ApdexReport, error)
+}
+
+// NewApdexReportResource creates a new ApdexReportResource. The Instana API returns the report of an apdex configuration as an array of reports. Therefore, the report matching the requested apdex ID is selected from the response
+func NewApdexReportResource(client RestClient) ApdexReportResource {
+	return &apdexReportResource{
+		resourcePath: ApdexReportResourcePath,
+		unmarshaller: NewDefaultJSONUnmarshaller(&ApdexReport{}),
+		client:       client,
+	}
+}
+
+type apdexReportResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*ApdexReport]
+	client       RestClient
+}
+
+func (r *apdexReportResource) GetReport(apdexID string, from int64, to int64) (*ApdexReport, error) {
+	queryParams := map[string]string{
+		apdexReportQueryParamFrom: strconv.FormatInt(from, 10),
+		apdexReportQueryParamTo:   strconv.FormatInt(to, 10),
+	}
+	data, err := r.client.GetByQuery(fmt.Sprintf("%s/%s", r.resourcePath, apdexID), queryParams)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, report := range *reports {
+		if report.ApdexID == apdexID {
+			return report, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
@@ -0,0 +1,83 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	apdexReportID        = "apdex-id"
+	apdexReportFrom      = int64(1700000000000)
+	apdexReportTo        = int64(1700000060000)
+	apdexReportPath      = ApdexReportResourcePath + "/" + apdexReportID
+	apdexReportQueryFrom = "1700000000000"
+	apdexReportQueryTo   = "1700000060000"
+)
+
+func TestApdexReportResource(t *testing.T) {
+	t.Run("should request report for the given time frame and return report with matching apdex id", func(t *testing.T) {
+		response := []byte(`[
+			{ "apdexId": "other-id", "apdexScore": [ [ 1700000000000, 0.5 ] ], "from": 1700000000000, "to": 1700000060000 },
+			{ "apdexId": "apdex-id", "apdexScore": [ [ 1700000000000, 0.75 ], [ 1700000060000, 0.9 ] ], "from": 1700000000000, "to": 1700000060000 }
+		]`)
+		sut := createApdexReportResourceWithResponse(t, response, nil)
+
+		result, err := sut.GetReport(apdexReportID, apdexReportFrom, apdexReportTo)
+
+		require.NoError(t, err)
+		require.Equal(t, &ApdexReport{
+			ApdexID:    apdexReportID,
+			ApdexScore: [][]float64{{1700000000000, 0.75}, {1700000060000, 0.9}},
+			From:       apdexReportFrom,
+			To:         apdexReportTo,
+		}, result)
+	})
+
+	t.Run("should return not found error when no report matches the apdex id", func(t *testing.T) {
+		response := []byte(`[ { "apdexId": "other-id", "apdexScore": [], "from": 1700000000000, "to": 1700000060000 } ]`)
+		sut := createApdexReportResourceWithResponse(t, response, nil)
+
+		_, err := sut.GetReport(apdexReportID, apdexReportFrom, apdexReportTo)
+
+		require.ErrorIs(t, err, ErrEntityNotFound)
+	})
+
+	t.Run("should return not found error when empty array is returned", func(t *testing.T) {
+		sut := createApdexReportResourceWithResponse(t, []byte(`[]`), nil)
+
+		_, err := sut.GetReport(apdexReportID, apdexReportFrom, apdexReportTo)
+
+		require.ErrorIs(t, err, ErrEntityNotFound)
+	})
+
+	t.Run("should return error when response is not an array", func(t *testing.T) {
+		sut := createApdexReportResourceWithResponse(t, []byte(`{ "apdexId": "apdex-id" }`), nil)
+
+		_, err := sut.GetReport(apdexReportID, apdexReportFrom, apdexReportTo)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to parse json")
+	})
+
+	t.Run("should return error when client fails", func(t *testing.T) {
+		expectedError := errors.New("test")
+		sut := createApdexReportResourceWithResponse(t, nil, expectedError)
+
+		_, err := sut.GetReport(apdexReportID, apdexReportFrom, apdexReportTo)
+
+		require.ErrorIs(t, err, expectedError)
+	})
+}
+
+func createApdexReportResourceWithResponse(t *testing.T, response []byte, clientError error) ApdexReportResource {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetByQuery(apdexReportPath, map[string]string{"from": apdexReportQueryFrom, "to": apdexReportQueryTo}).Times(1).Return(response, clientError)
+	return NewApdexReportResource(client)
+}
@@ -13,6 +13,7 @@ type ApplicationAlertConfig struct {
 	Description           string                         `json:"description"`
 	Severity              int                            `json:"severity"`
 	Triggering            bool                           `json:"triggering"`
+	Enabled               bool                           `json:"enabled"`
 	Applications          map[string]IncludedApplication `json:"applications"`
 	BoundaryScope         BoundaryScope                  `json:"boundaryScope"`
 	TagFilterExpression   *TagFilter                     `json:"tagFilterExpression"`
@@ -25,6 +26,7 @@ type ApplicationAlertConfig struct {
 	Rule                  ApplicationAlertRule           `json:"rule"`
 	Threshold             Threshold                      `json:"threshold"`
 	TimeThreshold         TimeThreshold                  `json:"timeThreshold"`
+	UpdateBaseline        bool                           `json:"-"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
@@ -32,6 +34,16 @@ func (a *ApplicationAlertConfig) GetIDForResourcePath() string {
 	return a.ID
 }
 
+// IsEnabled implementation of the interface EnabledAware
+func (a *ApplicationAlertConfig) IsEnabled() bool {
+	return a.Enabled
+}
+
+// IsBaselineUpdateRequested implementation of the interface BaselineUpdateAware
+func (a *ApplicationAlertConfig) IsBaselineUpdateRequested() bool {
+	return a.UpdateBaseline
+}
+
 // GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
 func (a *ApplicationAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
 	return a.CustomerPayloadFields
@@ -0,0 +1,126 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	//ApplicationsResourcePath path to the applications discovered by application monitoring of Instana RESTful API
+	ApplicationsResourcePath = ApplicationMonitoringBasePath + "/applications"
+	//ServicesResourcePath path to the services discovered by application monitoring of Instana RESTful API
+	ServicesResourcePath = ApplicationMonitoringBasePath + "/services"
+	//EndpointsResourcePath path to the endpoints discovered by application monitoring of Instana RESTful API
+	EndpointsResourcePath = ApplicationsResourcePath + "/services/endpoints"
+
+	//DiscoveryPageSize the number of objects requested per page from the discovery resources
+	DiscoveryPageSize = 200
+
+	discoveryQueryParamNameFilter = "nameFilter"
+	discoveryQueryParamWindowSize = "windowSize"
+	discoveryQueryParamPage       = "page"
+	discoveryQueryParamPageSize   = "pageSize"
+)
+
+// Application data structure for the Instana API model of an application discovered by application monitoring
+type Application struct {
+	ID            string `json:"id"`
+	Label         string `json:"label"`
+	BoundaryScope string `json:"boundaryScope"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject for Application
+func (a *Application) GetIDForResourcePath() string {
+	return a.ID
+}
+
+// Service data structure for the Instana API model of a service discovered by application monitoring
+type Service struct {
+	ID           string   `json:"id"`
+	Label        string   `json:"label"`
+	Types        []string `json:"types"`
+	Technologies []string `json:"technologies"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject for Service
+func (s *Service) GetIDForResourcePath() string {
+	return s.ID
+}
+
+// Endpoint data structure for the Instana API model of an endpoint discovered by application monitoring
+type Endpoint struct {
+	ID           string   `json:"id"`
+	Label        string   `json:"label"`
+	ServiceID    string   `json:"serviceId"`
+	Type         string   `json:"type"`
+	Technologies []string `json:"technologies"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject for Endpoint
+func (e *Endpoint) GetIDForResourcePath() string {
+	return e.ID
+}
+
+// DiscoveryQuery the optional query parameters to discover applications, services and endpoints
+type DiscoveryQuery struct {
+	//NameFilter restricts the result to objects whose name contains the given value
+	NameFilter string
+	//WindowSize the size of the time window in milliseconds in which the objects must have been seen. The default of the Instana API is used when not set
+	WindowSize int64
+}
+
+// DiscoveryResource interface definition of the resources of the Instana API to discover applications, services and endpoints
+type DiscoveryResource[T InstanaDataObject] interface {
+	GetAll(query DiscoveryQuery) (*[]T, error)
+}
+
+type discoveryPage[T InstanaDataObject] struct {
+	Items     []T `json:"items"`
+	TotalHits int `json:"totalHits"`
+}
+
+// NewDiscoveryResource creates a new DiscoveryResource for the given resource path. All pages of the result are requested
+func NewDiscoveryResource[T InstanaDataObject](resourcePath string, client RestClient) DiscoveryResource[T] {
+	return &discoveryResource[T]{
+		resourcePath: resourcePath,
+		client:       client,
+	}
+}
+
+type discoveryResource[T InstanaDataObject] struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (r *discoveryResource[T]) GetAll(query DiscoveryQuery) (*[]T, error) {
+	result := make([]T, 0)
+	for page := 1; ; page++ {
+		data, err := r.client.GetByQuery(r.resourcePath, r.createQueryParameters(query, page))
+		if err != nil {
+			return nil, err
+		}
+		currentPage := &discoveryPage[T]{}
+		if err = json.Unmarshal(data, currentPage); err != nil {
+			return nil, fmt.Errorf("failed to parse json; %s", err)
+		}
+		result = append(result, currentPage.Items...)
+		if len(currentPage.Items) == 0 || len(result) >= currentPage.TotalHits {
+			return &result, nil
+		}
+	}
+}
+
+func (r *discoveryResource[T]) createQueryParameters(query DiscoveryQuery, page int) map[string]string {
+	queryParams := map[string]string{
+		discoveryQueryParamPage:     strconv.Itoa(page),
+		discoveryQueryParamPageSize: strconv.Itoa(DiscoveryPageSize),
+	}
+	if len(query.NameFilter) > 0 {
+		queryParams[discoveryQueryParamNameFilter] = query.NameFilter
+	}
+	if query.WindowSize > 0 {
+		queryParams[discoveryQueryParamWindowSize] = strconv.FormatInt(query.WindowSize, 10)
+	}
+	return queryParams
+}
@@ -0,0 +1,106 @@
+package restapi_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestShouldReturnIDOfDiscoveredObjects(t *testing.T) {
+	require.Equal(t, "application-id", (&Application{ID: "application-id"}).GetIDForResourcePath())
+	require.Equal(t, "service-id", (&Service{ID: "service-id"}).GetIDForResourcePath())
+	require.Equal(t, "endpoint-id", (&Endpoint{ID: "endpoint-id"}).GetIDForResourcePath())
+}
+
+func TestShouldGetAllPagesOfDiscoveryResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetByQuery(ServicesResourcePath, createDiscoveryQueryParameters(1)).Times(1).Return([]byte(`{"items":[{"id":"service-id-1","label":"shop","types":["HTTP"],"technologies":["java"]}],"page":1,"pageSize":1,"totalHits":2}`), nil)
+	client.EXPECT().GetByQuery(ServicesResourcePath, createDiscoveryQueryParameters(2)).Times(1).Return([]byte(`{"items":[{"id":"service-id-2","label":"shop-db","types":["DATABASE"],"technologies":["postgreSqlDatabase"]}],"page":2,"pageSize":1,"totalHits":2}`), nil)
+
+	sut := NewDiscoveryResource[*Service](ServicesResourcePath, client)
+
+	result, err := sut.GetAll(DiscoveryQuery{NameFilter: "shop", WindowSize: 3600000})
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*Service{
+		{ID: "service-id-1", Label: "shop", Types: []string{"HTTP"}, Technologies: []string{"java"}},
+		{ID: "service-id-2", Label: "shop-db", Types: []string{"DATABASE"}, Technologies: []string{"postgreSqlDatabase"}},
+	}, result)
+}
+
+func TestShouldOmitOptionalQueryParametersOfDiscoveryResourceWhenNotProvided(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetByQuery(ApplicationsResourcePath, map[string]string{"page": "1", "pageSize": strconv.Itoa(DiscoveryPageSize)}).Times(1).Return([]byte(`{"items":[{"id":"application-id","label":"shop","boundaryScope":"INBOUND","entityType":"APPLICATION"}],"page":1,"pageSize":200,"totalHits":1}`), nil)
+
+	sut := NewDiscoveryResource[*Application](ApplicationsResourcePath, client)
+
+	result, err := sut.GetAll(DiscoveryQuery{})
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*Application{{ID: "application-id", Label: "shop", BoundaryScope: "INBOUND"}}, result)
+}
+
+func TestShouldStopPagingOfDiscoveryResourceWhenEmptyPageIsReturned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetByQuery(EndpointsResourcePath, gomock.Any()).Times(1).Return([]byte(`{"items":[],"page":1,"pageSize":200,"totalHits":5}`), nil)
+
+	sut := NewDiscoveryResource[*Endpoint](EndpointsResourcePath, client)
+
+	result, err := sut.GetAll(DiscoveryQuery{})
+
+	require.NoError(t, err)
+	require.Empty(t, *result)
+}
+
+func TestShouldFailToGetAllOfDiscoveryResourceWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetByQuery(EndpointsResourcePath, gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := NewDiscoveryResource[*Endpoint](EndpointsResourcePath, client)
+
+	_, err := sut.GetAll(DiscoveryQuery{})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetAllOfDiscoveryResourceWhenResponseIsNotValidJson(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetByQuery(EndpointsResourcePath, gomock.Any()).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewDiscoveryResource[*Endpoint](EndpointsResourcePath, client)
+
+	_, err := sut.GetAll(DiscoveryQuery{})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to parse json")
+}
+
+func createDiscoveryQueryParameters(page int) map[string]string {
+	return map[string]string{
+		"nameFilter": "shop",
+		"windowSize": "3600000",
+		"page":       strconv.Itoa(page),
+		"pageSize":   strconv.Itoa(DiscoveryPageSize),
+	}
+}
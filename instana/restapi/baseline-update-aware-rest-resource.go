@@ -0,0 +1,62 @@
+package restapi
+
+// UpdateBaselineSubResourcePath the sub resource path of the Instana API to trigger the recalculation of the historic baseline of an alert configuration
+const UpdateBaselineSubResourcePath = "update-baseline"
+
+// BaselineUpdateAware interface definition of data objects which support the recalculation of the historic baseline through the dedicated update-baseline sub resource of the Instana API
+type BaselineUpdateAware interface {
+	IsBaselineUpdateRequested() bool
+}
+
+type baselineUpdateAwareInstanaDataObject interface {
+	BaselineUpdateAware
+	InstanaDataObject
+}
+
+// NewBaselineUpdateAwareRestResource creates a new REST resource which decorates the provided REST resource. After update the recalculation of the historic baseline is triggered using HTTP POST on the update-baseline sub resource when it is requested by the provided data object
+func NewBaselineUpdateAwareRestResource[T baselineUpdateAwareInstanaDataObject](delegate RestResource[T], resourcePath string, client RestClient) RestResource[T] {
+	return &baselineUpdateAwareRestResource[T]{
+		delegate:     delegate,
+		resourcePath: resourcePath,
+		client:       client,
+	}
+}
+
+type baselineUpdateAwareRestResource[T baselineUpdateAwareInstanaDataObject] struct {
+	delegate     RestResource[T]
+	resourcePath string
+	client       RestClient
+}
+
+func (r *baselineUpdateAwareRestResource[T]) GetAll() (*[]T, error) {
+	return r.delegate.GetAll()
+}
+
+func (r *baselineUpdateAwareRestResource[T]) GetOne(id string) (T, error) {
+	return r.delegate.GetOne(id)
+}
+
+func (r *baselineUpdateAwareRestResource[T]) Create(data T) (T, error) {
+	return r.delegate.Create(data)
+}
+
+func (r *baselineUpdateAwareRestResource[T]) Update(data T) (T, error) {
+	result, err := r.delegate.Update(data)
+	if err != nil || !data.IsBaselineUpdateRequested() {
+		return result, err
+	}
+
+	_, err = r.client.PostSubResource(r.resourcePath, result.GetIDForResourcePath(), UpdateBaselineSubResourcePath)
+	if err != nil {
+		return result, err
+	}
+	return r.delegate.GetOne(result.GetIDForResourcePath())
+}
+
+func (r *baselineUpdateAwareRestResource[T]) Delete(data T) error {
+	return r.delegate.Delete(data)
+}
+
+func (r *baselineUpdateAwareRestResource[T]) DeleteByID(id string) error {
+	return r.delegate.DeleteByID(id)
+}
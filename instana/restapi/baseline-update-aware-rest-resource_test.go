@@ -0,0 +1,158 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const baselineUpdateAwareTestID = "test-id"
+
+func TestShouldDelegateGetAllOfBaselineUpdateAwareRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedResult := &[]*ApplicationAlertConfig{{ID: baselineUpdateAwareTestID}}
+	delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	delegate.EXPECT().GetAll().Times(1).Return(expectedResult, nil)
+
+	sut := NewBaselineUpdateAwareRestResource[*ApplicationAlertConfig](delegate, ApplicationAlertConfigsResourcePath, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResult, result)
+}
+
+func TestShouldDelegateGetOneOfBaselineUpdateAwareRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedResult := &ApplicationAlertConfig{ID: baselineUpdateAwareTestID}
+	delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	delegate.EXPECT().GetOne(baselineUpdateAwareTestID).Times(1).Return(expectedResult, nil)
+
+	sut := NewBaselineUpdateAwareRestResource[*ApplicationAlertConfig](delegate, ApplicationAlertConfigsResourcePath, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetOne(baselineUpdateAwareTestID)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResult, result)
+}
+
+func TestShouldNotUpdateBaselineOnCreateOfBaselineUpdateAwareRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &ApplicationAlertConfig{Name: "test", UpdateBaseline: true}
+	created := &ApplicationAlertConfig{ID: baselineUpdateAwareTestID, Name: "test"}
+	delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	delegate.EXPECT().Create(data).Times(1).Return(created, nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostSubResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewBaselineUpdateAwareRestResource[*ApplicationAlertConfig](delegate, ApplicationAlertConfigsResourcePath, client)
+
+	result, err := sut.Create(data)
+
+	require.NoError(t, err)
+	require.Equal(t, created, result)
+}
+
+func TestShouldNotUpdateBaselineOnUpdateWhenBaselineUpdateIsNotRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &ApplicationAlertConfig{ID: baselineUpdateAwareTestID, Name: "test"}
+	delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	delegate.EXPECT().Update(data).Times(1).Return(data, nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostSubResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewBaselineUpdateAwareRestResource[*ApplicationAlertConfig](delegate, ApplicationAlertConfigsResourcePath, client)
+
+	result, err := sut.Update(data)
+
+	require.NoError(t, err)
+	require.Equal(t, data, result)
+}
+
+func TestShouldUpdateBaselineOnUpdateWhenBaselineUpdateIsRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	lastUpdated := int64(1000)
+	data := &ApplicationAlertConfig{ID: baselineUpdateAwareTestID, Name: "test", UpdateBaseline: true}
+	updated := &ApplicationAlertConfig{ID: baselineUpdateAwareTestID, Name: "test"}
+	expectedResult := &ApplicationAlertConfig{ID: baselineUpdateAwareTestID, Name: "test", Threshold: Threshold{LastUpdated: &lastUpdated}}
+	delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		delegate.EXPECT().Update(data).Times(1).Return(updated, nil),
+		client.EXPECT().PostSubResource(ApplicationAlertConfigsResourcePath, baselineUpdateAwareTestID, UpdateBaselineSubResourcePath).Times(1).Return(nil, nil),
+		delegate.EXPECT().GetOne(baselineUpdateAwareTestID).Times(1).Return(expectedResult, nil),
+	)
+
+	sut := NewBaselineUpdateAwareRestResource[*ApplicationAlertConfig](delegate, ApplicationAlertConfigsResourcePath, client)
+
+	result, err := sut.Update(data)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResult, result)
+}
+
+func TestShouldReturnErrorOfDelegateOnUpdateOfBaselineUpdateAwareRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	data := &ApplicationAlertConfig{ID: baselineUpdateAwareTestID, UpdateBaseline: true}
+	delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	delegate.EXPECT().Update(data).Times(1).Return(nil, expectedError)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostSubResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewBaselineUpdateAwareRestResource[*ApplicationAlertConfig](delegate, ApplicationAlertConfigsResourcePath, client)
+
+	_, err := sut.Update(data)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldReturnErrorWhenBaselineCannotBeUpdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	data := &ApplicationAlertConfig{ID: baselineUpdateAwareTestID, UpdateBaseline: true}
+	delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	delegate.EXPECT().Update(data).Times(1).Return(data, nil)
+	delegate.EXPECT().GetOne(gomock.Any()).Times(0)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostSubResource(ApplicationAlertConfigsResourcePath, baselineUpdateAwareTestID, UpdateBaselineSubResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewBaselineUpdateAwareRestResource[*ApplicationAlertConfig](delegate, ApplicationAlertConfigsResourcePath, client)
+
+	_, err := sut.Update(data)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldDelegateDeleteOfBaselineUpdateAwareRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &ApplicationAlertConfig{ID: baselineUpdateAwareTestID}
+	delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	delegate.EXPECT().Delete(data).Times(1).Return(nil)
+	delegate.EXPECT().DeleteByID(baselineUpdateAwareTestID).Times(1).Return(nil)
+
+	sut := NewBaselineUpdateAwareRestResource[*ApplicationAlertConfig](delegate, ApplicationAlertConfigsResourcePath, mocks.NewMockRestClient(ctrl))
+
+	require.NoError(t, sut.Delete(data))
+	require.NoError(t, sut.DeleteByID(baselineUpdateAwareTestID))
+}
@@ -0,0 +1,100 @@
+package restapi
+
+// NewBuiltinEventSpecificationStateRestResource creates a new REST resource to manage the enabled flag of builtin event specifications. Builtin event specifications cannot be created or deleted. Therefore, the enabled flag is set using the enable and disable endpoints on create and update and restored to the previous value on delete
+func NewBuiltinEventSpecificationStateRestResource(client RestClient) RestResource[*BuiltinEventSpecificationState] {
+	return &builtinEventSpecificationStateRestResource{
+		resourcePath: BuiltinEventSpecificationResourcePath,
+		unmarshaller: NewDefaultJSONUnmarshaller(&BuiltinEventSpecification{}),
+		client:       client,
+	}
+}
+
+type builtinEventSpecificationStateRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*BuiltinEventSpecification]
+	client       RestClient
+}
+
+func (r *builtinEventSpecificationStateRestResource) GetAll() (*[]*BuiltinEventSpecificationState, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	specs, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	states := make([]*BuiltinEventSpecificationState, len(*specs))
+	for i, spec := range *specs {
+		states[i] = &BuiltinEventSpecificationState{ID: spec.ID, Enabled: spec.Enabled}
+	}
+	return &states, nil
+}
+
+func (r *builtinEventSpecificationStateRestResource) GetOne(id string) (*BuiltinEventSpecificationState, error) {
+	spec, err := r.getSpecification(id)
+	if err != nil {
+		return nil, err
+	}
+	return &BuiltinEventSpecificationState{ID: spec.ID, Enabled: spec.Enabled}, nil
+}
+
+func (r *builtinEventSpecificationStateRestResource) getSpecification(id string) (*BuiltinEventSpecification, error) {
+	data, err := r.client.GetOne(id, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.Unmarshal(data)
+}
+
+func (r *builtinEventSpecificationStateRestResource) Create(data *BuiltinEventSpecificationState) (*BuiltinEventSpecificationState, error) {
+	spec, err := r.getSpecification(data.ID)
+	if err != nil {
+		return data, err
+	}
+	previousEnabled := spec.Enabled
+	result, err := r.setEnabled(data.ID, data.Enabled)
+	if err != nil {
+		return data, err
+	}
+	result.PreviousEnabled = &previousEnabled
+	return result, nil
+}
+
+func (r *builtinEventSpecificationStateRestResource) Update(data *BuiltinEventSpecificationState) (*BuiltinEventSpecificationState, error) {
+	result, err := r.setEnabled(data.ID, data.Enabled)
+	if err != nil {
+		return data, err
+	}
+	result.PreviousEnabled = data.PreviousEnabled
+	return result, nil
+}
+
+func (r *builtinEventSpecificationStateRestResource) setEnabled(id string, enabled bool) (*BuiltinEventSpecificationState, error) {
+	subResourcePath := DisableSubResourcePath
+	if enabled {
+		subResourcePath = EnableSubResourcePath
+	}
+	data, err := r.client.PostSubResource(r.resourcePath, id, subResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &BuiltinEventSpecificationState{ID: spec.ID, Enabled: spec.Enabled}, nil
+}
+
+func (r *builtinEventSpecificationStateRestResource) Delete(data *BuiltinEventSpecificationState) error {
+	if data.PreviousEnabled == nil {
+		return nil
+	}
+	_, err := r.setEnabled(data.ID, *data.PreviousEnabled)
+	return err
+}
+
+// DeleteByID does not change the builtin event specification as the previous state is not known
+func (r *builtinEventSpecificationStateRestResource) DeleteByID(_ string) error {
+	return nil
+}
@@ -0,0 +1,204 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const builtinEventSpecificationID = "builtin-event-id"
+
+var builtinEventSpecificationEnabledResponse = []byte(`{"id":"builtin-event-id","name":"builtin-event","enabled":true}`)
+var builtinEventSpecificationDisabledResponse = []byte(`{"id":"builtin-event-id","name":"builtin-event","enabled":false}`)
+
+func TestShouldGetAllBuiltinEventSpecificationStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(BuiltinEventSpecificationResourcePath).Times(1).Return([]byte(`[{"id":"a","enabled":true},{"id":"b","enabled":false}]`), nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*BuiltinEventSpecificationState{{ID: "a", Enabled: true}, {ID: "b", Enabled: false}}, result)
+}
+
+func TestShouldFailToGetAllBuiltinEventSpecificationStatesWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(BuiltinEventSpecificationResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	_, err := sut.GetAll()
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldGetOneBuiltinEventSpecificationState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(builtinEventSpecificationID, BuiltinEventSpecificationResourcePath).Times(1).Return(builtinEventSpecificationDisabledResponse, nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	result, err := sut.GetOne(builtinEventSpecificationID)
+
+	require.NoError(t, err)
+	require.Equal(t, &BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: false}, result)
+}
+
+func TestShouldFailToGetOneBuiltinEventSpecificationStateWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(builtinEventSpecificationID, BuiltinEventSpecificationResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	_, err := sut.GetOne(builtinEventSpecificationID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldDisableBuiltinEventSpecificationOnCreateAndKeepPreviousState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().GetOne(builtinEventSpecificationID, BuiltinEventSpecificationResourcePath).Times(1).Return(builtinEventSpecificationEnabledResponse, nil),
+		client.EXPECT().PostSubResource(BuiltinEventSpecificationResourcePath, builtinEventSpecificationID, "disable").Times(1).Return(builtinEventSpecificationDisabledResponse, nil),
+	)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	result, err := sut.Create(&BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: false})
+
+	require.NoError(t, err)
+	previousEnabled := true
+	require.Equal(t, &BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: false, PreviousEnabled: &previousEnabled}, result)
+}
+
+func TestShouldFailToCreateBuiltinEventSpecificationStateWhenSpecificationCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(builtinEventSpecificationID, BuiltinEventSpecificationResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().PostSubResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	_, err := sut.Create(&BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: false})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToCreateBuiltinEventSpecificationStateWhenEnabledFlagCannotBeSet(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(builtinEventSpecificationID, BuiltinEventSpecificationResourcePath).Times(1).Return(builtinEventSpecificationEnabledResponse, nil)
+	client.EXPECT().PostSubResource(BuiltinEventSpecificationResourcePath, builtinEventSpecificationID, "disable").Times(1).Return(nil, expectedError)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	_, err := sut.Create(&BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: false})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldEnableBuiltinEventSpecificationOnUpdateAndRetainPreviousState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostSubResource(BuiltinEventSpecificationResourcePath, builtinEventSpecificationID, "enable").Times(1).Return(builtinEventSpecificationEnabledResponse, nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	previousEnabled := false
+	result, err := sut.Update(&BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: true, PreviousEnabled: &previousEnabled})
+
+	require.NoError(t, err)
+	require.Equal(t, &BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: true, PreviousEnabled: &previousEnabled}, result)
+}
+
+func TestShouldFailToUpdateBuiltinEventSpecificationStateWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostSubResource(BuiltinEventSpecificationResourcePath, builtinEventSpecificationID, "enable").Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	_, err := sut.Update(&BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: true})
+
+	require.Error(t, err)
+}
+
+func TestShouldRestorePreviousStateOfBuiltinEventSpecificationOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostSubResource(BuiltinEventSpecificationResourcePath, builtinEventSpecificationID, "enable").Times(1).Return(builtinEventSpecificationEnabledResponse, nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	previousEnabled := true
+	err := sut.Delete(&BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: false, PreviousEnabled: &previousEnabled})
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailToRestorePreviousStateOfBuiltinEventSpecificationOnDeleteWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostSubResource(BuiltinEventSpecificationResourcePath, builtinEventSpecificationID, "disable").Times(1).Return(nil, expectedError)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	previousEnabled := false
+	err := sut.Delete(&BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: true, PreviousEnabled: &previousEnabled})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldNotChangeBuiltinEventSpecificationOnDeleteWhenPreviousStateIsNotKnown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostSubResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(client)
+
+	require.NoError(t, sut.Delete(&BuiltinEventSpecificationState{ID: builtinEventSpecificationID, Enabled: false}))
+	require.NoError(t, sut.DeleteByID(builtinEventSpecificationID))
+}
@@ -0,0 +1,15 @@
+package restapi
+
+// BuiltinEventSpecificationState is the representation of the enabled flag of a builtin event specification in Instana.
+// PreviousEnabled holds the enabled flag of the builtin event specification before it was managed by the provider. It is
+// only known when the state was created by the provider and nil otherwise
+type BuiltinEventSpecificationState struct {
+	ID              string
+	Enabled         bool
+	PreviousEnabled *bool
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (s *BuiltinEventSpecificationState) GetIDForResourcePath() string {
+	return s.ID
+}
@@ -0,0 +1,50 @@
+package restapi
+
+const (
+	catalogPathElement = "/catalog"
+	tagsPathElement    = "/tags"
+	metricsPathElement = "/metrics"
+
+	//ApplicationCatalogTagsResourcePath path to the tag catalog of application monitoring of Instana RESTful API
+	ApplicationCatalogTagsResourcePath = ApplicationMonitoringBasePath + catalogPathElement + tagsPathElement
+	//ApplicationCatalogMetricsResourcePath path to the metric catalog of application monitoring of Instana RESTful API
+	ApplicationCatalogMetricsResourcePath = ApplicationMonitoringBasePath + catalogPathElement + metricsPathElement
+	//WebsiteCatalogTagsResourcePath path to the tag catalog of website monitoring of Instana RESTful API
+	WebsiteCatalogTagsResourcePath = WebsiteMonitoringResourcePath + catalogPathElement + tagsPathElement
+	//WebsiteCatalogMetricsResourcePath path to the metric catalog of website monitoring of Instana RESTful API
+	WebsiteCatalogMetricsResourcePath = WebsiteMonitoringResourcePath + catalogPathElement + metricsPathElement
+	//MobileAppCatalogTagsResourcePath path to the tag catalog of mobile app monitoring of Instana RESTful API
+	MobileAppCatalogTagsResourcePath = MobileAppMonitoringResourcePath + catalogPathElement + tagsPathElement
+	//MobileAppCatalogMetricsResourcePath path to the metric catalog of mobile app monitoring of Instana RESTful API
+	MobileAppCatalogMetricsResourcePath = MobileAppMonitoringResourcePath + catalogPathElement + metricsPathElement
+)
+
+// CatalogTag data structure for the Instana API model of a tag of a catalog. Tags are identified by their name
+type CatalogTag struct {
+	Name                  string `json:"name"`
+	Label                 string `json:"label"`
+	Description           string `json:"description"`
+	Type                  string `json:"type"`
+	CanApplyToSource      bool   `json:"canApplyToSource"`
+	CanApplyToDestination bool   `json:"canApplyToDestination"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject for CatalogTag
+func (t *CatalogTag) GetIDForResourcePath() string {
+	return t.Name
+}
+
+// CatalogMetric data structure for the Instana API model of a metric of a catalog. Metrics are identified by their metric id
+type CatalogMetric struct {
+	MetricID           string   `json:"metricId"`
+	Label              string   `json:"label"`
+	Description        string   `json:"description"`
+	Formatter          string   `json:"formatter"`
+	Aggregations       []string `json:"aggregations"`
+	DefaultAggregation *string  `json:"defaultAggregation"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject for CatalogMetric
+func (m *CatalogMetric) GetIDForResourcePath() string {
+	return m.MetricID
+}
@@ -0,0 +1,28 @@
+package restapi
+
+const (
+	//CustomPayloadConfigurationResourcePath path to the global custom payload configuration of Instana RESTful API
+	CustomPayloadConfigurationResourcePath = EventSettingsBasePath + "/custom-payload-configurations"
+	//CustomPayloadConfigurationID the static ID of the global custom payload configuration which exists at most once per tenant
+	CustomPayloadConfigurationID = "custom-payload-configuration"
+)
+
+// CustomPayloadConfiguration is the representation of the global custom payload configuration which is added to all alerts in Instana
+type CustomPayloadConfiguration struct {
+	Fields []CustomPayloadField[any] `json:"fields"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *CustomPayloadConfiguration) GetIDForResourcePath() string {
+	return CustomPayloadConfigurationID
+}
+
+// GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (c *CustomPayloadConfiguration) GetCustomerPayloadFields() []CustomPayloadField[any] {
+	return c.Fields
+}
+
+// SetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (c *CustomPayloadConfiguration) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
+	c.Fields = fields
+}
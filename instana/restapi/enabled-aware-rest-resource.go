@@ -0,0 +1,81 @@
+package restapi
+
+const (
+	//EnableSubResourcePath the sub resource path of the Instana API to enable a configuration
+	EnableSubResourcePath = "enable"
+	//DisableSubResourcePath the sub resource path of the Instana API to disable a configuration
+	DisableSubResourcePath = "disable"
+)
+
+// EnabledAware interface definition of data objects which can be enabled and disabled through the dedicated enable and disable sub resources of the Instana API
+type EnabledAware interface {
+	IsEnabled() bool
+}
+
+type enabledAwareInstanaDataObject interface {
+	EnabledAware
+	InstanaDataObject
+}
+
+// NewEnabledAwareRestResource creates a new REST resource which decorates the provided REST resource. After create and update the enabled flag is applied using HTTP PUT on the enable or disable sub resource when it differs from the flag returned by the Instana API
+func NewEnabledAwareRestResource[T enabledAwareInstanaDataObject](delegate RestResource[T], resourcePath string, client RestClient) RestResource[T] {
+	return &enabledAwareRestResource[T]{
+		delegate:     delegate,
+		resourcePath: resourcePath,
+		client:       client,
+	}
+}
+
+type enabledAwareRestResource[T enabledAwareInstanaDataObject] struct {
+	delegate     RestResource[T]
+	resourcePath string
+	client       RestClient
+}
+
+func (r *enabledAwareRestResource[T]) GetAll() (*[]T, error) {
+	return r.delegate.GetAll()
+}
+
+func (r *enabledAwareRestResource[T]) GetOne(id string) (T, error) {
+	return r.delegate.GetOne(id)
+}
+
+func (r *enabledAwareRestResource[T]) Create(data T) (T, error) {
+	result, err := r.delegate.Create(data)
+	if err != nil {
+		return result, err
+	}
+	return r.applyEnabledFlag(data, result)
+}
+
+func (r *enabledAwareRestResource[T]) Update(data T) (T, error) {
+	result, err := r.delegate.Update(data)
+	if err != nil {
+		return result, err
+	}
+	return r.applyEnabledFlag(data, result)
+}
+
+func (r *enabledAwareRestResource[T]) applyEnabledFlag(data T, result T) (T, error) {
+	if result.IsEnabled() == data.IsEnabled() {
+		return result, nil
+	}
+
+	subResourcePath := DisableSubResourcePath
+	if data.IsEnabled() {
+		subResourcePath = EnableSubResourcePath
+	}
+	_, err := r.client.PutSubResource(r.resourcePath, result.GetIDForResourcePath(), subResourcePath)
+	if err != nil {
+		return result, err
+	}
+	return r.delegate.GetOne(result.GetIDForResourcePath())
+}
+
+func (r *enabledAwareRestResource[T]) Delete(data T) error {
+	return r.delegate.Delete(data)
+}
+
+func (r *enabledAwareRestResource[T]) DeleteByID(id string) error {
+	return r.delegate.DeleteByID(id)
+}
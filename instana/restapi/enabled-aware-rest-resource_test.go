@@ -0,0 +1,162 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const enabledAwareTestID = "test-id"
+
+func TestShouldDelegateGetAllOfEnabledAwareRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedResult := &[]*WebsiteAlertConfig{{ID: enabledAwareTestID}}
+	delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	delegate.EXPECT().GetAll().Times(1).Return(expectedResult, nil)
+
+	sut := NewEnabledAwareRestResource[*WebsiteAlertConfig](delegate, WebsiteAlertConfigResourcePath, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResult, result)
+}
+
+func TestShouldDelegateGetOneOfEnabledAwareRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedResult := &WebsiteAlertConfig{ID: enabledAwareTestID}
+	delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	delegate.EXPECT().GetOne(enabledAwareTestID).Times(1).Return(expectedResult, nil)
+
+	sut := NewEnabledAwareRestResource[*WebsiteAlertConfig](delegate, WebsiteAlertConfigResourcePath, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetOne(enabledAwareTestID)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResult, result)
+}
+
+func TestShouldNotCallEnableOrDisableSubResourceOnCreateWhenEnabledFlagIsAlreadyApplied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &WebsiteAlertConfig{ID: enabledAwareTestID, Enabled: true}
+	delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	delegate.EXPECT().Create(data).Times(1).Return(data, nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutSubResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewEnabledAwareRestResource[*WebsiteAlertConfig](delegate, WebsiteAlertConfigResourcePath, client)
+
+	result, err := sut.Create(data)
+
+	require.NoError(t, err)
+	require.Equal(t, data, result)
+}
+
+func TestShouldDisableConfigOnCreateWhenEnabledFlagIsNotApplied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &WebsiteAlertConfig{Name: "test", Enabled: false}
+	created := &WebsiteAlertConfig{ID: enabledAwareTestID, Name: "test", Enabled: true}
+	expectedResult := &WebsiteAlertConfig{ID: enabledAwareTestID, Name: "test", Enabled: false}
+	delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		delegate.EXPECT().Create(data).Times(1).Return(created, nil),
+		client.EXPECT().PutSubResource(WebsiteAlertConfigResourcePath, enabledAwareTestID, DisableSubResourcePath).Times(1).Return(nil, nil),
+		delegate.EXPECT().GetOne(enabledAwareTestID).Times(1).Return(expectedResult, nil),
+	)
+
+	sut := NewEnabledAwareRestResource[*WebsiteAlertConfig](delegate, WebsiteAlertConfigResourcePath, client)
+
+	result, err := sut.Create(data)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResult, result)
+}
+
+func TestShouldEnableConfigOnUpdateWhenEnabledFlagIsNotApplied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &WebsiteAlertConfig{ID: enabledAwareTestID, Enabled: true}
+	updated := &WebsiteAlertConfig{ID: enabledAwareTestID, Enabled: false}
+	expectedResult := &WebsiteAlertConfig{ID: enabledAwareTestID, Enabled: true}
+	delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		delegate.EXPECT().Update(data).Times(1).Return(updated, nil),
+		client.EXPECT().PutSubResource(WebsiteAlertConfigResourcePath, enabledAwareTestID, EnableSubResourcePath).Times(1).Return(nil, nil),
+		delegate.EXPECT().GetOne(enabledAwareTestID).Times(1).Return(expectedResult, nil),
+	)
+
+	sut := NewEnabledAwareRestResource[*WebsiteAlertConfig](delegate, WebsiteAlertConfigResourcePath, client)
+
+	result, err := sut.Update(data)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResult, result)
+}
+
+func TestShouldReturnErrorOfDelegateOnUpdateOfEnabledAwareRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	data := &WebsiteAlertConfig{ID: enabledAwareTestID, Enabled: true}
+	delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	delegate.EXPECT().Update(data).Times(1).Return(data, expectedError)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutSubResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewEnabledAwareRestResource[*WebsiteAlertConfig](delegate, WebsiteAlertConfigResourcePath, client)
+
+	_, err := sut.Update(data)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldReturnErrorWhenEnabledFlagCannotBeAppliedOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	data := &WebsiteAlertConfig{Enabled: false}
+	created := &WebsiteAlertConfig{ID: enabledAwareTestID, Enabled: true}
+	delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	delegate.EXPECT().Create(data).Times(1).Return(created, nil)
+	delegate.EXPECT().GetOne(gomock.Any()).Times(0)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutSubResource(WebsiteAlertConfigResourcePath, enabledAwareTestID, DisableSubResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewEnabledAwareRestResource[*WebsiteAlertConfig](delegate, WebsiteAlertConfigResourcePath, client)
+
+	_, err := sut.Create(data)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldDelegateDeleteOfEnabledAwareRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &WebsiteAlertConfig{ID: enabledAwareTestID}
+	delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	delegate.EXPECT().Delete(data).Times(1).Return(nil)
+	delegate.EXPECT().DeleteByID(enabledAwareTestID).Times(1).Return(nil)
+
+	sut := NewEnabledAwareRestResource[*WebsiteAlertConfig](delegate, WebsiteAlertConfigResourcePath, mocks.NewMockRestClient(ctrl))
+
+	require.NoError(t, sut.Delete(data))
+	require.NoError(t, sut.DeleteByID(enabledAwareTestID))
+}
@@ -0,0 +1,75 @@
+package restapi
+
+// GeoLocationConfigSubResourcePath path of the geo location configuration sub resource of websites and mobile apps
+const GeoLocationConfigSubResourcePath = "geo-location"
+
+// GeoDetailRemoval custom type for the removal of geo location details of websites and mobile apps
+type GeoDetailRemoval string
+
+// GeoDetailRemovals custom type for a slice of GeoDetailRemoval
+type GeoDetailRemovals []GeoDetailRemoval
+
+// ToStringSlice Returns the corresponding string representations
+func (removals GeoDetailRemovals) ToStringSlice() []string {
+	result := make([]string, len(removals))
+	for i, v := range removals {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//GeoDetailRemovalNoRemoval constant value for the geo detail removal NO_REMOVAL
+	GeoDetailRemovalNoRemoval = GeoDetailRemoval("NO_REMOVAL")
+	//GeoDetailRemovalRemoveCoordinates constant value for the geo detail removal REMOVE_COORDINATES
+	GeoDetailRemovalRemoveCoordinates = GeoDetailRemoval("REMOVE_COORDINATES")
+	//GeoDetailRemovalRemoveCity constant value for the geo detail removal REMOVE_CITY
+	GeoDetailRemovalRemoveCity = GeoDetailRemoval("REMOVE_CITY")
+	//GeoDetailRemovalRemoveAll constant value for the geo detail removal REMOVE_ALL
+	GeoDetailRemovalRemoveAll = GeoDetailRemoval("REMOVE_ALL")
+)
+
+// SupportedGeoDetailRemovals list of all supported GeoDetailRemoval
+var SupportedGeoDetailRemovals = GeoDetailRemovals{GeoDetailRemovalNoRemoval, GeoDetailRemovalRemoveCoordinates, GeoDetailRemovalRemoveCity, GeoDetailRemovalRemoveAll}
+
+// GeoSubdivision is the representation of a subdivision (e.g. state or province) of a custom geo mapping rule in Instana
+type GeoSubdivision struct {
+	Code *string `json:"code"`
+	Name string  `json:"name"`
+}
+
+// GeoMappingRule is the representation of a custom geo mapping rule which maps an IP range to a geo location in Instana
+type GeoMappingRule struct {
+	Cidr           string           `json:"cidr"`
+	AccuracyRadius *int64           `json:"accuracyRadius"`
+	City           *string          `json:"city"`
+	Continent      *string          `json:"continent"`
+	ContinentCode  *string          `json:"continentCode"`
+	Country        *string          `json:"country"`
+	CountryCode    *string          `json:"countryCode"`
+	Latitude       *float64         `json:"latitude"`
+	Longitude      *float64         `json:"longitude"`
+	Subdivisions   []GeoSubdivision `json:"subdivisions"`
+}
+
+// GeoLocationConfig is the representation of the geo location configuration of a website or mobile app in Instana
+type GeoLocationConfig struct {
+	ID               string           `json:"-"`
+	GeoDetailRemoval GeoDetailRemoval `json:"geoDetailRemoval"`
+	GeoMappingRules  []GeoMappingRule `json:"geoMappingRules"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *GeoLocationConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// SetIDForResourcePath implementation of the interface InstanaSubResourceDataObject
+func (c *GeoLocationConfig) SetIDForResourcePath(id string) {
+	c.ID = id
+}
+
+// NewDefaultGeoLocationConfig creates the default geo location configuration which is applied by Instana when no configuration is provided
+func NewDefaultGeoLocationConfig() *GeoLocationConfig {
+	return &GeoLocationConfig{GeoDetailRemoval: GeoDetailRemovalNoRemoval, GeoMappingRules: []GeoMappingRule{}}
+}
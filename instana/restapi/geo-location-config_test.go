@@ -0,0 +1,13 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnSupportedGeoDetailRemovalsAsStringSlice(t *testing.T) {
+	expected := []string{"NO_REMOVAL", "REMOVE_COORDINATES", "REMOVE_CITY", "REMOVE_ALL"}
+	require.Equal(t, expected, SupportedGeoDetailRemovals.ToStringSlice())
+}
@@ -0,0 +1,57 @@
+package restapi
+
+// HttpEndpointConfigResourcePath path to http endpoint config resource of Instana RESTful API
+const HttpEndpointConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/http-endpoint"
+
+// HttpPathSegmentMatchingType custom type for the type of path segment matching rules of http endpoint rules
+type HttpPathSegmentMatchingType string
+
+// HttpPathSegmentMatchingTypes custom type for a slice of HttpPathSegmentMatchingType
+type HttpPathSegmentMatchingTypes []HttpPathSegmentMatchingType
+
+// ToStringSlice Returns the corresponding string representations
+func (types HttpPathSegmentMatchingTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, v := range types {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//HttpPathSegmentMatchingTypeFixed constant value for path segments matching a fixed name
+	HttpPathSegmentMatchingTypeFixed = HttpPathSegmentMatchingType("FIXED")
+	//HttpPathSegmentMatchingTypeParameter constant value for path segments representing a path parameter
+	HttpPathSegmentMatchingTypeParameter = HttpPathSegmentMatchingType("PARAMETER")
+	//HttpPathSegmentMatchingTypeMatchAll constant value for path segments matching all remaining path segments
+	HttpPathSegmentMatchingTypeMatchAll = HttpPathSegmentMatchingType("MATCH_ALL")
+)
+
+// SupportedHttpPathSegmentMatchingTypes list of all supported HttpPathSegmentMatchingType
+var SupportedHttpPathSegmentMatchingTypes = HttpPathSegmentMatchingTypes{HttpPathSegmentMatchingTypeFixed, HttpPathSegmentMatchingTypeParameter, HttpPathSegmentMatchingTypeMatchAll}
+
+// HttpPathSegmentMatchingRule is the representation of a single path segment of a http endpoint rule in Instana
+type HttpPathSegmentMatchingRule struct {
+	Type HttpPathSegmentMatchingType `json:"type"`
+	Name *string                     `json:"name,omitempty"`
+}
+
+// HttpEndpointRule is the representation of a http endpoint rule in Instana which defines how http paths are collapsed into endpoints
+type HttpEndpointRule struct {
+	Enabled      bool                          `json:"enabled"`
+	PathSegments []HttpPathSegmentMatchingRule `json:"pathSegments"`
+	TestCases    []string                      `json:"testCases"`
+}
+
+// HttpEndpointConfig is the representation of the http endpoint config of a service in Instana
+type HttpEndpointConfig struct {
+	ServiceID                                      string             `json:"serviceId"`
+	EndpointNameByFirstPathSegmentRuleEnabled      bool               `json:"endpointNameByFirstPathSegmentRuleEnabled"`
+	EndpointNameByCollectedPathTemplateRuleEnabled bool               `json:"endpointNameByCollectedPathTemplateRuleEnabled"`
+	Rules                                          []HttpEndpointRule `json:"rules"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *HttpEndpointConfig) GetIDForResourcePath() string {
+	return c.ServiceID
+}
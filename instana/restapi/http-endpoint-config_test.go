@@ -0,0 +1,13 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnSupportedHttpPathSegmentMatchingTypesAsStringSlice(t *testing.T) {
+	expected := []string{"FIXED", "PARAMETER", "MATCH_ALL"}
+	require.Equal(t, expected, SupportedHttpPathSegmentMatchingTypes.ToStringSlice())
+}
@@ -0,0 +1,7 @@
+package restapi
+
+// InfraTimeThreshold struct representing the API model of an infrastructure time threshold
+type InfraTimeThreshold struct {
+	Type       string `json:"type"`
+	TimeWindow int64  `json:"timeWindow"`
+}
@@ -5,6 +5,12 @@ type InstanaDataObject interface {
 	GetIDForResourcePath() string
 }
 
+// InstanaSubResourceDataObject is a marker interface for data objects which are provided as a sub resource of another resource of the Instana REST API. The ID of such a data object is the ID of the parent resource
+type InstanaSubResourceDataObject interface {
+	InstanaDataObject
+	SetIDForResourcePath(id string)
+}
+
 // RestResource interface definition of a instana REST resource.
 type RestResource[T InstanaDataObject] interface {
 	GetAll() (*[]T, error)
@@ -0,0 +1,52 @@
+package restapi
+
+// IPMaskingConfigSubResourcePath path of the ip masking configuration sub resource of websites and mobile apps
+const IPMaskingConfigSubResourcePath = "ip-masking"
+
+// IPMasking custom type for the ip masking mode of websites and mobile apps
+type IPMasking string
+
+// IPMaskings custom type for a slice of IPMasking
+type IPMaskings []IPMasking
+
+// ToStringSlice Returns the corresponding string representations
+func (masks IPMaskings) ToStringSlice() []string {
+	result := make([]string, len(masks))
+	for i, v := range masks {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//IPMaskingDefault constant value for the ip masking mode DEFAULT
+	IPMaskingDefault = IPMasking("DEFAULT")
+	//IPMaskingStrict constant value for the ip masking mode STRICT
+	IPMaskingStrict = IPMasking("STRICT")
+	//IPMaskingRemoveAllDetails constant value for the ip masking mode REMOVE_ALL_DETAILS
+	IPMaskingRemoveAllDetails = IPMasking("REMOVE_ALL_DETAILS")
+)
+
+// SupportedIPMaskings list of all supported IPMasking modes
+var SupportedIPMaskings = IPMaskings{IPMaskingDefault, IPMaskingStrict, IPMaskingRemoveAllDetails}
+
+// IPMaskingConfig is the representation of the ip masking configuration of a website or mobile app in Instana
+type IPMaskingConfig struct {
+	ID        string    `json:"-"`
+	IPMasking IPMasking `json:"ipMasking"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *IPMaskingConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// SetIDForResourcePath implementation of the interface InstanaSubResourceDataObject
+func (c *IPMaskingConfig) SetIDForResourcePath(id string) {
+	c.ID = id
+}
+
+// NewDefaultIPMaskingConfig creates the default ip masking configuration which is applied by Instana when no configuration is provided
+func NewDefaultIPMaskingConfig() *IPMaskingConfig {
+	return &IPMaskingConfig{IPMasking: IPMaskingDefault}
+}
@@ -0,0 +1,13 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnSupportedIPMaskingsAsStringSlice(t *testing.T) {
+	expected := []string{"DEFAULT", "STRICT", "REMOVE_ALL_DETAILS"}
+	require.Equal(t, expected, SupportedIPMaskings.ToStringSlice())
+}
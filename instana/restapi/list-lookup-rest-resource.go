@@ -0,0 +1,26 @@
+package restapi
+
+// NewListLookupRestResource decorates the given RestResource so that single objects are looked up from the list of all objects. This is required for REST resources of the Instana API which do not provide an endpoint to retrieve a single object
+func NewListLookupRestResource[T InstanaDataObject](delegate RestResource[T]) RestResource[T] {
+	return &listLookupRestResource[T]{
+		RestResource: delegate,
+	}
+}
+
+type listLookupRestResource[T InstanaDataObject] struct {
+	RestResource[T]
+}
+
+func (r *listLookupRestResource[T]) GetOne(id string) (T, error) {
+	var zero T
+	objects, err := r.GetAll()
+	if err != nil {
+		return zero, err
+	}
+	for _, o := range *objects {
+		if o.GetIDForResourcePath() == id {
+			return o, nil
+		}
+	}
+	return zero, ErrEntityNotFound
+}
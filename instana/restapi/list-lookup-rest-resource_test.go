@@ -0,0 +1,84 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestShouldLookupSingleObjectFromListOfAllObjects(t *testing.T) {
+	objectA := &ManualServiceConfig{ID: "a"}
+	objectB := &ManualServiceConfig{ID: "b"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	delegate := mocks.NewMockRestResource[*ManualServiceConfig](ctrl)
+	delegate.EXPECT().GetAll().Times(1).Return(&[]*ManualServiceConfig{objectA, objectB}, nil)
+	delegate.EXPECT().GetOne(gomock.Any()).Times(0)
+
+	sut := NewListLookupRestResource[*ManualServiceConfig](delegate)
+
+	result, err := sut.GetOne("b")
+
+	require.NoError(t, err)
+	require.Equal(t, objectB, result)
+}
+
+func TestShouldReturnEntityNotFoundErrorWhenObjectIsNotContainedInListOfAllObjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	delegate := mocks.NewMockRestResource[*ManualServiceConfig](ctrl)
+	delegate.EXPECT().GetAll().Times(1).Return(&[]*ManualServiceConfig{{ID: "a"}}, nil)
+
+	sut := NewListLookupRestResource[*ManualServiceConfig](delegate)
+
+	_, err := sut.GetOne("b")
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToLookupSingleObjectWhenListOfAllObjectsCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	delegate := mocks.NewMockRestResource[*ManualServiceConfig](ctrl)
+	delegate.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+
+	sut := NewListLookupRestResource[*ManualServiceConfig](delegate)
+
+	_, err := sut.GetOne("a")
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDelegateWriteOperationsOfListLookupRestResource(t *testing.T) {
+	object := &ManualServiceConfig{ID: "a"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	delegate := mocks.NewMockRestResource[*ManualServiceConfig](ctrl)
+	delegate.EXPECT().Create(object).Times(1).Return(object, nil)
+	delegate.EXPECT().Update(object).Times(1).Return(object, nil)
+	delegate.EXPECT().DeleteByID("a").Times(1).Return(nil)
+
+	sut := NewListLookupRestResource[*ManualServiceConfig](delegate)
+
+	created, err := sut.Create(object)
+	require.NoError(t, err)
+	require.Equal(t, object, created)
+
+	updated, err := sut.Update(object)
+	require.NoError(t, err)
+	require.Equal(t, object, updated)
+
+	require.NoError(t, sut.DeleteByID("a"))
+}
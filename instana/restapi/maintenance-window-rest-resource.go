@@ -0,0 +1,76 @@
+package restapi
+
+// NewMaintenanceWindowRestResource creates a new REST resource for maintenance windows. Maintenance windows are created and updated using PUT. The paused state is managed through the dedicated pause and resume sub resources of the Instana API
+func NewMaintenanceWindowRestResource(unmarshaller JSONUnmarshaller[*MaintenanceWindow], client RestClient) RestResource[*MaintenanceWindow] {
+	return &maintenanceWindowRestResource{
+		resourcePath: MaintenanceWindowResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type maintenanceWindowRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*MaintenanceWindow]
+	client       RestClient
+}
+
+func (r *maintenanceWindowRestResource) GetAll() (*[]*MaintenanceWindow, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (r *maintenanceWindowRestResource) GetOne(id string) (*MaintenanceWindow, error) {
+	data, err := r.client.GetOne(id, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.Unmarshal(data)
+}
+
+func (r *maintenanceWindowRestResource) Create(data *MaintenanceWindow) (*MaintenanceWindow, error) {
+	return r.upsert(data)
+}
+
+func (r *maintenanceWindowRestResource) Update(data *MaintenanceWindow) (*MaintenanceWindow, error) {
+	return r.upsert(data)
+}
+
+func (r *maintenanceWindowRestResource) upsert(data *MaintenanceWindow) (*MaintenanceWindow, error) {
+	response, err := r.client.Put(data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	result, err := r.unmarshaller.Unmarshal(response)
+	if err != nil {
+		return nil, err
+	}
+	if result.Paused == data.Paused {
+		return result, nil
+	}
+
+	subResourcePath := MaintenanceWindowResumeSubResourcePath
+	if data.Paused {
+		subResourcePath = MaintenanceWindowPauseSubResourcePath
+	}
+	_, err = r.client.PutSubResource(r.resourcePath, data.GetIDForResourcePath(), subResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetOne(data.GetIDForResourcePath())
+}
+
+func (r *maintenanceWindowRestResource) Delete(data *MaintenanceWindow) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *maintenanceWindowRestResource) DeleteByID(id string) error {
+	return r.client.Delete(id, r.resourcePath)
+}
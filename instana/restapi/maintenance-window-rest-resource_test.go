@@ -0,0 +1,309 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	maintenanceWindowID    = "id"
+	maintenanceWindowName  = "name"
+	maintenanceWindowQuery = "entity.type:host"
+)
+
+var maintenanceWindowSerialized = []byte("serialized")
+
+func makeMaintenanceWindow(paused bool) *MaintenanceWindow {
+	return &MaintenanceWindow{
+		ID:     maintenanceWindowID,
+		Name:   maintenanceWindowName,
+		Query:  maintenanceWindowQuery,
+		Paused: paused,
+		Scheduling: MaintenanceWindowScheduling{
+			Type:  MaintenanceWindowSchedulingTypeOneTime,
+			Start: 1700000000000,
+			Duration: MaintenanceWindowDuration{
+				Amount: 2,
+				Unit:   MaintenanceWindowDurationUnitHours,
+			},
+		},
+	}
+}
+
+// ########################################################
+// GET All Tests
+// ########################################################
+
+func TestShouldSuccessfullyGetAllMaintenanceWindows(t *testing.T) {
+	testObject := makeMaintenanceWindow(false)
+	expectedResult := []*MaintenanceWindow{testObject, testObject}
+	restResponseData := []byte("server-response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(MaintenanceWindowResourcePath).Times(1).Return(restResponseData, nil)
+
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(&expectedResult, nil)
+
+	sut := NewMaintenanceWindowRestResource(jsonUnmarshaller, restClient)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResult, result)
+}
+
+func TestShouldFailToGetAllMaintenanceWindowsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(MaintenanceWindowResourcePath).Times(1).Return(nil, expectedError)
+
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
+
+	sut := NewMaintenanceWindowRestResource(jsonUnmarshaller, restClient)
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetAllMaintenanceWindowsWhenRestResultCannotBeUnmarshalled(t *testing.T) {
+	restResponseData := []byte("invalidResponse")
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(MaintenanceWindowResourcePath).Times(1).Return(restResponseData, nil)
+
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(nil, expectedError)
+
+	sut := NewMaintenanceWindowRestResource(jsonUnmarshaller, restClient)
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+// ########################################################
+// GET Operation Tests
+// ########################################################
+
+func TestShouldSuccessfullyExecuteGetOperationOfMaintenanceWindowRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	maintenanceWindow := makeMaintenanceWindow(false)
+
+	client.EXPECT().GetOne(maintenanceWindowID, MaintenanceWindowResourcePath).Times(1).Return(maintenanceWindowSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowSerialized).Times(1).Return(maintenanceWindow, nil)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(maintenanceWindowID)
+
+	require.NoError(t, err)
+	require.Equal(t, maintenanceWindow, result)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOperationOfMaintenanceWindowRestResourceAndGetOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	expectedError := errors.New("Error")
+
+	client.EXPECT().GetOne(maintenanceWindowID, MaintenanceWindowResourcePath).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(maintenanceWindowID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+// ########################################################
+// Create and Update Operation Tests
+// ########################################################
+
+func TestShouldSuccessfullyExecuteCreateOperationOfMaintenanceWindowRestResourceWhenPausedStateMatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	maintenanceWindow := makeMaintenanceWindow(false)
+
+	client.EXPECT().Put(gomock.Eq(maintenanceWindow), gomock.Eq(MaintenanceWindowResourcePath)).Times(1).Return(maintenanceWindowSerialized, nil)
+	client.EXPECT().PutSubResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowSerialized).Times(1).Return(maintenanceWindow, nil)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	result, err := sut.Create(maintenanceWindow)
+
+	require.NoError(t, err)
+	require.Equal(t, maintenanceWindow, result)
+}
+
+func TestShouldPauseMaintenanceWindowWhenExecutingCreateOperationOfMaintenanceWindowRestResourceWithPausedSetToTrue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	maintenanceWindow := makeMaintenanceWindow(true)
+	pausedResponse := []byte("paused")
+
+	gomock.InOrder(
+		client.EXPECT().Put(gomock.Eq(maintenanceWindow), gomock.Eq(MaintenanceWindowResourcePath)).Times(1).Return(maintenanceWindowSerialized, nil),
+		client.EXPECT().PutSubResource(MaintenanceWindowResourcePath, maintenanceWindowID, MaintenanceWindowPauseSubResourcePath).Times(1).Return(nil, nil),
+		client.EXPECT().GetOne(maintenanceWindowID, MaintenanceWindowResourcePath).Times(1).Return(pausedResponse, nil),
+	)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowSerialized).Times(1).Return(makeMaintenanceWindow(false), nil)
+	unmarshaller.EXPECT().Unmarshal(pausedResponse).Times(1).Return(maintenanceWindow, nil)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	result, err := sut.Create(maintenanceWindow)
+
+	require.NoError(t, err)
+	require.Equal(t, maintenanceWindow, result)
+}
+
+func TestShouldResumeMaintenanceWindowWhenExecutingUpdateOperationOfMaintenanceWindowRestResourceWithPausedSetToFalse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	maintenanceWindow := makeMaintenanceWindow(false)
+	resumedResponse := []byte("resumed")
+
+	gomock.InOrder(
+		client.EXPECT().Put(gomock.Eq(maintenanceWindow), gomock.Eq(MaintenanceWindowResourcePath)).Times(1).Return(maintenanceWindowSerialized, nil),
+		client.EXPECT().PutSubResource(MaintenanceWindowResourcePath, maintenanceWindowID, MaintenanceWindowResumeSubResourcePath).Times(1).Return(nil, nil),
+		client.EXPECT().GetOne(maintenanceWindowID, MaintenanceWindowResourcePath).Times(1).Return(resumedResponse, nil),
+	)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowSerialized).Times(1).Return(makeMaintenanceWindow(true), nil)
+	unmarshaller.EXPECT().Unmarshal(resumedResponse).Times(1).Return(maintenanceWindow, nil)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	result, err := sut.Update(maintenanceWindow)
+
+	require.NoError(t, err)
+	require.Equal(t, maintenanceWindow, result)
+}
+
+func TestShouldReturnErrorWhenExecutingUpdateOperationOfMaintenanceWindowRestResourceAndPutOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	expectedError := errors.New("Error")
+	maintenanceWindow := makeMaintenanceWindow(false)
+
+	client.EXPECT().Put(gomock.Eq(maintenanceWindow), gomock.Eq(MaintenanceWindowResourcePath)).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	_, err := sut.Update(maintenanceWindow)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldReturnErrorWhenExecutingUpdateOperationOfMaintenanceWindowRestResourceAndUnmarshallingFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	expectedError := errors.New("Error")
+	maintenanceWindow := makeMaintenanceWindow(false)
+
+	client.EXPECT().Put(gomock.Eq(maintenanceWindow), gomock.Eq(MaintenanceWindowResourcePath)).Times(1).Return(maintenanceWindowSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowSerialized).Times(1).Return(nil, expectedError)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	_, err := sut.Update(maintenanceWindow)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldReturnErrorWhenExecutingUpdateOperationOfMaintenanceWindowRestResourceAndPauseRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	expectedError := errors.New("Error")
+	maintenanceWindow := makeMaintenanceWindow(true)
+
+	client.EXPECT().Put(gomock.Eq(maintenanceWindow), gomock.Eq(MaintenanceWindowResourcePath)).Times(1).Return(maintenanceWindowSerialized, nil)
+	client.EXPECT().PutSubResource(MaintenanceWindowResourcePath, maintenanceWindowID, MaintenanceWindowPauseSubResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().GetOne(gomock.Any(), gomock.Any()).Times(0)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowSerialized).Times(1).Return(makeMaintenanceWindow(false), nil)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	_, err := sut.Update(maintenanceWindow)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+// ########################################################
+// Delete Operation Tests
+// ########################################################
+
+func TestShouldSuccessfullyExecuteDeleteByObjectOperationOfMaintenanceWindowRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+
+	client.EXPECT().Delete(maintenanceWindowID, MaintenanceWindowResourcePath).Times(1).Return(nil)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	err := sut.Delete(makeMaintenanceWindow(false))
+
+	require.NoError(t, err)
+}
+
+func TestShouldReturnErrorWhenExecutingDeleteByIdOperationOfMaintenanceWindowRestResourceAndDeleteRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindow](ctrl)
+	expectedError := errors.New("Error")
+
+	client.EXPECT().Delete(maintenanceWindowID, MaintenanceWindowResourcePath).Times(1).Return(expectedError)
+
+	sut := NewMaintenanceWindowRestResource(unmarshaller, client)
+
+	err := sut.DeleteByID(maintenanceWindowID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
@@ -0,0 +1,77 @@
+package restapi
+
+// MaintenanceWindowResourcePath path to maintenance window resource of Instana RESTful API
+const MaintenanceWindowResourcePath = SettingsBasePath + "/v2/maintenance"
+
+const (
+	//MaintenanceWindowPauseSubResourcePath the sub resource path to pause a maintenance window
+	MaintenanceWindowPauseSubResourcePath = "pause"
+	//MaintenanceWindowResumeSubResourcePath the sub resource path to resume a paused maintenance window
+	MaintenanceWindowResumeSubResourcePath = "resume"
+)
+
+// MaintenanceWindowSchedulingType custom type for the type of the scheduling of maintenance windows
+type MaintenanceWindowSchedulingType string
+
+const (
+	//MaintenanceWindowSchedulingTypeOneTime constant value for one time maintenance windows
+	MaintenanceWindowSchedulingTypeOneTime = MaintenanceWindowSchedulingType("ONE_TIME")
+	//MaintenanceWindowSchedulingTypeRecurrent constant value for recurrent maintenance windows
+	MaintenanceWindowSchedulingTypeRecurrent = MaintenanceWindowSchedulingType("RECURRENT")
+)
+
+// MaintenanceWindowDurationUnit custom type for the unit of the duration of maintenance windows
+type MaintenanceWindowDurationUnit string
+
+// MaintenanceWindowDurationUnits custom type for a slice of MaintenanceWindowDurationUnit
+type MaintenanceWindowDurationUnits []MaintenanceWindowDurationUnit
+
+// ToStringSlice Returns the corresponding string representations
+func (units MaintenanceWindowDurationUnits) ToStringSlice() []string {
+	result := make([]string, len(units))
+	for i, v := range units {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//MaintenanceWindowDurationUnitMinutes constant value for the maintenance window duration unit minutes
+	MaintenanceWindowDurationUnitMinutes = MaintenanceWindowDurationUnit("MINUTES")
+	//MaintenanceWindowDurationUnitHours constant value for the maintenance window duration unit hours
+	MaintenanceWindowDurationUnitHours = MaintenanceWindowDurationUnit("HOURS")
+	//MaintenanceWindowDurationUnitDays constant value for the maintenance window duration unit days
+	MaintenanceWindowDurationUnitDays = MaintenanceWindowDurationUnit("DAYS")
+)
+
+// SupportedMaintenanceWindowDurationUnits list of all supported MaintenanceWindowDurationUnit
+var SupportedMaintenanceWindowDurationUnits = MaintenanceWindowDurationUnits{MaintenanceWindowDurationUnitMinutes, MaintenanceWindowDurationUnitHours, MaintenanceWindowDurationUnitDays}
+
+// MaintenanceWindowDuration is the representation of the duration of a maintenance window in Instana
+type MaintenanceWindowDuration struct {
+	Amount int64                         `json:"amount"`
+	Unit   MaintenanceWindowDurationUnit `json:"unit"`
+}
+
+// MaintenanceWindowScheduling is the representation of the scheduling of a maintenance window in Instana
+type MaintenanceWindowScheduling struct {
+	Type       MaintenanceWindowSchedulingType `json:"type"`
+	Start      int64                           `json:"start"`
+	Duration   MaintenanceWindowDuration       `json:"duration"`
+	RRule      *string                         `json:"rrule"`
+	TimezoneID *string                         `json:"timezoneId"`
+}
+
+// MaintenanceWindow is the representation of a maintenance window in Instana
+type MaintenanceWindow struct {
+	ID         string                      `json:"id"`
+	Name       string                      `json:"name"`
+	Query      string                      `json:"query"`
+	Scheduling MaintenanceWindowScheduling `json:"scheduling"`
+	Paused     bool                        `json:"paused"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (m *MaintenanceWindow) GetIDForResourcePath() string {
+	return m.ID
+}
@@ -0,0 +1,15 @@
+package restapi
+
+// MobileAppMonitoringConfigResourcePath path to mobile app monitoring config resource of Instana RESTful API
+const MobileAppMonitoringConfigResourcePath = MobileAppMonitoringResourcePath + "/config"
+
+// MobileAppMonitoringConfig data structure of a Mobile App Monitoring Configuration of the Instana API
+type MobileAppMonitoringConfig struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetIDForResourcePath implemention of the interface InstanaDataObject
+func (r *MobileAppMonitoringConfig) GetIDForResourcePath() string {
+	return r.ID
+}
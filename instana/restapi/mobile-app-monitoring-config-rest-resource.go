@@ -0,0 +1,73 @@
+package restapi
+
+// NewMobileAppMonitoringConfigRestResource creates a new REST for the mobile app monitoring config. The Instana API does not provide an endpoint to retrieve a single mobile app monitoring config. Therefore, single configurations are looked up from the list of all mobile app monitoring configs
+func NewMobileAppMonitoringConfigRestResource(unmarshaller JSONUnmarshaller[*MobileAppMonitoringConfig], client RestClient) RestResource[*MobileAppMonitoringConfig] {
+	return &mobileAppMonitoringConfigRestResource{
+		resourcePath: MobileAppMonitoringConfigResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type mobileAppMonitoringConfigRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*MobileAppMonitoringConfig]
+	client       RestClient
+}
+
+func (r *mobileAppMonitoringConfigRestResource) GetAll() (*[]*MobileAppMonitoringConfig, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (r *mobileAppMonitoringConfigRestResource) GetOne(id string) (*MobileAppMonitoringConfig, error) {
+	objects, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range *objects {
+		if o.ID == id {
+			return o, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *mobileAppMonitoringConfigRestResource) Create(data *MobileAppMonitoringConfig) (*MobileAppMonitoringConfig, error) {
+	response, err := r.client.PostByQuery(r.resourcePath, map[string]string{"name": data.Name})
+	if err != nil {
+		return data, err
+	}
+	return r.validateResponseAndConvertToStruct(response)
+}
+
+func (r *mobileAppMonitoringConfigRestResource) Update(data *MobileAppMonitoringConfig) (*MobileAppMonitoringConfig, error) {
+	response, err := r.client.PutByQuery(r.resourcePath, data.GetIDForResourcePath(), map[string]string{"name": data.Name})
+	if err != nil {
+		return data, err
+	}
+	return r.validateResponseAndConvertToStruct(response)
+}
+
+func (r *mobileAppMonitoringConfigRestResource) validateResponseAndConvertToStruct(data []byte) (*MobileAppMonitoringConfig, error) {
+	dataObject, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return dataObject, nil
+}
+
+func (r *mobileAppMonitoringConfigRestResource) Delete(data *MobileAppMonitoringConfig) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *mobileAppMonitoringConfigRestResource) DeleteByID(id string) error {
+	return r.client.Delete(id, r.resourcePath)
+}
@@ -0,0 +1,267 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const mobileAppMonitoringConfigID = "mobile-app-config-id"
+const mobileAppMonitoringConfigName = "mobile-app-config-name"
+
+var mobileAppMonitoringConfigSerialized = []byte("serialized")
+var mobileAppNameQueryParameter = map[string]string{"name": mobileAppMonitoringConfigName}
+
+func makeTestMobileAppMonitoringConfig() *MobileAppMonitoringConfig {
+	return &MobileAppMonitoringConfig{
+		ID:   mobileAppMonitoringConfigID,
+		Name: mobileAppMonitoringConfigName,
+	}
+}
+
+// ########################################################
+// GET All Tests
+// ########################################################
+func TestShouldSuccessfullyGetAllMobileAppMonitoringConfigs(t *testing.T) {
+	config := makeTestMobileAppMonitoringConfig()
+	expectedResult := []*MobileAppMonitoringConfig{config, config}
+	restResponseData := []byte("server-response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(MobileAppMonitoringConfigResourcePath).Times(1).Return(restResponseData, nil)
+
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(&expectedResult, nil)
+
+	sut := NewMobileAppMonitoringConfigRestResource(jsonUnmarshaller, restClient)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResult, result)
+}
+
+func TestShouldFailToGetAllMobileAppMonitoringConfigsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(MobileAppMonitoringConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
+
+	sut := NewMobileAppMonitoringConfigRestResource(jsonUnmarshaller, restClient)
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetAllMobileAppMonitoringConfigsWhenRestResultCannotBeUnmarshalled(t *testing.T) {
+	restResponseData := []byte("invalidResponse")
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(MobileAppMonitoringConfigResourcePath).Times(1).Return(restResponseData, nil)
+
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(nil, expectedError)
+
+	sut := NewMobileAppMonitoringConfigRestResource(jsonUnmarshaller, restClient)
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+// ########################################################
+// GET Operation Tests
+// ########################################################
+
+func TestShouldSuccessfullyExecuteGetOperationOfMobileAppMonitoringConfigRestResourceByLookingUpConfigFromAllConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	config := makeTestMobileAppMonitoringConfig()
+	otherConfig := &MobileAppMonitoringConfig{ID: "other-id", Name: "other-name"}
+
+	client.EXPECT().Get(MobileAppMonitoringConfigResourcePath).Times(1).Return(mobileAppMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().UnmarshalArray(mobileAppMonitoringConfigSerialized).Times(1).Return(&[]*MobileAppMonitoringConfig{otherConfig, config}, nil)
+
+	sut := NewMobileAppMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(mobileAppMonitoringConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldReturnEntityNotFoundErrorWhenExecutingGetOperationOfMobileAppMonitoringConfigRestResourceAndConfigDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	otherConfig := &MobileAppMonitoringConfig{ID: "other-id", Name: "other-name"}
+
+	client.EXPECT().Get(MobileAppMonitoringConfigResourcePath).Times(1).Return(mobileAppMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().UnmarshalArray(mobileAppMonitoringConfigSerialized).Times(1).Return(&[]*MobileAppMonitoringConfig{otherConfig}, nil)
+
+	sut := NewMobileAppMonitoringConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(mobileAppMonitoringConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, ErrEntityNotFound, err)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOperationOfMobileAppMonitoringConfigRestResourceAndGetOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	expectedError := errors.New("Error")
+
+	client.EXPECT().Get(MobileAppMonitoringConfigResourcePath).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
+
+	sut := NewMobileAppMonitoringConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(mobileAppMonitoringConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+// ########################################################
+// Create Operation Tests
+// ########################################################
+
+func TestShouldSuccessfullyExecuteCreateOperationOfMobileAppMonitoringConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	config := makeTestMobileAppMonitoringConfig()
+
+	client.EXPECT().PostByQuery(MobileAppMonitoringConfigResourcePath, mobileAppNameQueryParameter).Times(1).Return(mobileAppMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(mobileAppMonitoringConfigSerialized).Times(1).Return(config, nil)
+
+	sut := NewMobileAppMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldReturnErrorWhenExecutingCreateOperationOfMobileAppMonitoringConfigRestResourceAndPostOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	expectedError := errors.New("Error")
+	config := makeTestMobileAppMonitoringConfig()
+
+	client.EXPECT().PostByQuery(MobileAppMonitoringConfigResourcePath, mobileAppNameQueryParameter).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewMobileAppMonitoringConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Create(config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+// ########################################################
+// Update Operation Tests
+// ########################################################
+
+func TestShouldSuccessfullyExecuteUpdateOperationOfMobileAppMonitoringConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	config := makeTestMobileAppMonitoringConfig()
+
+	client.EXPECT().PutByQuery(MobileAppMonitoringConfigResourcePath, mobileAppMonitoringConfigID, mobileAppNameQueryParameter).Times(1).Return(mobileAppMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(mobileAppMonitoringConfigSerialized).Times(1).Return(config, nil)
+
+	sut := NewMobileAppMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Update(config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldReturnErrorWhenExecutingUpdateOperationOfMobileAppMonitoringConfigRestResourceAndUnmarshallingFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	expectedError := errors.New("Error")
+	config := makeTestMobileAppMonitoringConfig()
+
+	client.EXPECT().PutByQuery(MobileAppMonitoringConfigResourcePath, mobileAppMonitoringConfigID, mobileAppNameQueryParameter).Times(1).Return(mobileAppMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(mobileAppMonitoringConfigSerialized).Times(1).Return(nil, expectedError)
+
+	sut := NewMobileAppMonitoringConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Update(config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+// ########################################################
+// Delete Operation Tests
+// ########################################################
+
+func TestShouldSuccessfullyExecuteDeleteByObjectOperationOfMobileAppMonitoringConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+
+	client.EXPECT().Delete(mobileAppMonitoringConfigID, MobileAppMonitoringConfigResourcePath).Times(1).Return(nil)
+
+	sut := NewMobileAppMonitoringConfigRestResource(unmarshaller, client)
+
+	err := sut.Delete(makeTestMobileAppMonitoringConfig())
+
+	require.NoError(t, err)
+}
+
+func TestShouldReturnErrorWhenExecutingDeleteByIdOperationOfMobileAppMonitoringConfigRestResourceAndDeleteRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppMonitoringConfig](ctrl)
+	expectedError := errors.New("Error")
+
+	client.EXPECT().Delete(mobileAppMonitoringConfigID, MobileAppMonitoringConfigResourcePath).Times(1).Return(expectedError)
+
+	sut := NewMobileAppMonitoringConfigRestResource(unmarshaller, client)
+
+	err := sut.DeleteByID(mobileAppMonitoringConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
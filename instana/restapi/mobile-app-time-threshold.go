@@ -0,0 +1,12 @@
+package restapi
+
+// MobileAppTimeThreshold struct representing the API model of a mobile app time threshold. The impact measurement
+// methods supported by mobile apps are the same as the ones of websites.
+type MobileAppTimeThreshold struct {
+	Type                    string                          `json:"type"`
+	TimeWindow              *int64                          `json:"timeWindow"`
+	Violations              *int32                          `json:"violations"`
+	ImpactMeasurementMethod *WebsiteImpactMeasurementMethod `json:"impactMeasurementMethod"`
+	UserPercentage          *float64                        `json:"userPercentage"`
+	Users                   *int32                          `json:"users"`
+}
@@ -0,0 +1,40 @@
+package restapi
+
+const (
+	//RBACMappingsResourcePath path to the mappings of identity provider groups to Instana groups of the Instana RESTful API
+	RBACMappingsResourcePath = RBACSettingsBasePath + "/mappings"
+	//IdentityProviderConfigResourcePath path to the identity provider configuration of the Instana RESTful API
+	IdentityProviderConfigResourcePath = RBACMappingsResourcePath + "/identityProvider/restrictEmptyIdpGroups"
+)
+
+// IdentityProviderConfigID the ID of the identity provider configuration. The configuration exists exactly once per Instana tenant
+const IdentityProviderConfigID = "identity-provider-config"
+
+// GroupMapping is the representation of a mapping of an identity provider group (LDAP, OIDC, SAML) to an Instana group
+type GroupMapping struct {
+	ID      string `json:"id,omitempty"`
+	GroupID string `json:"groupId"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (m *GroupMapping) GetIDForResourcePath() string {
+	return m.ID
+}
+
+// IdentityProviderConfig is the representation of the identity provider configuration of an Instana tenant. When
+// RestrictEmptyIdpGroups is enabled only users with at least one matching group mapping are allowed to log in
+type IdentityProviderConfig struct {
+	RestrictEmptyIdpGroups bool `json:"restrictEmptyIdpGroups"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *IdentityProviderConfig) GetIDForResourcePath() string {
+	return IdentityProviderConfigID
+}
+
+// NewDefaultIdentityProviderConfig creates the default identity provider configuration of Instana which does not restrict users without group mappings
+func NewDefaultIdentityProviderConfig() *IdentityProviderConfig {
+	return &IdentityProviderConfig{RestrictEmptyIdpGroups: false}
+}
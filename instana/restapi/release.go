@@ -0,0 +1,38 @@
+package restapi
+
+// ReleaseResourcePath path to the release resource of Instana RESTful API
+const ReleaseResourcePath = InstanaAPIBasePath + "/releases"
+
+// ReleaseApplicationScope represents an application perspective in the scope of a release. Application perspectives
+// are referenced by name. The ID is only provided by Instana when reading the release
+type ReleaseApplicationScope struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// ReleaseServiceScopedTo represents the application perspectives to which the service scope of a release is limited
+type ReleaseServiceScopedTo struct {
+	Applications []ReleaseApplicationScope `json:"applications"`
+}
+
+// ReleaseServiceScope represents a service in the scope of a release. Services are referenced by name. The ID is only
+// provided by Instana when reading the release
+type ReleaseServiceScope struct {
+	ID       string                  `json:"id,omitempty"`
+	Name     string                  `json:"name"`
+	ScopedTo *ReleaseServiceScopedTo `json:"scopedTo,omitempty"`
+}
+
+// Release is the representation of a release (deployment marker) in Instana
+type Release struct {
+	ID           string                    `json:"id,omitempty"`
+	Name         string                    `json:"name"`
+	Start        int64                     `json:"start"`
+	Applications []ReleaseApplicationScope `json:"applications"`
+	Services     []ReleaseServiceScope     `json:"services"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *Release) GetIDForResourcePath() string {
+	return r.ID
+}
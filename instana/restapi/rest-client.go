@@ -22,12 +22,21 @@ const encodingApplicationJSON = "application/json; charset=utf-8"
 type RestClient interface {
 	Get(resourcePath string) ([]byte, error)
 	GetOne(id string, resourcePath string) ([]byte, error)
+	GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
 	Post(data InstanaDataObject, resourcePath string) ([]byte, error)
 	PostWithID(data InstanaDataObject, resourcePath string) ([]byte, error)
 	Put(data InstanaDataObject, resourcePath string) ([]byte, error)
 	Delete(resourceID string, resourceBasePath string) error
 	PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
 	PutByQuery(resourcePath string, is string, queryParams map[string]string) ([]byte, error)
+	PutSubResource(resourcePath string, id string, subResourcePath string) ([]byte, error)
+	PostSubResource(resourcePath string, id string, subResourcePath string) ([]byte, error)
+	GetSubResource(resourcePath string, id string, subResourcePath string) ([]byte, error)
+	PutSubResourceWithData(data InstanaDataObject, resourcePath string, subResourcePath string) ([]byte, error)
+	PutWithoutID(data interface{}, resourcePath string) ([]byte, error)
+	PostWithoutID(data interface{}, resourcePath string) ([]byte, error)
+	DeleteWithoutID(resourcePath string) error
+	DeleteByQuery(resourcePath string, queryParams map[string]string) error
 }
 
 type apiRequest struct {
@@ -88,6 +97,14 @@ func (client *restClientImpl) GetOne(id string, resourcePath string) ([]byte, er
 	return client.executeRequest(resty.MethodGet, url, req)
 }
 
+// GetByQuery request the resource from the given resource path using the given query parameters
+func (client *restClientImpl) GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	client.appendQueryParameters(req, queryParams)
+	return client.executeRequest(resty.MethodGet, url, req)
+}
+
 // Post executes a HTTP PUT request to create or update the given resource
 func (client *restClientImpl) Post(data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
@@ -133,6 +150,65 @@ func (client *restClientImpl) PutByQuery(resourcePath string, id string, queryPa
 	return client.executeRequest(resty.MethodPut, url, req)
 }
 
+// PutSubResource executes a HTTP PUT request without a body on the given sub resource path of the resource with the given ID
+func (client *restClientImpl) PutSubResource(resourcePath string, id string, subResourcePath string) ([]byte, error) {
+	url := client.buildSubResourceURL(resourcePath, id, subResourcePath)
+	req := client.createRequest()
+	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+}
+
+// PostSubResource executes a HTTP POST request without a body on the given sub resource path of the resource with the given ID
+func (client *restClientImpl) PostSubResource(resourcePath string, id string, subResourcePath string) ([]byte, error) {
+	url := client.buildSubResourceURL(resourcePath, id, subResourcePath)
+	req := client.createRequest()
+	return client.executeRequestWithThrottling(resty.MethodPost, url, req)
+}
+
+// GetSubResource request the given sub resource path of the resource with the given ID
+func (client *restClientImpl) GetSubResource(resourcePath string, id string, subResourcePath string) ([]byte, error) {
+	url := client.buildSubResourceURL(resourcePath, id, subResourcePath)
+	req := client.createRequest()
+	return client.executeRequest(resty.MethodGet, url, req)
+}
+
+// PutSubResourceWithData executes a HTTP PUT request to update the given sub resource path of the resource with the ID of the given InstanaDataObject
+func (client *restClientImpl) PutSubResourceWithData(data InstanaDataObject, resourcePath string, subResourcePath string) ([]byte, error) {
+	url := client.buildSubResourceURL(resourcePath, data.GetIDForResourcePath(), subResourcePath)
+	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+}
+
+// PutWithoutID executes a HTTP PUT request with the given data as body on the given resource path without appending an ID
+func (client *restClientImpl) PutWithoutID(data interface{}, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+}
+
+// PostWithoutID executes a HTTP POST request with the given data as body on the given resource path without appending an ID
+func (client *restClientImpl) PostWithoutID(data interface{}, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	return client.executeRequestWithThrottling(resty.MethodPost, url, req)
+}
+
+// DeleteWithoutID executes a HTTP DELETE request on the given resource path without appending an ID
+func (client *restClientImpl) DeleteWithoutID(resourcePath string) error {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	_, err := client.executeRequestWithThrottling(resty.MethodDelete, url, req)
+	return err
+}
+
+// DeleteByQuery executes a HTTP DELETE request on the given resource path identifying the resource by the given query parameters
+func (client *restClientImpl) DeleteByQuery(resourcePath string, queryParams map[string]string) error {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	client.appendQueryParameters(req, queryParams)
+	_, err := client.executeRequestWithThrottling(resty.MethodDelete, url, req)
+	return err
+}
+
 func (client *restClientImpl) createRequest() *resty.Request {
 	return client.restyClient.R().SetHeader("Accept", "application/json").SetHeader("Authorization", fmt.Sprintf("apiToken %s", client.apiToken))
 }
@@ -215,6 +291,14 @@ func (client *restClientImpl) buildResourceURL(resourceBasePath string, id strin
 	return client.buildURL(resourcePath)
 }
 
+func (client *restClientImpl) buildSubResourceURL(resourceBasePath string, id string, subResourcePath string) string {
+	resourceURL := client.buildResourceURL(resourceBasePath, id)
+	if strings.HasPrefix(subResourcePath, "/") {
+		return resourceURL + subResourcePath
+	}
+	return resourceURL + "/" + subResourcePath
+}
+
 func (client *restClientImpl) buildURL(resourcePath string) string {
 	return fmt.Sprintf("https://%s%s", client.host, resourcePath)
 }
@@ -88,6 +88,29 @@ func TestShouldReturnNotFoundErrorMessageForGetOneRequestWhenStatusIsNotEntityNo
 	verifyNotFoundResponse(data, err, t)
 }
 
+func TestShouldReturnDataForSuccessfulGetByQueryRequest(t *testing.T) {
+	queryParameters := map[string]string{"nameFilter": "shop", "page": "1"}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodGet, testPath, queryParameters, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.GetByQuery(testPath, queryParameters)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForGetByQueryRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	queryParameters := map[string]string{"nameFilter": "shop", "page": "1"}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodGet, testPath, queryParameters, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.GetByQuery(testPath, queryParameters)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func TestShouldReturnDataForSuccessfulPostRequest(t *testing.T) {
 	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPost, testPath)
 	defer httpServer.Close()
@@ -242,6 +265,172 @@ func TestShouldReturnErrorMessageForPutByQueryRequestWhenStatusIsNotASuccessStat
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldReturnDataForSuccessfulPutSubResourceRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID+"/sub")
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutSubResource(testPath, testID, "sub")
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnDataForSuccessfulPutSubResourceRequestWhenSubResourcePathStartsWithASlash(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID+"/sub")
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutSubResource(testPath, testID, "/sub")
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnEntityNotFoundErrorForPutSubResourceRequestWhenStatusIsNotFound(t *testing.T) {
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPathWithID+"/sub", http.StatusNotFound)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutSubResource(testPath, testID, "sub")
+
+	verifyNotFoundResponse(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutSubResourceRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPathWithID+"/sub", statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutSubResource(testPath, testID, "sub")
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPostSubResourceRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPost, testPathWithID+"/sub")
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PostSubResource(testPath, testID, "sub")
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnEntityNotFoundErrorForPostSubResourceRequestWhenStatusIsNotFound(t *testing.T) {
+	httpServer := setupAndStartHttpServer(http.MethodPost, testPathWithID+"/sub", http.StatusNotFound)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PostSubResource(testPath, testID, "sub")
+
+	verifyNotFoundResponse(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPostSubResourceRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPost, testPathWithID+"/sub", statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PostSubResource(testPath, testID, "sub")
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulGetSubResourceRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPathWithID+"/sub")
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.GetSubResource(testPath, testID, "sub")
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnEntityNotFoundErrorForGetSubResourceRequestWhenStatusIsNotFound(t *testing.T) {
+	httpServer := setupAndStartHttpServer(http.MethodGet, testPathWithID+"/sub", http.StatusNotFound)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.GetSubResource(testPath, testID, "sub")
+
+	verifyNotFoundResponse(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForGetSubResourceRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodGet, testPathWithID+"/sub", statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.GetSubResource(testPath, testID, "sub")
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPutSubResourceWithDataRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID+"/sub")
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutSubResourceWithData(testDataObject{id: testID}, testPath, "sub")
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutSubResourceWithDataRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPathWithID+"/sub", statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutSubResourceWithData(testDataObject{id: testID}, testPath, "sub")
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPutWithoutIDRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutWithoutID([]string{"a", "b"}, testPath)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutWithoutIDRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutWithoutID([]string{"a", "b"}, testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPostWithoutIDRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPost, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PostWithoutID([]string{"a", "b"}, testPath)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPostWithoutIDRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPost, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PostWithoutID([]string{"a", "b"}, testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 type testDataObject struct {
 	id string
 }
@@ -272,6 +461,50 @@ func TestShouldReturnErrorMessageForDeleteRequestWhenStatusIsNotASuccessStatusAn
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldReturnNothingForSuccessfulDeleteWithoutIDRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodDelete, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteWithoutID(testPath)
+
+	require.Nil(t, err)
+}
+
+func TestShouldReturnErrorMessageForDeleteWithoutIDRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodDelete, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteWithoutID(testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnNothingForSuccessfulDeleteByQueryRequest(t *testing.T) {
+	queryParameters := map[string]string{"email": "user@example.com"}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodDelete, testPath, queryParameters, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(testPath, queryParameters)
+
+	require.Nil(t, err)
+}
+
+func TestShouldReturnErrorMessageForDeleteByQueryRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	queryParameters := map[string]string{"email": "user@example.com"}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodDelete, testPath, queryParameters, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(testPath, queryParameters)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func setupAndStartHttpServerWithOKResponseCode(httpMethod string, fullPath string) testutils.TestHTTPServer {
 	return setupAndStartHttpServer(httpMethod, fullPath, 200)
 }
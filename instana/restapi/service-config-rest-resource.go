@@ -0,0 +1,150 @@
+package restapi
+
+import (
+	"fmt"
+	"sync"
+)
+
+// serviceConfigOrderMutex serializes all changes of service configs which may modify the tenant wide order of the service configs. The order is updated by replacing the full list of service config IDs. Concurrent changes would otherwise overwrite each other
+var serviceConfigOrderMutex sync.Mutex
+
+// NewServiceConfigRestResource creates a new REST resource for service configs. Besides the CRUD operations of the service configs the REST resource keeps the order of the service configs in sync using the order endpoint of the Instana API
+func NewServiceConfigRestResource(unmarshaller JSONUnmarshaller[*ServiceConfig], client RestClient) RestResource[*ServiceConfig] {
+	return &serviceConfigRestResource{
+		delegate: NewCreatePOSTUpdatePUTRestResource(ServiceConfigResourcePath, unmarshaller, client),
+		client:   client,
+	}
+}
+
+type serviceConfigRestResource struct {
+	delegate RestResource[*ServiceConfig]
+	client   RestClient
+}
+
+func (r *serviceConfigRestResource) GetAll() (*[]*ServiceConfig, error) {
+	objects, err := r.delegate.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for i, o := range *objects {
+		order := i
+		o.Order = &order
+	}
+	return objects, nil
+}
+
+func (r *serviceConfigRestResource) GetOne(id string) (*ServiceConfig, error) {
+	objects, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range *objects {
+		if o.ID == id {
+			return o, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *serviceConfigRestResource) Create(data *ServiceConfig) (*ServiceConfig, error) {
+	serviceConfigOrderMutex.Lock()
+	defer serviceConfigOrderMutex.Unlock()
+
+	if err := r.validateOrder(data); err != nil {
+		return data, err
+	}
+	result, err := r.delegate.Create(data)
+	if err != nil {
+		return result, err
+	}
+	return r.applyOrder(result.ID, data.Order)
+}
+
+func (r *serviceConfigRestResource) Update(data *ServiceConfig) (*ServiceConfig, error) {
+	serviceConfigOrderMutex.Lock()
+	defer serviceConfigOrderMutex.Unlock()
+
+	if err := r.validateOrder(data); err != nil {
+		return data, err
+	}
+	result, err := r.delegate.Update(data)
+	if err != nil {
+		return result, err
+	}
+	return r.applyOrder(result.ID, data.Order)
+}
+
+// validateOrder ensures that the requested order of the given service config is within the list of all service configs before the service config is created or updated
+func (r *serviceConfigRestResource) validateOrder(data *ServiceConfig) error {
+	if data.Order == nil {
+		return nil
+	}
+	objects, err := r.GetAll()
+	if err != nil {
+		return err
+	}
+	numberOfServiceConfigs := len(*objects)
+	if !r.containsServiceConfig(*objects, data.ID) {
+		numberOfServiceConfigs++
+	}
+	return r.checkOrderInRange(*data.Order, numberOfServiceConfigs)
+}
+
+func (r *serviceConfigRestResource) containsServiceConfig(objects []*ServiceConfig, id string) bool {
+	for _, o := range objects {
+		if o.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *serviceConfigRestResource) checkOrderInRange(order int, numberOfServiceConfigs int) error {
+	if order < 0 || order >= numberOfServiceConfigs {
+		return fmt.Errorf("position %d of service config is out of range; only %d service configs exist", order+1, numberOfServiceConfigs)
+	}
+	return nil
+}
+
+func (r *serviceConfigRestResource) applyOrder(id string, order *int) (*ServiceConfig, error) {
+	objects, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(*objects))
+	var current *ServiceConfig
+	for _, o := range *objects {
+		if o.ID == id {
+			current = o
+		} else {
+			ids = append(ids, o.ID)
+		}
+	}
+	if current == nil {
+		return nil, ErrEntityNotFound
+	}
+	if order == nil || *order == *current.Order {
+		return current, nil
+	}
+
+	if err = r.checkOrderInRange(*order, len(*objects)); err != nil {
+		return nil, err
+	}
+
+	position := *order
+	ids = append(ids[:position], append([]string{id}, ids[position:]...)...)
+	if _, err = r.client.PutWithoutID(ids, ServiceConfigOrderResourcePath); err != nil {
+		return nil, err
+	}
+	current.Order = &position
+	return current, nil
+}
+
+func (r *serviceConfigRestResource) Delete(data *ServiceConfig) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *serviceConfigRestResource) DeleteByID(id string) error {
+	return r.delegate.DeleteByID(id)
+}
@@ -0,0 +1,296 @@
+package restapi_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const serviceConfigListJSON = `[
+	{"id":"a","name":"A","label":"{docker.container.name}","enabled":true,"matchSpecification":[{"key":"docker.container.name","value":".*"}]},
+	{"id":"b","name":"B","label":"{kubernetes.container.name}","enabled":true,"matchSpecification":[{"key":"kubernetes.container.name","value":".*"}]},
+	{"id":"c","name":"C","label":"{jvm.args.abc}","enabled":false,"matchSpecification":[{"key":"jvm.args.abc","value":".*"}]}
+]`
+
+const serviceConfigListWithoutConfigCJSON = `[
+	{"id":"a","name":"A","label":"{docker.container.name}","enabled":true,"matchSpecification":[{"key":"docker.container.name","value":".*"}]},
+	{"id":"b","name":"B","label":"{kubernetes.container.name}","enabled":true,"matchSpecification":[{"key":"kubernetes.container.name","value":".*"}]}
+]`
+
+const serviceConfigJSON = `{"id":"c","name":"C","label":"{jvm.args.abc}","enabled":false,"matchSpecification":[{"key":"jvm.args.abc","value":".*"}]}`
+
+func createServiceConfigRestResource(client RestClient) RestResource[*ServiceConfig] {
+	return NewServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ServiceConfig{}), client)
+}
+
+func TestShouldGetAllServiceConfigsWithTheirOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigListJSON), nil)
+
+	result, err := createServiceConfigRestResource(client).GetAll()
+
+	require.NoError(t, err)
+	require.Len(t, *result, 3)
+	for i, config := range *result {
+		require.Equal(t, i, *config.Order)
+	}
+}
+
+func TestShouldGetOneServiceConfigFromListOfAllServiceConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigListJSON), nil)
+	client.EXPECT().GetOne(gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := createServiceConfigRestResource(client).GetOne("b")
+
+	require.NoError(t, err)
+	require.Equal(t, "b", result.ID)
+	require.Equal(t, 1, *result.Order)
+}
+
+func TestShouldReturnEntityNotFoundWhenServiceConfigIsNotAvailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigListJSON), nil)
+
+	_, err := createServiceConfigRestResource(client).GetOne("d")
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneServiceConfigWhenListCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := createServiceConfigRestResource(client).GetOne("a")
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldCreateServiceConfigAndMoveItToTheRequestedPosition(t *testing.T) {
+	order := 0
+	config := &ServiceConfig{Name: "C", Label: "{jvm.args.abc}", Order: &order}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigListWithoutConfigCJSON), nil),
+		client.EXPECT().Post(config, ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigJSON), nil),
+		client.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigListJSON), nil),
+		client.EXPECT().PutWithoutID([]string{"c", "a", "b"}, ServiceConfigOrderResourcePath).Times(1).Return([]byte{}, nil),
+	)
+
+	result, err := createServiceConfigRestResource(client).Create(config)
+
+	require.NoError(t, err)
+	require.Equal(t, "c", result.ID)
+	require.Equal(t, 0, *result.Order)
+}
+
+func TestShouldCreateServiceConfigWithoutReorderingWhenNoOrderIsRequested(t *testing.T) {
+	config := &ServiceConfig{Name: "C", Label: "{jvm.args.abc}"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Post(config, ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigJSON), nil)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigListJSON), nil)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := createServiceConfigRestResource(client).Create(config)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, *result.Order)
+}
+
+func TestShouldUpdateServiceConfigWithoutReorderingWhenServiceConfigIsAlreadyAtTheRequestedPosition(t *testing.T) {
+	order := 2
+	config := &ServiceConfig{ID: "c", Name: "C", Label: "{jvm.args.abc}", Order: &order}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(config, ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigJSON), nil)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(2).Return([]byte(serviceConfigListJSON), nil)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := createServiceConfigRestResource(client).Update(config)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, *result.Order)
+}
+
+func TestShouldUpdateServiceConfigAndMoveItToTheEndWhenTheLastPositionIsRequested(t *testing.T) {
+	order := 2
+	config := &ServiceConfig{ID: "a", Name: "A", Label: "{docker.container.name}", Order: &order}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(config, ServiceConfigResourcePath).Times(1).Return([]byte(`{"id":"a"}`), nil)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(2).Return([]byte(serviceConfigListJSON), nil)
+	client.EXPECT().PutWithoutID([]string{"b", "c", "a"}, ServiceConfigOrderResourcePath).Times(1).Return([]byte{}, nil)
+
+	result, err := createServiceConfigRestResource(client).Update(config)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, *result.Order)
+}
+
+func TestShouldFailToUpdateServiceConfigWhenRequestedPositionExceedsTheNumberOfServiceConfigs(t *testing.T) {
+	order := 3
+	config := &ServiceConfig{ID: "a", Name: "A", Label: "{docker.container.name}", Order: &order}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigListJSON), nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := createServiceConfigRestResource(client).Update(config)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "position 4 of service config is out of range; only 3 service configs exist")
+}
+
+func TestShouldFailToCreateServiceConfigWhenRequestedPositionExceedsTheNumberOfServiceConfigs(t *testing.T) {
+	order := 4
+	config := &ServiceConfig{Name: "D", Label: "{jvm.args.def}", Order: &order}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigListJSON), nil)
+	client.EXPECT().Post(gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := createServiceConfigRestResource(client).Create(config)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "position 5 of service config is out of range; only 4 service configs exist")
+}
+
+func TestShouldSerializeConcurrentReorderingOfServiceConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var lock sync.Mutex
+	currentOrder := []string{"a", "b", "c"}
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(ServiceConfigResourcePath).AnyTimes().DoAndReturn(func(_ string) ([]byte, error) {
+		lock.Lock()
+		configs := make([]string, len(currentOrder))
+		for i, id := range currentOrder {
+			configs[i] = fmt.Sprintf(`{"id":"%s","name":"%s"}`, id, id)
+		}
+		lock.Unlock()
+		//give a concurrent reordering the chance to read the same list before the order is updated
+		time.Sleep(20 * time.Millisecond)
+		return []byte("[" + strings.Join(configs, ",") + "]"), nil
+	})
+	client.EXPECT().Put(gomock.Any(), ServiceConfigResourcePath).Times(2).DoAndReturn(func(data InstanaDataObject, _ string) ([]byte, error) {
+		return []byte(fmt.Sprintf(`{"id":"%s"}`, data.GetIDForResourcePath())), nil
+	})
+	client.EXPECT().PutWithoutID(gomock.Any(), ServiceConfigOrderResourcePath).Times(2).DoAndReturn(func(data interface{}, _ string) ([]byte, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		currentOrder = data.([]string)
+		return []byte{}, nil
+	})
+
+	moveAToTheEnd := 2
+	moveCToTheBeginning := 0
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	for _, config := range []*ServiceConfig{{ID: "a", Name: "a", Order: &moveAToTheEnd}, {ID: "c", Name: "c", Order: &moveCToTheBeginning}} {
+		wg.Add(1)
+		go func(c *ServiceConfig) {
+			defer wg.Done()
+			_, err := createServiceConfigRestResource(client).Update(c)
+			errs <- err
+		}(config)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"c", "b", "a"}, currentOrder)
+}
+
+func TestShouldFailToUpdateServiceConfigWhenOrderCannotBeUpdated(t *testing.T) {
+	order := 1
+	config := &ServiceConfig{ID: "a", Name: "A", Label: "{docker.container.name}", Order: &order}
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(config, ServiceConfigResourcePath).Times(1).Return([]byte(`{"id":"a"}`), nil)
+	client.EXPECT().Get(ServiceConfigResourcePath).Times(2).Return([]byte(serviceConfigListJSON), nil)
+	client.EXPECT().PutWithoutID([]string{"b", "a", "c"}, ServiceConfigOrderResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := createServiceConfigRestResource(client).Update(config)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToCreateServiceConfigWhenClientReturnsError(t *testing.T) {
+	config := &ServiceConfig{Name: "C"}
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Post(config, ServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().Get(gomock.Any()).Times(0)
+
+	_, err := createServiceConfigRestResource(client).Create(config)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDeleteServiceConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Delete("a", ServiceConfigResourcePath).Times(2).Return(nil)
+
+	sut := createServiceConfigRestResource(client)
+
+	require.NoError(t, sut.Delete(&ServiceConfig{ID: "a"}))
+	require.NoError(t, sut.DeleteByID("a"))
+}
@@ -0,0 +1,32 @@
+package restapi
+
+const (
+	//ServiceConfigResourcePath path to service config resource of Instana RESTful API
+	ServiceConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/service"
+	//ServiceConfigOrderResourcePath path to the resource of Instana RESTful API to define the order of all service configs
+	ServiceConfigOrderResourcePath = ServiceConfigResourcePath + "/order"
+)
+
+// ServiceMatchingRule is the representation of a single matching rule of a service config in Instana
+type ServiceMatchingRule struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ServiceConfig is the representation of a service config (service mapping rule) in Instana. The order of the service
+// config is not part of the API model. It is derived from the position of the service config in the list of all
+// service configs and maintained through the order endpoint.
+type ServiceConfig struct {
+	ID                 string                `json:"id,omitempty"`
+	Name               string                `json:"name"`
+	Comment            *string               `json:"comment"`
+	Label              string                `json:"label"`
+	Enabled            bool                  `json:"enabled"`
+	MatchSpecification []ServiceMatchingRule `json:"matchSpecification"`
+	Order              *int                  `json:"-"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
@@ -0,0 +1,83 @@
+package restapi
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+// NewSingletonConfigRestResource creates a new REST resource for configurations which exist exactly once per Instana tenant (e.g. the identity provider configuration). Such configurations cannot be created or deleted. Therefore, they are updated using HTTP PUT on create and reset to the provided default configuration on delete
+func NewSingletonConfigRestResource[T InstanaDataObject](resourcePath string, unmarshaller JSONUnmarshaller[T], client RestClient, defaultConfigFactory func() T) RestResource[T] {
+	return &singletonConfigRestResource[T]{
+		resourcePath:         resourcePath,
+		unmarshaller:         unmarshaller,
+		client:               client,
+		defaultConfigFactory: defaultConfigFactory,
+	}
+}
+
+// NewDeletableSingletonConfigRestResource creates a new REST resource for configurations which exist at most once per Instana tenant (e.g. the global custom payload configuration). Such configurations are created and updated using HTTP PUT and deleted using HTTP DELETE without an ID
+func NewDeletableSingletonConfigRestResource[T InstanaDataObject](resourcePath string, unmarshaller JSONUnmarshaller[T], client RestClient) RestResource[T] {
+	return &singletonConfigRestResource[T]{
+		resourcePath: resourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type singletonConfigRestResource[T InstanaDataObject] struct {
+	resourcePath         string
+	unmarshaller         JSONUnmarshaller[T]
+	client               RestClient
+	defaultConfigFactory func() T
+}
+
+func (r *singletonConfigRestResource[T]) GetAll() (*[]T, error) {
+	object, err := r.get()
+	if err != nil {
+		return nil, err
+	}
+	return &[]T{object}, nil
+}
+
+func (r *singletonConfigRestResource[T]) GetOne(_ string) (T, error) {
+	return r.get()
+}
+
+func (r *singletonConfigRestResource[T]) get() (T, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return utils.GetZeroValue[T](), err
+	}
+	return r.unmarshaller.Unmarshal(data)
+}
+
+func (r *singletonConfigRestResource[T]) Create(data T) (T, error) {
+	return r.upsert(data)
+}
+
+func (r *singletonConfigRestResource[T]) Update(data T) (T, error) {
+	return r.upsert(data)
+}
+
+func (r *singletonConfigRestResource[T]) upsert(data T) (T, error) {
+	_, err := r.client.PutWithoutID(data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.get()
+}
+
+func (r *singletonConfigRestResource[T]) Delete(_ T) error {
+	return r.reset()
+}
+
+func (r *singletonConfigRestResource[T]) DeleteByID(_ string) error {
+	return r.reset()
+}
+
+func (r *singletonConfigRestResource[T]) reset() error {
+	if r.defaultConfigFactory == nil {
+		return r.client.DeleteWithoutID(r.resourcePath)
+	}
+	_, err := r.client.PutWithoutID(r.defaultConfigFactory(), r.resourcePath)
+	return err
+}
@@ -0,0 +1,173 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const singletonConfigPath = "/singleton"
+
+var singletonConfigSerialized = []byte("serialized")
+
+func createSingletonConfigRestResource(unmarshaller JSONUnmarshaller[*IdentityProviderConfig], client RestClient) RestResource[*IdentityProviderConfig] {
+	return NewSingletonConfigRestResource(singletonConfigPath, unmarshaller, client, NewDefaultIdentityProviderConfig)
+}
+
+func TestShouldSuccessfullyExecuteGetAllOperationOfSingletonConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IdentityProviderConfig](ctrl)
+	expected := &IdentityProviderConfig{RestrictEmptyIdpGroups: true}
+
+	client.EXPECT().Get(singletonConfigPath).Times(1).Return(singletonConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(singletonConfigSerialized).Times(1).Return(expected, nil)
+
+	sut := createSingletonConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*IdentityProviderConfig{expected}, result)
+}
+
+func TestShouldSuccessfullyExecuteGetOperationOfSingletonConfigRestResourceIndependentOfTheProvidedID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IdentityProviderConfig](ctrl)
+	expected := &IdentityProviderConfig{RestrictEmptyIdpGroups: true}
+
+	client.EXPECT().Get(singletonConfigPath).Times(1).Return(singletonConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(singletonConfigSerialized).Times(1).Return(expected, nil)
+
+	sut := createSingletonConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne("any-id")
+
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOperationOfSingletonConfigRestResourceAndGetOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IdentityProviderConfig](ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(singletonConfigPath).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := createSingletonConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(IdentityProviderConfigID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyExecuteCreateAndUpdateOperationOfSingletonConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IdentityProviderConfig](ctrl)
+	config := &IdentityProviderConfig{RestrictEmptyIdpGroups: true}
+
+	client.EXPECT().PutWithoutID(config, singletonConfigPath).Times(2).Return([]byte{}, nil)
+	client.EXPECT().Get(singletonConfigPath).Times(2).Return(singletonConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(singletonConfigSerialized).Times(2).Return(config, nil)
+
+	sut := createSingletonConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(config)
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+
+	result, err = sut.Update(config)
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldReturnErrorWhenExecutingUpdateOperationOfSingletonConfigRestResourceAndPutOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IdentityProviderConfig](ctrl)
+	config := &IdentityProviderConfig{RestrictEmptyIdpGroups: true}
+	expectedError := errors.New("test")
+
+	client.EXPECT().PutWithoutID(config, singletonConfigPath).Times(1).Return(nil, expectedError)
+	client.EXPECT().Get(gomock.Any()).Times(0)
+
+	sut := createSingletonConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Update(config)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldResetConfigToDefaultWhenExecutingDeleteOperationOfSingletonConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IdentityProviderConfig](ctrl)
+
+	client.EXPECT().PutWithoutID(NewDefaultIdentityProviderConfig(), singletonConfigPath).Times(2).Return([]byte{}, nil)
+
+	sut := createSingletonConfigRestResource(unmarshaller, client)
+
+	require.NoError(t, sut.Delete(&IdentityProviderConfig{RestrictEmptyIdpGroups: true}))
+	require.NoError(t, sut.DeleteByID(IdentityProviderConfigID))
+}
+
+func TestShouldReturnErrorWhenExecutingDeleteByIDOperationOfSingletonConfigRestResourceAndPutOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IdentityProviderConfig](ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().PutWithoutID(gomock.Any(), singletonConfigPath).Times(1).Return(nil, expectedError)
+
+	sut := createSingletonConfigRestResource(unmarshaller, client)
+
+	err := sut.DeleteByID(IdentityProviderConfigID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDeleteConfigWhenExecutingDeleteOperationOfDeletableSingletonConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IdentityProviderConfig](ctrl)
+
+	client.EXPECT().DeleteWithoutID(singletonConfigPath).Times(2).Return(nil)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewDeletableSingletonConfigRestResource(singletonConfigPath, unmarshaller, client)
+
+	require.NoError(t, sut.Delete(&IdentityProviderConfig{RestrictEmptyIdpGroups: true}))
+	require.NoError(t, sut.DeleteByID(IdentityProviderConfigID))
+}
+
+func TestShouldReturnErrorWhenExecutingDeleteOperationOfDeletableSingletonConfigRestResourceAndDeleteOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IdentityProviderConfig](ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().DeleteWithoutID(singletonConfigPath).Times(1).Return(expectedError)
+
+	sut := NewDeletableSingletonConfigRestResource(singletonConfigPath, unmarshaller, client)
+
+	err := sut.DeleteByID(IdentityProviderConfigID)
+
+	require.Equal(t, expectedError, err)
+}
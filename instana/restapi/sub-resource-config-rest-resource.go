@@ -0,0 +1,70 @@
+package restapi
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+// NewSubResourceConfigRestResource creates a new REST resource for configurations which are provided as sub resource of another resource of the Instana REST API (e.g. the ip masking configuration of a website). Such configurations exist as long as the parent resource exists. Therefore, they are updated using HTTP PUT on create and reset to the provided default configuration on delete
+func NewSubResourceConfigRestResource[T InstanaSubResourceDataObject](resourcePath string, subResourcePath string, unmarshaller JSONUnmarshaller[T], client RestClient, defaultConfigFactory func() T) RestResource[T] {
+	return &subResourceConfigRestResource[T]{
+		resourcePath:         resourcePath,
+		subResourcePath:      subResourcePath,
+		unmarshaller:         unmarshaller,
+		client:               client,
+		defaultConfigFactory: defaultConfigFactory,
+	}
+}
+
+type subResourceConfigRestResource[T InstanaSubResourceDataObject] struct {
+	resourcePath         string
+	subResourcePath      string
+	unmarshaller         JSONUnmarshaller[T]
+	client               RestClient
+	defaultConfigFactory func() T
+}
+
+func (r *subResourceConfigRestResource[T]) GetAll() (*[]T, error) {
+	return nil, fmt.Errorf("get all is not supported for %s/{id}/%s", r.resourcePath, r.subResourcePath)
+}
+
+func (r *subResourceConfigRestResource[T]) GetOne(id string) (T, error) {
+	data, err := r.client.GetSubResource(r.resourcePath, id, r.subResourcePath)
+	if err != nil {
+		return utils.GetZeroValue[T](), err
+	}
+	object, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return utils.GetZeroValue[T](), err
+	}
+	object.SetIDForResourcePath(id)
+	return object, nil
+}
+
+func (r *subResourceConfigRestResource[T]) Create(data T) (T, error) {
+	return r.upsert(data)
+}
+
+func (r *subResourceConfigRestResource[T]) Update(data T) (T, error) {
+	return r.upsert(data)
+}
+
+func (r *subResourceConfigRestResource[T]) upsert(data T) (T, error) {
+	_, err := r.client.PutSubResourceWithData(data, r.resourcePath, r.subResourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.GetOne(data.GetIDForResourcePath())
+}
+
+func (r *subResourceConfigRestResource[T]) Delete(data T) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *subResourceConfigRestResource[T]) DeleteByID(id string) error {
+	defaultConfig := r.defaultConfigFactory()
+	defaultConfig.SetIDForResourcePath(id)
+	_, err := r.client.PutSubResourceWithData(defaultConfig, r.resourcePath, r.subResourcePath)
+	return err
+}
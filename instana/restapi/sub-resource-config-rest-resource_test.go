@@ -0,0 +1,165 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	subResourceConfigParentPath = "/parent"
+	subResourceConfigSubPath    = "sub"
+	subResourceConfigID         = "parent-id"
+)
+
+var subResourceConfigSerialized = []byte("serialized")
+
+func makeSubResourceConfigTestObject(ipMasking IPMasking) *IPMaskingConfig {
+	return &IPMaskingConfig{ID: subResourceConfigID, IPMasking: ipMasking}
+}
+
+func createSubResourceConfigRestResource(unmarshaller JSONUnmarshaller[*IPMaskingConfig], client RestClient) RestResource[*IPMaskingConfig] {
+	return NewSubResourceConfigRestResource(subResourceConfigParentPath, subResourceConfigSubPath, unmarshaller, client, NewDefaultIPMaskingConfig)
+}
+
+func TestShouldReturnErrorWhenExecutingGetAllOperationOfSubResourceConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IPMaskingConfig](ctrl)
+
+	sut := createSubResourceConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetAll()
+
+	require.ErrorContains(t, err, "get all is not supported for /parent/{id}/sub")
+}
+
+func TestShouldSuccessfullyExecuteGetOperationOfSubResourceConfigRestResourceAndSetIDOfParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IPMaskingConfig](ctrl)
+
+	client.EXPECT().GetSubResource(subResourceConfigParentPath, subResourceConfigID, subResourceConfigSubPath).Times(1).Return(subResourceConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(subResourceConfigSerialized).Times(1).Return(&IPMaskingConfig{IPMasking: IPMaskingStrict}, nil)
+
+	sut := createSubResourceConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(subResourceConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, makeSubResourceConfigTestObject(IPMaskingStrict), result)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOperationOfSubResourceConfigRestResourceAndGetOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IPMaskingConfig](ctrl)
+	expectedError := errors.New("Error")
+
+	client.EXPECT().GetSubResource(subResourceConfigParentPath, subResourceConfigID, subResourceConfigSubPath).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := createSubResourceConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(subResourceConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOperationOfSubResourceConfigRestResourceAndUnmarshallingFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IPMaskingConfig](ctrl)
+	expectedError := errors.New("Error")
+
+	client.EXPECT().GetSubResource(subResourceConfigParentPath, subResourceConfigID, subResourceConfigSubPath).Times(1).Return(subResourceConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(subResourceConfigSerialized).Times(1).Return(nil, expectedError)
+
+	sut := createSubResourceConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(subResourceConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyExecuteCreateOperationOfSubResourceConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IPMaskingConfig](ctrl)
+	config := makeSubResourceConfigTestObject(IPMaskingStrict)
+
+	gomock.InOrder(
+		client.EXPECT().PutSubResourceWithData(config, subResourceConfigParentPath, subResourceConfigSubPath).Times(1).Return(nil, nil),
+		client.EXPECT().GetSubResource(subResourceConfigParentPath, subResourceConfigID, subResourceConfigSubPath).Times(1).Return(subResourceConfigSerialized, nil),
+	)
+	unmarshaller.EXPECT().Unmarshal(subResourceConfigSerialized).Times(1).Return(&IPMaskingConfig{IPMasking: IPMaskingStrict}, nil)
+
+	sut := createSubResourceConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldReturnErrorWhenExecutingUpdateOperationOfSubResourceConfigRestResourceAndPutOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IPMaskingConfig](ctrl)
+	expectedError := errors.New("Error")
+	config := makeSubResourceConfigTestObject(IPMaskingStrict)
+
+	client.EXPECT().PutSubResourceWithData(config, subResourceConfigParentPath, subResourceConfigSubPath).Times(1).Return(nil, expectedError)
+	client.EXPECT().GetSubResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := createSubResourceConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Update(config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldResetConfigToDefaultWhenExecutingDeleteOperationOfSubResourceConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IPMaskingConfig](ctrl)
+
+	client.EXPECT().PutSubResourceWithData(makeSubResourceConfigTestObject(IPMaskingDefault), subResourceConfigParentPath, subResourceConfigSubPath).Times(1).Return(nil, nil)
+
+	sut := createSubResourceConfigRestResource(unmarshaller, client)
+
+	err := sut.Delete(makeSubResourceConfigTestObject(IPMaskingStrict))
+
+	require.NoError(t, err)
+}
+
+func TestShouldReturnErrorWhenExecutingDeleteByIDOperationOfSubResourceConfigRestResourceAndPutOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*IPMaskingConfig](ctrl)
+	expectedError := errors.New("Error")
+
+	client.EXPECT().PutSubResourceWithData(makeSubResourceConfigTestObject(IPMaskingDefault), subResourceConfigParentPath, subResourceConfigSubPath).Times(1).Return(nil, expectedError)
+
+	sut := createSubResourceConfigRestResource(unmarshaller, client)
+
+	err := sut.DeleteByID(subResourceConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
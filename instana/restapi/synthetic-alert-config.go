@@ -0,0 +1,33 @@
+package restapi
+
+// SyntheticAlertConfigResourcePath path to synthetic alert config resource of Instana RESTful API
+const SyntheticAlertConfigResourcePath = EventSettingsBasePath + "/global-alert-configs/synthetics"
+
+// SyntheticAlertConfig is the representation of a synthetic alert configuration in Instana
+type SyntheticAlertConfig struct {
+	ID                    string                    `json:"id"`
+	Name                  string                    `json:"name"`
+	Description           string                    `json:"description"`
+	Severity              int                       `json:"severity"`
+	SyntheticTestIDs      []string                  `json:"syntheticTestIds"`
+	TagFilterExpression   *TagFilter                `json:"tagFilterExpression"`
+	AlertChannelIDs       []string                  `json:"alertChannelIds"`
+	CustomerPayloadFields []CustomPayloadField[any] `json:"customPayloadFields"`
+	Rule                  SyntheticAlertRule        `json:"rule"`
+	TimeThreshold         SyntheticTimeThreshold    `json:"timeThreshold"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *SyntheticAlertConfig) GetIDForResourcePath() string {
+	return r.ID
+}
+
+// GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (a *SyntheticAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
+	return a.CustomerPayloadFields
+}
+
+// SetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (a *SyntheticAlertConfig) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
+	a.CustomerPayloadFields = fields
+}
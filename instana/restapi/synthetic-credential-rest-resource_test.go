@@ -0,0 +1,148 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const syntheticCredentialName = "my_credential"
+
+func TestShouldGetAllSyntheticCredentialsFromListOfNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return([]byte(`["a","b"]`), nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*SyntheticCredential{{Name: "a"}, {Name: "b"}}, result)
+}
+
+func TestShouldFailToGetAllSyntheticCredentialsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.GetAll()
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetAllSyntheticCredentialsWhenResponseIsNotAValidListOfNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return([]byte(`{"foo":"bar"}`), nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to parse json")
+}
+
+func TestShouldGetSingleSyntheticCredentialByNameFromListOfNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return([]byte(`["other","my_credential"]`), nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	result, err := sut.GetOne(syntheticCredentialName)
+
+	require.NoError(t, err)
+	require.Equal(t, &SyntheticCredential{Name: syntheticCredentialName}, result)
+}
+
+func TestShouldReturnEntityNotFoundErrorWhenSyntheticCredentialDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return([]byte(`["other"]`), nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.GetOne(syntheticCredentialName)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldCreateSyntheticCredentialAndReturnItWithoutValue(t *testing.T) {
+	credential := &SyntheticCredential{Name: syntheticCredentialName, Value: "secret"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Post(credential, SyntheticCredentialResourcePath).Times(1).Return([]byte{}, nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	result, err := sut.Create(credential)
+
+	require.NoError(t, err)
+	require.Equal(t, &SyntheticCredential{Name: syntheticCredentialName}, result)
+}
+
+func TestShouldFailToCreateSyntheticCredentialWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+	credential := &SyntheticCredential{Name: syntheticCredentialName, Value: "secret"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Post(credential, SyntheticCredentialResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.Create(credential)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldNotSupportUpdateOfSyntheticCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.Update(&SyntheticCredential{Name: syntheticCredentialName, Value: "secret"})
+
+	require.Error(t, err)
+}
+
+func TestShouldDeleteSyntheticCredentialByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Delete(syntheticCredentialName, SyntheticCredentialResourcePath).Times(2).Return(nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	require.NoError(t, sut.Delete(&SyntheticCredential{Name: syntheticCredentialName}))
+	require.NoError(t, sut.DeleteByID(syntheticCredentialName))
+}
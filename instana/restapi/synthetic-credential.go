@@ -0,0 +1,16 @@
+package restapi
+
+// SyntheticCredentialResourcePath path to synthetic credentials of the Instana RESTful API
+const SyntheticCredentialResourcePath = SyntheticSettingsBasePath + "/credentials"
+
+// SyntheticCredential is the representation of a credential which can be referenced by synthetic script tests. The
+// value of the credential is stored encrypted by Instana and cannot be retrieved after creation
+type SyntheticCredential struct {
+	Name  string `json:"credentialName"`
+	Value string `json:"credentialValue"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *SyntheticCredential) GetIDForResourcePath() string {
+	return c.Name
+}
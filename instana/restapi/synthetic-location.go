@@ -1,10 +1,27 @@
 package restapi
 
 type SyntheticLocation struct {
-	ID           string `json:"id"`
-	Label        string `json:"label"`
-	Description  string `json:"description"`
-	LocationType string `json:"locationType"`
+	ID                   string                        `json:"id"`
+	Label                string                        `json:"label"`
+	DisplayLabel         string                        `json:"displayLabel"`
+	Description          string                        `json:"description"`
+	LocationType         string                        `json:"locationType"`
+	GeoPoint             SyntheticGeoPoint             `json:"geoPoint"`
+	PlaybackCapabilities SyntheticPlaybackCapabilities `json:"playbackCapabilities"`
+}
+
+// SyntheticGeoPoint the geographic location of a SyntheticLocation
+type SyntheticGeoPoint struct {
+	CityName    string  `json:"cityName"`
+	CountryName string  `json:"countryName"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+}
+
+// SyntheticPlaybackCapabilities the browser and synthetic test types supported by a SyntheticLocation
+type SyntheticPlaybackCapabilities struct {
+	BrowserType   []string `json:"browserType"`
+	SyntheticType []string `json:"syntheticType"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject for SyntheticLocation
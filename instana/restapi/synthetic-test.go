@@ -16,8 +16,28 @@ type SyntheticTestConfig struct {
 	AllowInsecure    *bool                  `json:"allowInsecure"`
 	ExpectStatus     *int32                 `json:"expectStatus"`
 	ExpectMatch      *string                `json:"expectMatch"`
-	// HttpScript
-	Script *string `json:"script"`
+	// HttpScript, BrowserScript and WebpageScript
+	Script     *string                       `json:"script"`
+	ScriptType *string                       `json:"scriptType"`
+	Scripts    *MultipleScriptsConfiguration `json:"scripts"`
+	// BrowserScript, WebpageAction and WebpageScript
+	Browser     *string `json:"browser"`
+	RecordVideo *bool   `json:"recordVideo"`
+	// DNSAction
+	Lookup           *string `json:"lookup"`
+	Server           *string `json:"server"`
+	Port             *int32  `json:"port"`
+	QueryType        *string `json:"queryType"`
+	AcceptCNAME      *bool   `json:"acceptCNAME"`
+	LookupServerName *bool   `json:"lookupServerName"`
+	RecursiveLookups *bool   `json:"recursiveLookups"`
+	ServerRetries    *int32  `json:"serverRetries"`
+}
+
+// MultipleScriptsConfiguration the configuration of synthetic tests which are composed of multiple script files
+type MultipleScriptsConfiguration struct {
+	Bundle     *string `json:"bundle"`
+	ScriptFile *string `json:"scriptFile"`
 }
 
 type SyntheticTest struct {
@@ -0,0 +1,216 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	//UsersResourcePath path to the users resource of Instana RESTful API
+	UsersResourcePath = SettingsBasePath + "/users"
+	//UsersOverviewResourcePath path to the overview of users and pending invitations of Instana RESTful API
+	UsersOverviewResourcePath = UsersResourcePath + "/overview"
+	//UserInvitationsResourcePath path to the user invitations resource of Instana RESTful API
+	UserInvitationsResourcePath = SettingsBasePath + "/invitations"
+
+	//InvitationStatusSuccess constant value for the status of a successful invitation
+	InvitationStatusSuccess = "SUCCESS"
+)
+
+// User data structure for the Instana API model for users
+type User struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	FullName string `json:"fullName"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (u *User) GetIDForResourcePath() string {
+	return u.ID
+}
+
+// PendingInvitation data structure for the Instana API model of invitations which are not yet accepted
+type PendingInvitation struct {
+	ID      string `json:"id"`
+	Email   string `json:"email"`
+	GroupID string `json:"groupId"`
+}
+
+// UsersOverview data structure for the Instana API model of the overview of all users including pending invitations
+type UsersOverview struct {
+	Users       []*User              `json:"users"`
+	Invitations []*PendingInvitation `json:"invitations"`
+}
+
+// FindUserByEmail returns the user with the given email address or nil when no such user exists. Email addresses are compared case-insensitive
+func (o *UsersOverview) FindUserByEmail(email string) *User {
+	for _, u := range o.Users {
+		if strings.EqualFold(u.Email, email) {
+			return u
+		}
+	}
+	return nil
+}
+
+// FindInvitationByEmail returns the pending invitation of the given email address or nil when no such invitation exists. Email addresses are compared case-insensitive
+func (o *UsersOverview) FindInvitationByEmail(email string) *PendingInvitation {
+	for _, i := range o.Invitations {
+		if strings.EqualFold(i.Email, email) {
+			return i
+		}
+	}
+	return nil
+}
+
+// UsersOverviewResource interface definition of the overview of all users including pending invitations
+type UsersOverviewResource interface {
+	Get() (*UsersOverview, error)
+}
+
+// NewUsersOverviewResource creates a new UsersOverviewResource
+func NewUsersOverviewResource(client RestClient) UsersOverviewResource {
+	return &usersOverviewResource{client: client}
+}
+
+type usersOverviewResource struct {
+	client RestClient
+}
+
+func (r *usersOverviewResource) Get() (*UsersOverview, error) {
+	data, err := r.client.Get(UsersOverviewResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	overview := &UsersOverview{}
+	if err = json.Unmarshal(data, overview); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return overview, nil
+}
+
+// UserInvitation data structure for the Instana API model for user invitations. The invitation is identified by the email address
+type UserInvitation struct {
+	Email   string `json:"email"`
+	GroupID string `json:"groupId"`
+	//UserID is not part of the API model. It is set when the invitation is accepted
+	UserID string `json:"-"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (i *UserInvitation) GetIDForResourcePath() string {
+	return i.Email
+}
+
+// InvitationResult data structure for the Instana API model of the result of a single invitation
+type InvitationResult struct {
+	UserEmail        string `json:"userEmail"`
+	InvitationStatus string `json:"invitationStatus"`
+}
+
+// InvitationResponse data structure for the Instana API model of the response of the invitation of users
+type InvitationResponse struct {
+	InvitationResults []*InvitationResult `json:"invitationResults"`
+}
+
+// NewUserInvitationRestResource creates a new REST resource for user invitations. Invitations cannot be updated. Once an invitation is accepted it is represented by the created user
+func NewUserInvitationRestResource(overview UsersOverviewResource, client RestClient) RestResource[*UserInvitation] {
+	return &userInvitationRestResource{
+		overview: overview,
+		client:   client,
+	}
+}
+
+type userInvitationRestResource struct {
+	overview UsersOverviewResource
+	client   RestClient
+}
+
+func (r *userInvitationRestResource) GetAll() (*[]*UserInvitation, error) {
+	overview, err := r.overview.Get()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*UserInvitation, len(overview.Invitations))
+	for i, invitation := range overview.Invitations {
+		result[i] = &UserInvitation{Email: invitation.Email, GroupID: invitation.GroupID}
+	}
+	return &result, nil
+}
+
+func (r *userInvitationRestResource) GetOne(email string) (*UserInvitation, error) {
+	overview, err := r.overview.Get()
+	if err != nil {
+		return nil, err
+	}
+	if invitation := overview.FindInvitationByEmail(email); invitation != nil {
+		return &UserInvitation{Email: invitation.Email, GroupID: invitation.GroupID}, nil
+	}
+	if user := overview.FindUserByEmail(email); user != nil {
+		return &UserInvitation{Email: user.Email, UserID: user.ID}, nil
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *userInvitationRestResource) Create(data *UserInvitation) (*UserInvitation, error) {
+	response, err := r.client.PostWithoutID([]*UserInvitation{data}, UserInvitationsResourcePath)
+	if err != nil {
+		return data, err
+	}
+	results, err := r.parseInvitationResults(response)
+	if err != nil {
+		return data, err
+	}
+	for _, result := range results {
+		if strings.EqualFold(result.UserEmail, data.Email) && result.InvitationStatus != InvitationStatusSuccess {
+			return data, fmt.Errorf("failed to invite user %s; status %s", data.Email, result.InvitationStatus)
+		}
+	}
+	return data, nil
+}
+
+// parseInvitationResults supports both, a single invitation response object and an array of invitation response objects, as the API documentation is not consistent
+func (r *userInvitationRestResource) parseInvitationResults(data []byte) ([]*InvitationResult, error) {
+	trimmed := strings.TrimSpace(string(data))
+	if len(trimmed) == 0 {
+		return []*InvitationResult{}, nil
+	}
+	responses := make([]*InvitationResponse, 0)
+	if strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(data, &responses); err != nil {
+			return nil, fmt.Errorf("failed to parse json; %s", err)
+		}
+	} else {
+		response := &InvitationResponse{}
+		if err := json.Unmarshal(data, response); err != nil {
+			return nil, fmt.Errorf("failed to parse json; %s", err)
+		}
+		responses = append(responses, response)
+	}
+	results := make([]*InvitationResult, 0)
+	for _, response := range responses {
+		results = append(results, response.InvitationResults...)
+	}
+	return results, nil
+}
+
+func (r *userInvitationRestResource) Update(data *UserInvitation) (*UserInvitation, error) {
+	return data, errors.New("update is not supported for user invitations")
+}
+
+func (r *userInvitationRestResource) Delete(data *UserInvitation) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+// DeleteByID revokes the invitation when it is still pending. Users who already accepted the invitation are kept
+func (r *userInvitationRestResource) DeleteByID(email string) error {
+	overview, err := r.overview.Get()
+	if err != nil {
+		return err
+	}
+	if overview.FindInvitationByEmail(email) == nil {
+		return nil
+	}
+	return r.client.DeleteByQuery(UserInvitationsResourcePath, map[string]string{"email": email})
+}
@@ -0,0 +1,245 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	userInvitationEmail   = "new.hire@example.com"
+	userInvitationGroupID = "group-id"
+	userInvitationUserID  = "user-id"
+)
+
+func TestShouldReturnEmailAsIDOfUserInvitation(t *testing.T) {
+	invitation := &UserInvitation{Email: userInvitationEmail, GroupID: userInvitationGroupID}
+
+	require.Equal(t, userInvitationEmail, invitation.GetIDForResourcePath())
+}
+
+func TestShouldFindUsersAndInvitationsOfUsersOverviewByEmailCaseInsensitive(t *testing.T) {
+	user := &User{ID: userInvitationUserID, Email: "John.Doe@example.com", FullName: "John Doe"}
+	invitation := &PendingInvitation{Email: userInvitationEmail, GroupID: userInvitationGroupID}
+	overview := &UsersOverview{Users: []*User{user}, Invitations: []*PendingInvitation{invitation}}
+
+	require.Equal(t, user, overview.FindUserByEmail("john.doe@example.com"))
+	require.Nil(t, overview.FindUserByEmail(userInvitationEmail))
+	require.Equal(t, invitation, overview.FindInvitationByEmail("New.Hire@example.com"))
+	require.Nil(t, overview.FindInvitationByEmail("john.doe@example.com"))
+}
+
+func TestShouldGetUsersOverview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte(`{"users":[{"id":"user-id","email":"john.doe@example.com","fullName":"John Doe"}],"invitations":[{"id":"invitation-id","email":"new.hire@example.com","groupId":"group-id"}]}`), nil)
+
+	sut := NewUsersOverviewResource(client)
+
+	result, err := sut.Get()
+
+	require.NoError(t, err)
+	require.Equal(t, &UsersOverview{
+		Users:       []*User{{ID: userInvitationUserID, Email: "john.doe@example.com", FullName: "John Doe"}},
+		Invitations: []*PendingInvitation{{ID: "invitation-id", Email: userInvitationEmail, GroupID: userInvitationGroupID}},
+	}, result)
+}
+
+func TestShouldFailToGetUsersOverviewWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := NewUsersOverviewResource(client).Get()
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToGetUsersOverviewWhenResponseIsNotValidJson(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	_, err := NewUsersOverviewResource(client).Get()
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, "failed to parse json")
+}
+
+func TestShouldReturnPendingInvitationsOfUserInvitationRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	overview := mocks.NewMockUsersOverviewResource(ctrl)
+	overview.EXPECT().Get().Times(1).Return(createUsersOverviewForUserInvitationTest(), nil)
+
+	sut := NewUserInvitationRestResource(overview, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*UserInvitation{{Email: userInvitationEmail, GroupID: userInvitationGroupID}}, result)
+}
+
+func TestShouldReturnPendingInvitationWhenInvitationIsNotYetAccepted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	overview := mocks.NewMockUsersOverviewResource(ctrl)
+	overview.EXPECT().Get().Times(1).Return(createUsersOverviewForUserInvitationTest(), nil)
+
+	sut := NewUserInvitationRestResource(overview, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetOne(userInvitationEmail)
+
+	require.NoError(t, err)
+	require.Equal(t, &UserInvitation{Email: userInvitationEmail, GroupID: userInvitationGroupID}, result)
+}
+
+func TestShouldReturnInvitationWithUserIDWhenInvitationIsAccepted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	overview := mocks.NewMockUsersOverviewResource(ctrl)
+	overview.EXPECT().Get().Times(1).Return(createUsersOverviewForUserInvitationTest(), nil)
+
+	sut := NewUserInvitationRestResource(overview, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetOne("john.doe@example.com")
+
+	require.NoError(t, err)
+	require.Equal(t, &UserInvitation{Email: "john.doe@example.com", UserID: userInvitationUserID}, result)
+}
+
+func TestShouldReturnNotFoundErrorWhenNeitherInvitationNorUserExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	overview := mocks.NewMockUsersOverviewResource(ctrl)
+	overview.EXPECT().Get().Times(1).Return(createUsersOverviewForUserInvitationTest(), nil)
+
+	sut := NewUserInvitationRestResource(overview, mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.GetOne("unknown@example.com")
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldCreateUserInvitation(t *testing.T) {
+	testCases := map[string]string{
+		"object response": `{"invitationResults":[{"userEmail":"new.hire@example.com","invitationStatus":"SUCCESS"}]}`,
+		"array response":  `[{"invitationResults":[{"userEmail":"new.hire@example.com","invitationStatus":"SUCCESS"}]}]`,
+		"empty response":  ``,
+	}
+	for name, response := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			invitation := &UserInvitation{Email: userInvitationEmail, GroupID: userInvitationGroupID}
+			client := mocks.NewMockRestClient(ctrl)
+			client.EXPECT().PostWithoutID([]*UserInvitation{invitation}, UserInvitationsResourcePath).Times(1).Return([]byte(response), nil)
+
+			sut := NewUserInvitationRestResource(mocks.NewMockUsersOverviewResource(ctrl), client)
+
+			result, err := sut.Create(invitation)
+
+			require.NoError(t, err)
+			require.Equal(t, invitation, result)
+		})
+	}
+}
+
+func TestShouldFailToCreateUserInvitationWhenInvitationIsNotSuccessful(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	invitation := &UserInvitation{Email: userInvitationEmail, GroupID: userInvitationGroupID}
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostWithoutID([]*UserInvitation{invitation}, UserInvitationsResourcePath).Times(1).Return([]byte(`{"invitationResults":[{"userEmail":"new.hire@example.com","invitationStatus":"FAILURE_USER_ALREADY_EXISTS"}]}`), nil)
+
+	sut := NewUserInvitationRestResource(mocks.NewMockUsersOverviewResource(ctrl), client)
+
+	_, err := sut.Create(invitation)
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, "failed to invite user new.hire@example.com; status FAILURE_USER_ALREADY_EXISTS")
+}
+
+func TestShouldFailToCreateUserInvitationWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	invitation := &UserInvitation{Email: userInvitationEmail, GroupID: userInvitationGroupID}
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostWithoutID([]*UserInvitation{invitation}, UserInvitationsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewUserInvitationRestResource(mocks.NewMockUsersOverviewResource(ctrl), client)
+
+	_, err := sut.Create(invitation)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToUpdateUserInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewUserInvitationRestResource(mocks.NewMockUsersOverviewResource(ctrl), mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.Update(&UserInvitation{Email: userInvitationEmail})
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, "update is not supported for user invitations")
+}
+
+func TestShouldRevokePendingUserInvitationOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	overview := mocks.NewMockUsersOverviewResource(ctrl)
+	overview.EXPECT().Get().Times(1).Return(createUsersOverviewForUserInvitationTest(), nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().DeleteByQuery(UserInvitationsResourcePath, map[string]string{"email": userInvitationEmail}).Times(1).Return(nil)
+
+	sut := NewUserInvitationRestResource(overview, client)
+
+	err := sut.Delete(&UserInvitation{Email: userInvitationEmail, GroupID: userInvitationGroupID})
+
+	require.NoError(t, err)
+}
+
+func TestShouldNotRemoveUserOnDeleteWhenInvitationIsAccepted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	overview := mocks.NewMockUsersOverviewResource(ctrl)
+	overview.EXPECT().Get().Times(1).Return(createUsersOverviewForUserInvitationTest(), nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().DeleteByQuery(gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewUserInvitationRestResource(overview, client)
+
+	err := sut.DeleteByID("john.doe@example.com")
+
+	require.NoError(t, err)
+}
+
+func createUsersOverviewForUserInvitationTest() *UsersOverview {
+	return &UsersOverview{
+		Users:       []*User{{ID: userInvitationUserID, Email: "john.doe@example.com", FullName: "John Doe"}},
+		Invitations: []*PendingInvitation{{Email: userInvitationEmail, GroupID: userInvitationGroupID}},
+	}
+}
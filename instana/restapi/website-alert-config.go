@@ -10,6 +10,7 @@ type WebsiteAlertConfig struct {
 	Description           string                    `json:"description"`
 	Severity              int                       `json:"severity"`
 	Triggering            bool                      `json:"triggering"`
+	Enabled               bool                      `json:"enabled"`
 	WebsiteID             string                    `json:"websiteId"`
 	TagFilterExpression   *TagFilter                `json:"tagFilterExpression"`
 	AlertChannelIDs       []string                  `json:"alertChannelIds"`
@@ -18,6 +19,7 @@ type WebsiteAlertConfig struct {
 	Rule                  WebsiteAlertRule          `json:"rule"`
 	Threshold             Threshold                 `json:"threshold"`
 	TimeThreshold         WebsiteTimeThreshold      `json:"timeThreshold"`
+	UpdateBaseline        bool                      `json:"-"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
@@ -25,6 +27,16 @@ func (r *WebsiteAlertConfig) GetIDForResourcePath() string {
 	return r.ID
 }
 
+// IsEnabled implementation of the interface EnabledAware
+func (r *WebsiteAlertConfig) IsEnabled() bool {
+	return r.Enabled
+}
+
+// IsBaselineUpdateRequested implementation of the interface BaselineUpdateAware
+func (r *WebsiteAlertConfig) IsBaselineUpdateRequested() bool {
+	return r.UpdateBaseline
+}
+
 // GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
 func (a *WebsiteAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
 	return a.CustomerPayloadFields
@@ -151,7 +151,7 @@ func (r *terraformResourceImpl[T]) Delete(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = r.resourceHandle.GetRestResource(instanaAPI).DeleteByID(object.GetIDForResourcePath())
+	err = r.resourceHandle.GetRestResource(instanaAPI).Delete(object)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -162,8 +162,11 @@ func (r *terraformResourceImpl[T]) Delete(_ context.Context, d *schema.ResourceD
 func (r *terraformResourceImpl[T]) ToSchemaResource() *schema.Resource {
 	metaData := r.resourceHandle.MetaData()
 	var updateOperation schema.UpdateContextFunc
-	if r.resourceHandle.MetaData().CreateOnly {
-		updateOperation = r.NoUpdateSupported
+	if metaData.CreateOnly {
+		//terraform does not allow an update operation when all fields force a re-creation of the resource
+		if hasUpdatableFields(metaData.Schema) {
+			updateOperation = r.NoUpdateSupported
+		}
 	} else {
 		updateOperation = r.Update
 	}
@@ -188,6 +191,15 @@ func (r *terraformResourceImpl[T]) ToSchemaResource() *schema.Resource {
 	}
 }
 
+func hasUpdatableFields(s map[string]*schema.Schema) bool {
+	for _, field := range s {
+		if !field.ForceNew && (field.Required || field.Optional) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *terraformResourceImpl[T]) importState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	if r.resourceHandle.MetaData().ResourceIDField != nil {
 		err := d.Set(*r.resourceHandle.MetaData().ResourceIDField, d.Id())
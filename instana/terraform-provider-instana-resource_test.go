@@ -10,6 +10,7 @@ import (
 	"github.com/gessnerfl/terraform-provider-instana/mocks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -28,6 +29,8 @@ func TestTerraformProviderInstanaResource(t *testing.T) {
 	t.Run("should return error when update test object fails through Instana API", ut.shouldReturnErrorWhenUpdateTestObjectFailsThroughInstanaAPI)
 	t.Run("should delete test object through Instana API", ut.shouldDeleteTestObjectThroughInstanaAPI)
 	t.Run("should return error when delete test object fails through Instana API", ut.shouldReturnErrorWhenDeleteTestObjectFailsThroughInstanaAPI)
+	t.Run("should delete test object by ID when default rest resource is used", ut.shouldDeleteTestObjectByIDWhenDefaultRestResourceIsUsed)
+	t.Run("should register update operation which is not supported for create only resources with updatable fields", ut.shouldRegisterNotSupportedUpdateOperationForCreateOnlyResourcesWithUpdatableFields)
 }
 
 type terraformProviderInstanaResourceUnitTest struct{}
@@ -192,7 +195,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldDeleteTestObjectThrough
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(nil).Times(1)
+		mockTestObjectApi.EXPECT().Delete(gomock.Cond(func(obj *restapi.AlertingChannel) bool { return obj.ID == id })).Return(nil).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
@@ -213,7 +216,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenDeleteTe
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(expectedError).Times(1)
+		mockTestObjectApi.EXPECT().Delete(gomock.Cond(func(obj *restapi.AlertingChannel) bool { return obj.ID == id })).Return(expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
@@ -225,6 +228,42 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenDeleteTe
 	})
 }
 
+func (r *terraformProviderInstanaResourceUnitTest) shouldDeleteTestObjectByIDWhenDefaultRestResourceIsUsed(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		id := "test-id"
+		data := r.createTestAlertingChannelEmailData()
+		resourceData := r.createAlertingChannelResourceData(data, t)
+		resourceData.SetId(id)
+		mockRestClient := mocks.NewMockRestClient(ctrl)
+		restResource := restapi.NewCreatePUTUpdatePUTRestResource(restapi.AlertingChannelsResourcePath, restapi.NewDefaultJSONUnmarshaller(&restapi.AlertingChannel{}), mockRestClient)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(restResource).Times(1)
+		mockRestClient.EXPECT().Delete(gomock.Eq(id), gomock.Eq(restapi.AlertingChannelsResourcePath)).Return(nil).Times(1)
+
+		resourceHandle := NewAlertingChannelResourceHandle()
+		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
+
+		assert.Nil(t, diag)
+		assert.GreaterOrEqual(t, 0, len(resourceData.Id()))
+	})
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldRegisterNotSupportedUpdateOperationForCreateOnlyResourcesWithUpdatableFields(t *testing.T) {
+	resourceHandle := NewSliConfigResourceHandle()
+	require.True(t, resourceHandle.MetaData().CreateOnly)
+
+	resource := NewTerraformResource(resourceHandle).ToSchemaResource()
+	require.NotNil(t, resource.UpdateContext)
+
+	resourceData := schema.TestResourceDataRaw(t, resourceHandle.MetaData().Schema, map[string]interface{}{})
+	diag := resource.UpdateContext(context.TODO(), resourceData, &ProviderMeta{})
+
+	assert.NotNil(t, diag)
+	assert.True(t, diag.HasError())
+	assert.Equal(t, "update operations not supported for "+ResourceInstanaSliConfig+" resources", diag[0].Summary)
+}
+
 func (r *terraformProviderInstanaResourceUnitTest) verifyTestObjectModelAppliedToResource(model *restapi.AlertingChannel, resourceData *schema.ResourceData, t *testing.T) {
 	assert.Equal(t, model.ID, resourceData.Id())
 	assert.Equal(t, resourceNameWithoutPrefixAndSuffix, resourceData.Get(AlertingChannelFieldName))
@@ -43,8 +43,9 @@ var (
 	resourceSchemaOptionalThresholdLastUpdated = &schema.Schema{
 		Type:         schema.TypeInt,
 		Optional:     true,
+		Computed:     true,
 		ValidateFunc: validation.IntAtLeast(0),
-		Description:  "The last updated value of the threshold",
+		Description:  "The last updated value of the threshold. The value is maintained by Instana, e.g. when the historic baseline is recalculated",
 	}
 )
 
@@ -48,6 +48,20 @@ func (mr *MockInstanaAPIMockRecorder) APITokens() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APITokens", reflect.TypeOf((*MockInstanaAPI)(nil).APITokens))
 }
 
+// AlertConfigVersionRestores mocks base method.
+func (m *MockInstanaAPI) AlertConfigVersionRestores() restapi.RestResource[*restapi.AlertConfigVersionRestore] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlertConfigVersionRestores")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.AlertConfigVersionRestore])
+	return ret0
+}
+
+// AlertConfigVersionRestores indicates an expected call of AlertConfigVersionRestores.
+func (mr *MockInstanaAPIMockRecorder) AlertConfigVersionRestores() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertConfigVersionRestores", reflect.TypeOf((*MockInstanaAPI)(nil).AlertConfigVersionRestores))
+}
+
 // AlertingChannels mocks base method.
 func (m *MockInstanaAPI) AlertingChannels() restapi.RestResource[*restapi.AlertingChannel] {
 	m.ctrl.T.Helper()
@@ -76,6 +90,48 @@ func (mr *MockInstanaAPIMockRecorder) AlertingConfigurations() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertingConfigurations", reflect.TypeOf((*MockInstanaAPI)(nil).AlertingConfigurations))
 }
 
+// ApdexConfigs mocks base method.
+func (m *MockInstanaAPI) ApdexConfigs() restapi.RestResource[*restapi.ApdexConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApdexConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ApdexConfig])
+	return ret0
+}
+
+// ApdexConfigs indicates an expected call of ApdexConfigs.
+func (mr *MockInstanaAPIMockRecorder) ApdexConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApdexConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApdexConfigs))
+}
+
+// ApdexReports mocks base method.
+func (m *MockInstanaAPI) ApdexReports() restapi.ApdexReportResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApdexReports")
+	ret0, _ := ret[0].(restapi.ApdexReportResource)
+	return ret0
+}
+
+// ApdexReports indicates an expected call of ApdexReports.
+func (mr *MockInstanaAPIMockRecorder) ApdexReports() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApdexReports", reflect.TypeOf((*MockInstanaAPI)(nil).ApdexReports))
+}
+
+// ApplicationAlertConfigVersions mocks base method.
+func (m *MockInstanaAPI) ApplicationAlertConfigVersions() restapi.AlertConfigVersionResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationAlertConfigVersions")
+	ret0, _ := ret[0].(restapi.AlertConfigVersionResource)
+	return ret0
+}
+
+// ApplicationAlertConfigVersions indicates an expected call of ApplicationAlertConfigVersions.
+func (mr *MockInstanaAPIMockRecorder) ApplicationAlertConfigVersions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationAlertConfigVersions", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationAlertConfigVersions))
+}
+
 // ApplicationAlertConfigs mocks base method.
 func (m *MockInstanaAPI) ApplicationAlertConfigs() restapi.RestResource[*restapi.ApplicationAlertConfig] {
 	m.ctrl.T.Helper()
@@ -90,6 +146,34 @@ func (mr *MockInstanaAPIMockRecorder) ApplicationAlertConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationAlertConfigs))
 }
 
+// ApplicationCatalogMetrics mocks base method.
+func (m *MockInstanaAPI) ApplicationCatalogMetrics() restapi.ReadOnlyRestResource[*restapi.CatalogMetric] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationCatalogMetrics")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.CatalogMetric])
+	return ret0
+}
+
+// ApplicationCatalogMetrics indicates an expected call of ApplicationCatalogMetrics.
+func (mr *MockInstanaAPIMockRecorder) ApplicationCatalogMetrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationCatalogMetrics", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationCatalogMetrics))
+}
+
+// ApplicationCatalogTags mocks base method.
+func (m *MockInstanaAPI) ApplicationCatalogTags() restapi.ReadOnlyRestResource[*restapi.CatalogTag] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationCatalogTags")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.CatalogTag])
+	return ret0
+}
+
+// ApplicationCatalogTags indicates an expected call of ApplicationCatalogTags.
+func (mr *MockInstanaAPIMockRecorder) ApplicationCatalogTags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationCatalogTags", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationCatalogTags))
+}
+
 // ApplicationConfigs mocks base method.
 func (m *MockInstanaAPI) ApplicationConfigs() restapi.RestResource[*restapi.ApplicationConfig] {
 	m.ctrl.T.Helper()
@@ -104,6 +188,34 @@ func (mr *MockInstanaAPIMockRecorder) ApplicationConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationConfigs))
 }
 
+// Applications mocks base method.
+func (m *MockInstanaAPI) Applications() restapi.DiscoveryResource[*restapi.Application] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Applications")
+	ret0, _ := ret[0].(restapi.DiscoveryResource[*restapi.Application])
+	return ret0
+}
+
+// Applications indicates an expected call of Applications.
+func (mr *MockInstanaAPIMockRecorder) Applications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Applications", reflect.TypeOf((*MockInstanaAPI)(nil).Applications))
+}
+
+// BuiltinEventSpecificationStates mocks base method.
+func (m *MockInstanaAPI) BuiltinEventSpecificationStates() restapi.RestResource[*restapi.BuiltinEventSpecificationState] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuiltinEventSpecificationStates")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.BuiltinEventSpecificationState])
+	return ret0
+}
+
+// BuiltinEventSpecificationStates indicates an expected call of BuiltinEventSpecificationStates.
+func (mr *MockInstanaAPIMockRecorder) BuiltinEventSpecificationStates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuiltinEventSpecificationStates", reflect.TypeOf((*MockInstanaAPI)(nil).BuiltinEventSpecificationStates))
+}
+
 // BuiltinEventSpecifications mocks base method.
 func (m *MockInstanaAPI) BuiltinEventSpecifications() restapi.ReadOnlyRestResource[*restapi.BuiltinEventSpecification] {
 	m.ctrl.T.Helper()
@@ -146,6 +258,48 @@ func (mr *MockInstanaAPIMockRecorder) CustomEventSpecifications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomEventSpecifications", reflect.TypeOf((*MockInstanaAPI)(nil).CustomEventSpecifications))
 }
 
+// CustomPayloadConfiguration mocks base method.
+func (m *MockInstanaAPI) CustomPayloadConfiguration() restapi.RestResource[*restapi.CustomPayloadConfiguration] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomPayloadConfiguration")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.CustomPayloadConfiguration])
+	return ret0
+}
+
+// CustomPayloadConfiguration indicates an expected call of CustomPayloadConfiguration.
+func (mr *MockInstanaAPIMockRecorder) CustomPayloadConfiguration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomPayloadConfiguration", reflect.TypeOf((*MockInstanaAPI)(nil).CustomPayloadConfiguration))
+}
+
+// Endpoints mocks base method.
+func (m *MockInstanaAPI) Endpoints() restapi.DiscoveryResource[*restapi.Endpoint] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Endpoints")
+	ret0, _ := ret[0].(restapi.DiscoveryResource[*restapi.Endpoint])
+	return ret0
+}
+
+// Endpoints indicates an expected call of Endpoints.
+func (mr *MockInstanaAPIMockRecorder) Endpoints() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Endpoints", reflect.TypeOf((*MockInstanaAPI)(nil).Endpoints))
+}
+
+// GlobalApplicationAlertConfigVersions mocks base method.
+func (m *MockInstanaAPI) GlobalApplicationAlertConfigVersions() restapi.AlertConfigVersionResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GlobalApplicationAlertConfigVersions")
+	ret0, _ := ret[0].(restapi.AlertConfigVersionResource)
+	return ret0
+}
+
+// GlobalApplicationAlertConfigVersions indicates an expected call of GlobalApplicationAlertConfigVersions.
+func (mr *MockInstanaAPIMockRecorder) GlobalApplicationAlertConfigVersions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalApplicationAlertConfigVersions", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalApplicationAlertConfigVersions))
+}
+
 // GlobalApplicationAlertConfigs mocks base method.
 func (m *MockInstanaAPI) GlobalApplicationAlertConfigs() restapi.RestResource[*restapi.ApplicationAlertConfig] {
 	m.ctrl.T.Helper()
@@ -160,6 +314,20 @@ func (mr *MockInstanaAPIMockRecorder) GlobalApplicationAlertConfigs() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalApplicationAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalApplicationAlertConfigs))
 }
 
+// GroupMappings mocks base method.
+func (m *MockInstanaAPI) GroupMappings() restapi.RestResource[*restapi.GroupMapping] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMappings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GroupMapping])
+	return ret0
+}
+
+// GroupMappings indicates an expected call of GroupMappings.
+func (mr *MockInstanaAPIMockRecorder) GroupMappings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMappings", reflect.TypeOf((*MockInstanaAPI)(nil).GroupMappings))
+}
+
 // Groups mocks base method.
 func (m *MockInstanaAPI) Groups() restapi.RestResource[*restapi.Group] {
 	m.ctrl.T.Helper()
@@ -174,6 +342,202 @@ func (mr *MockInstanaAPIMockRecorder) Groups() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockInstanaAPI)(nil).Groups))
 }
 
+// HttpEndpointConfigs mocks base method.
+func (m *MockInstanaAPI) HttpEndpointConfigs() restapi.RestResource[*restapi.HttpEndpointConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HttpEndpointConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.HttpEndpointConfig])
+	return ret0
+}
+
+// HttpEndpointConfigs indicates an expected call of HttpEndpointConfigs.
+func (mr *MockInstanaAPIMockRecorder) HttpEndpointConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HttpEndpointConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).HttpEndpointConfigs))
+}
+
+// IdentityProviderConfig mocks base method.
+func (m *MockInstanaAPI) IdentityProviderConfig() restapi.RestResource[*restapi.IdentityProviderConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IdentityProviderConfig")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.IdentityProviderConfig])
+	return ret0
+}
+
+// IdentityProviderConfig indicates an expected call of IdentityProviderConfig.
+func (mr *MockInstanaAPIMockRecorder) IdentityProviderConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdentityProviderConfig", reflect.TypeOf((*MockInstanaAPI)(nil).IdentityProviderConfig))
+}
+
+// InfraAlertConfigs mocks base method.
+func (m *MockInstanaAPI) InfraAlertConfigs() restapi.RestResource[*restapi.InfraAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InfraAlertConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.InfraAlertConfig])
+	return ret0
+}
+
+// InfraAlertConfigs indicates an expected call of InfraAlertConfigs.
+func (mr *MockInstanaAPIMockRecorder) InfraAlertConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InfraAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).InfraAlertConfigs))
+}
+
+// MaintenanceWindows mocks base method.
+func (m *MockInstanaAPI) MaintenanceWindows() restapi.RestResource[*restapi.MaintenanceWindow] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaintenanceWindows")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.MaintenanceWindow])
+	return ret0
+}
+
+// MaintenanceWindows indicates an expected call of MaintenanceWindows.
+func (mr *MockInstanaAPIMockRecorder) MaintenanceWindows() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaintenanceWindows", reflect.TypeOf((*MockInstanaAPI)(nil).MaintenanceWindows))
+}
+
+// ManualServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ManualServiceConfigs() restapi.RestResource[*restapi.ManualServiceConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManualServiceConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ManualServiceConfig])
+	return ret0
+}
+
+// ManualServiceConfigs indicates an expected call of ManualServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ManualServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManualServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ManualServiceConfigs))
+}
+
+// MobileAppAlertConfigs mocks base method.
+func (m *MockInstanaAPI) MobileAppAlertConfigs() restapi.RestResource[*restapi.MobileAppAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppAlertConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.MobileAppAlertConfig])
+	return ret0
+}
+
+// MobileAppAlertConfigs indicates an expected call of MobileAppAlertConfigs.
+func (mr *MockInstanaAPIMockRecorder) MobileAppAlertConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppAlertConfigs))
+}
+
+// MobileAppCatalogMetrics mocks base method.
+func (m *MockInstanaAPI) MobileAppCatalogMetrics() restapi.ReadOnlyRestResource[*restapi.CatalogMetric] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppCatalogMetrics")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.CatalogMetric])
+	return ret0
+}
+
+// MobileAppCatalogMetrics indicates an expected call of MobileAppCatalogMetrics.
+func (mr *MockInstanaAPIMockRecorder) MobileAppCatalogMetrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppCatalogMetrics", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppCatalogMetrics))
+}
+
+// MobileAppCatalogTags mocks base method.
+func (m *MockInstanaAPI) MobileAppCatalogTags() restapi.ReadOnlyRestResource[*restapi.CatalogTag] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppCatalogTags")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.CatalogTag])
+	return ret0
+}
+
+// MobileAppCatalogTags indicates an expected call of MobileAppCatalogTags.
+func (mr *MockInstanaAPIMockRecorder) MobileAppCatalogTags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppCatalogTags", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppCatalogTags))
+}
+
+// MobileAppGeoLocationConfigs mocks base method.
+func (m *MockInstanaAPI) MobileAppGeoLocationConfigs() restapi.RestResource[*restapi.GeoLocationConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppGeoLocationConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GeoLocationConfig])
+	return ret0
+}
+
+// MobileAppGeoLocationConfigs indicates an expected call of MobileAppGeoLocationConfigs.
+func (mr *MockInstanaAPIMockRecorder) MobileAppGeoLocationConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppGeoLocationConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppGeoLocationConfigs))
+}
+
+// MobileAppIPMaskingConfigs mocks base method.
+func (m *MockInstanaAPI) MobileAppIPMaskingConfigs() restapi.RestResource[*restapi.IPMaskingConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppIPMaskingConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.IPMaskingConfig])
+	return ret0
+}
+
+// MobileAppIPMaskingConfigs indicates an expected call of MobileAppIPMaskingConfigs.
+func (mr *MockInstanaAPIMockRecorder) MobileAppIPMaskingConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppIPMaskingConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppIPMaskingConfigs))
+}
+
+// MobileAppMonitoringConfigs mocks base method.
+func (m *MockInstanaAPI) MobileAppMonitoringConfigs() restapi.RestResource[*restapi.MobileAppMonitoringConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppMonitoringConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.MobileAppMonitoringConfig])
+	return ret0
+}
+
+// MobileAppMonitoringConfigs indicates an expected call of MobileAppMonitoringConfigs.
+func (mr *MockInstanaAPIMockRecorder) MobileAppMonitoringConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppMonitoringConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppMonitoringConfigs))
+}
+
+// Releases mocks base method.
+func (m *MockInstanaAPI) Releases() restapi.RestResource[*restapi.Release] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Releases")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.Release])
+	return ret0
+}
+
+// Releases indicates an expected call of Releases.
+func (mr *MockInstanaAPIMockRecorder) Releases() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Releases", reflect.TypeOf((*MockInstanaAPI)(nil).Releases))
+}
+
+// ServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ServiceConfigs() restapi.RestResource[*restapi.ServiceConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ServiceConfig])
+	return ret0
+}
+
+// ServiceConfigs indicates an expected call of ServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ServiceConfigs))
+}
+
+// Services mocks base method.
+func (m *MockInstanaAPI) Services() restapi.DiscoveryResource[*restapi.Service] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Services")
+	ret0, _ := ret[0].(restapi.DiscoveryResource[*restapi.Service])
+	return ret0
+}
+
+// Services indicates an expected call of Services.
+func (mr *MockInstanaAPIMockRecorder) Services() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Services", reflect.TypeOf((*MockInstanaAPI)(nil).Services))
+}
+
 // SliConfigs mocks base method.
 func (m *MockInstanaAPI) SliConfigs() restapi.RestResource[*restapi.SliConfig] {
 	m.ctrl.T.Helper()
@@ -188,6 +552,34 @@ func (mr *MockInstanaAPIMockRecorder) SliConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SliConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).SliConfigs))
 }
 
+// SyntheticAlertConfigs mocks base method.
+func (m *MockInstanaAPI) SyntheticAlertConfigs() restapi.RestResource[*restapi.SyntheticAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyntheticAlertConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SyntheticAlertConfig])
+	return ret0
+}
+
+// SyntheticAlertConfigs indicates an expected call of SyntheticAlertConfigs.
+func (mr *MockInstanaAPIMockRecorder) SyntheticAlertConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticAlertConfigs))
+}
+
+// SyntheticCredentials mocks base method.
+func (m *MockInstanaAPI) SyntheticCredentials() restapi.RestResource[*restapi.SyntheticCredential] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyntheticCredentials")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SyntheticCredential])
+	return ret0
+}
+
+// SyntheticCredentials indicates an expected call of SyntheticCredentials.
+func (mr *MockInstanaAPIMockRecorder) SyntheticCredentials() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticCredentials", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticCredentials))
+}
+
 // SyntheticLocation mocks base method.
 func (m *MockInstanaAPI) SyntheticLocation() restapi.ReadOnlyRestResource[*restapi.SyntheticLocation] {
 	m.ctrl.T.Helper()
@@ -216,6 +608,48 @@ func (mr *MockInstanaAPIMockRecorder) SyntheticTest() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticTest", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticTest))
 }
 
+// UserInvitations mocks base method.
+func (m *MockInstanaAPI) UserInvitations() restapi.RestResource[*restapi.UserInvitation] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserInvitations")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.UserInvitation])
+	return ret0
+}
+
+// UserInvitations indicates an expected call of UserInvitations.
+func (mr *MockInstanaAPIMockRecorder) UserInvitations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserInvitations", reflect.TypeOf((*MockInstanaAPI)(nil).UserInvitations))
+}
+
+// Users mocks base method.
+func (m *MockInstanaAPI) Users() restapi.ReadOnlyRestResource[*restapi.User] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Users")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.User])
+	return ret0
+}
+
+// Users indicates an expected call of Users.
+func (mr *MockInstanaAPIMockRecorder) Users() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockInstanaAPI)(nil).Users))
+}
+
+// UsersOverview mocks base method.
+func (m *MockInstanaAPI) UsersOverview() restapi.UsersOverviewResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsersOverview")
+	ret0, _ := ret[0].(restapi.UsersOverviewResource)
+	return ret0
+}
+
+// UsersOverview indicates an expected call of UsersOverview.
+func (mr *MockInstanaAPIMockRecorder) UsersOverview() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersOverview", reflect.TypeOf((*MockInstanaAPI)(nil).UsersOverview))
+}
+
 // WebsiteAlertConfig mocks base method.
 func (m *MockInstanaAPI) WebsiteAlertConfig() restapi.RestResource[*restapi.WebsiteAlertConfig] {
 	m.ctrl.T.Helper()
@@ -230,6 +664,76 @@ func (mr *MockInstanaAPIMockRecorder) WebsiteAlertConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteAlertConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteAlertConfig))
 }
 
+// WebsiteAlertConfigVersions mocks base method.
+func (m *MockInstanaAPI) WebsiteAlertConfigVersions() restapi.AlertConfigVersionResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteAlertConfigVersions")
+	ret0, _ := ret[0].(restapi.AlertConfigVersionResource)
+	return ret0
+}
+
+// WebsiteAlertConfigVersions indicates an expected call of WebsiteAlertConfigVersions.
+func (mr *MockInstanaAPIMockRecorder) WebsiteAlertConfigVersions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteAlertConfigVersions", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteAlertConfigVersions))
+}
+
+// WebsiteCatalogMetrics mocks base method.
+func (m *MockInstanaAPI) WebsiteCatalogMetrics() restapi.ReadOnlyRestResource[*restapi.CatalogMetric] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteCatalogMetrics")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.CatalogMetric])
+	return ret0
+}
+
+// WebsiteCatalogMetrics indicates an expected call of WebsiteCatalogMetrics.
+func (mr *MockInstanaAPIMockRecorder) WebsiteCatalogMetrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteCatalogMetrics", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteCatalogMetrics))
+}
+
+// WebsiteCatalogTags mocks base method.
+func (m *MockInstanaAPI) WebsiteCatalogTags() restapi.ReadOnlyRestResource[*restapi.CatalogTag] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteCatalogTags")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.CatalogTag])
+	return ret0
+}
+
+// WebsiteCatalogTags indicates an expected call of WebsiteCatalogTags.
+func (mr *MockInstanaAPIMockRecorder) WebsiteCatalogTags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteCatalogTags", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteCatalogTags))
+}
+
+// WebsiteGeoLocationConfigs mocks base method.
+func (m *MockInstanaAPI) WebsiteGeoLocationConfigs() restapi.RestResource[*restapi.GeoLocationConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteGeoLocationConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GeoLocationConfig])
+	return ret0
+}
+
+// WebsiteGeoLocationConfigs indicates an expected call of WebsiteGeoLocationConfigs.
+func (mr *MockInstanaAPIMockRecorder) WebsiteGeoLocationConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteGeoLocationConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteGeoLocationConfigs))
+}
+
+// WebsiteIPMaskingConfigs mocks base method.
+func (m *MockInstanaAPI) WebsiteIPMaskingConfigs() restapi.RestResource[*restapi.IPMaskingConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteIPMaskingConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.IPMaskingConfig])
+	return ret0
+}
+
+// WebsiteIPMaskingConfigs indicates an expected call of WebsiteIPMaskingConfigs.
+func (mr *MockInstanaAPIMockRecorder) WebsiteIPMaskingConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteIPMaskingConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteIPMaskingConfigs))
+}
+
 // WebsiteMonitoringConfig mocks base method.
 func (m *MockInstanaAPI) WebsiteMonitoringConfig() restapi.RestResource[*restapi.WebsiteMonitoringConfig] {
 	m.ctrl.T.Helper()
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: instana/restapi/alert-config-version.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAlertConfigVersionResource is a mock of AlertConfigVersionResource interface.
+type MockAlertConfigVersionResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertConfigVersionResourceMockRecorder
+}
+
+// MockAlertConfigVersionResourceMockRecorder is the mock recorder for MockAlertConfigVersionResource.
+type MockAlertConfigVersionResourceMockRecorder struct {
+	mock *MockAlertConfigVersionResource
+}
+
+// NewMockAlertConfigVersionResource creates a new mock instance.
+func NewMockAlertConfigVersionResource(ctrl *gomock.Controller) *MockAlertConfigVersionResource {
+	mock := &MockAlertConfigVersionResource{ctrl: ctrl}
+	mock.recorder = &MockAlertConfigVersionResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertConfigVersionResource) EXPECT() *MockAlertConfigVersionResourceMockRecorder {
+	return m.recorder
+}
+
+// GetVersions mocks base method.
+func (m *MockAlertConfigVersionResource) GetVersions(alertConfigID string) (*[]*restapi.AlertConfigVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersions", alertConfigID)
+	ret0, _ := ret[0].(*[]*restapi.AlertConfigVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersions indicates an expected call of GetVersions.
+func (mr *MockAlertConfigVersionResourceMockRecorder) GetVersions(alertConfigID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockAlertConfigVersionResource)(nil).GetVersions), alertConfigID)
+}
+
+// Restore mocks base method.
+func (m *MockAlertConfigVersionResource) Restore(alertConfigID string, created int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", alertConfigID, created)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAlertConfigVersionResourceMockRecorder) Restore(alertConfigID, created interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAlertConfigVersionResource)(nil).Restore), alertConfigID, created)
+}
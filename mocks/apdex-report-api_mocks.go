@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: instana/restapi/apdex-report-api.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApdexReportResource is a mock of ApdexReportResource interface.
+type MockApdexReportResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockApdexReportResourceMockRecorder
+}
+
+// MockApdexReportResourceMockRecorder is the mock recorder for MockApdexReportResource.
+type MockApdexReportResourceMockRecorder struct {
+	mock *MockApdexReportResource
+}
+
+// NewMockApdexReportResource creates a new mock instance.
+func NewMockApdexReportResource(ctrl *gomock.Controller) *MockApdexReportResource {
+	mock := &MockApdexReportResource{ctrl: ctrl}
+	mock.recorder = &MockApdexReportResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApdexReportResource) EXPECT() *MockApdexReportResourceMockRecorder {
+	return m.recorder
+}
+
+// GetReport mocks base method.
+func (m *MockApdexReportResource) GetReport(apdexID string, from, to int64) (*restapi.ApdexReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", apdexID, from, to)
+	ret0, _ := ret[0].(*restapi.ApdexReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockApdexReportResourceMockRecorder) GetReport(apdexID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockApdexReportResource)(nil).GetReport), apdexID, from, to)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: instana/restapi/application-monitoring-discovery-api.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDiscoveryResource is a mock of DiscoveryResource interface.
+type MockDiscoveryResource[T restapi.InstanaDataObject] struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiscoveryResourceMockRecorder[T]
+}
+
+// MockDiscoveryResourceMockRecorder is the mock recorder for MockDiscoveryResource.
+type MockDiscoveryResourceMockRecorder[T restapi.InstanaDataObject] struct {
+	mock *MockDiscoveryResource[T]
+}
+
+// NewMockDiscoveryResource creates a new mock instance.
+func NewMockDiscoveryResource[T restapi.InstanaDataObject](ctrl *gomock.Controller) *MockDiscoveryResource[T] {
+	mock := &MockDiscoveryResource[T]{ctrl: ctrl}
+	mock.recorder = &MockDiscoveryResourceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiscoveryResource[T]) EXPECT() *MockDiscoveryResourceMockRecorder[T] {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockDiscoveryResource[T]) GetAll(query restapi.DiscoveryQuery) (*[]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", query)
+	ret0, _ := ret[0].(*[]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockDiscoveryResourceMockRecorder[T]) GetAll(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockDiscoveryResource[T])(nil).GetAll), query)
+}
@@ -48,6 +48,34 @@ func (mr *MockRestClientMockRecorder) Delete(resourceID, resourceBasePath interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestClient)(nil).Delete), resourceID, resourceBasePath)
 }
 
+// DeleteByQuery mocks base method.
+func (m *MockRestClient) DeleteByQuery(resourcePath string, queryParams map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByQuery", resourcePath, queryParams)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByQuery indicates an expected call of DeleteByQuery.
+func (mr *MockRestClientMockRecorder) DeleteByQuery(resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByQuery", reflect.TypeOf((*MockRestClient)(nil).DeleteByQuery), resourcePath, queryParams)
+}
+
+// DeleteWithoutID mocks base method.
+func (m *MockRestClient) DeleteWithoutID(resourcePath string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithoutID", resourcePath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWithoutID indicates an expected call of DeleteWithoutID.
+func (mr *MockRestClientMockRecorder) DeleteWithoutID(resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithoutID", reflect.TypeOf((*MockRestClient)(nil).DeleteWithoutID), resourcePath)
+}
+
 // Get mocks base method.
 func (m *MockRestClient) Get(resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +91,21 @@ func (mr *MockRestClientMockRecorder) Get(resourcePath interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRestClient)(nil).Get), resourcePath)
 }
 
+// GetByQuery mocks base method.
+func (m *MockRestClient) GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByQuery", resourcePath, queryParams)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByQuery indicates an expected call of GetByQuery.
+func (mr *MockRestClientMockRecorder) GetByQuery(resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByQuery", reflect.TypeOf((*MockRestClient)(nil).GetByQuery), resourcePath, queryParams)
+}
+
 // GetOne mocks base method.
 func (m *MockRestClient) GetOne(id, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -78,6 +121,21 @@ func (mr *MockRestClientMockRecorder) GetOne(id, resourcePath interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestClient)(nil).GetOne), id, resourcePath)
 }
 
+// GetSubResource mocks base method.
+func (m *MockRestClient) GetSubResource(resourcePath, id, subResourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubResource", resourcePath, id, subResourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubResource indicates an expected call of GetSubResource.
+func (mr *MockRestClientMockRecorder) GetSubResource(resourcePath, id, subResourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubResource", reflect.TypeOf((*MockRestClient)(nil).GetSubResource), resourcePath, id, subResourcePath)
+}
+
 // Post mocks base method.
 func (m *MockRestClient) Post(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +166,21 @@ func (mr *MockRestClientMockRecorder) PostByQuery(resourcePath, queryParams inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostByQuery", reflect.TypeOf((*MockRestClient)(nil).PostByQuery), resourcePath, queryParams)
 }
 
+// PostSubResource mocks base method.
+func (m *MockRestClient) PostSubResource(resourcePath, id, subResourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostSubResource", resourcePath, id, subResourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostSubResource indicates an expected call of PostSubResource.
+func (mr *MockRestClientMockRecorder) PostSubResource(resourcePath, id, subResourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostSubResource", reflect.TypeOf((*MockRestClient)(nil).PostSubResource), resourcePath, id, subResourcePath)
+}
+
 // PostWithID mocks base method.
 func (m *MockRestClient) PostWithID(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +196,21 @@ func (mr *MockRestClientMockRecorder) PostWithID(data, resourcePath interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithID", reflect.TypeOf((*MockRestClient)(nil).PostWithID), data, resourcePath)
 }
 
+// PostWithoutID mocks base method.
+func (m *MockRestClient) PostWithoutID(data any, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostWithoutID", data, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostWithoutID indicates an expected call of PostWithoutID.
+func (mr *MockRestClientMockRecorder) PostWithoutID(data, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithoutID", reflect.TypeOf((*MockRestClient)(nil).PostWithoutID), data, resourcePath)
+}
+
 // Put mocks base method.
 func (m *MockRestClient) Put(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -152,3 +240,48 @@ func (mr *MockRestClientMockRecorder) PutByQuery(resourcePath, is, queryParams i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByQuery", reflect.TypeOf((*MockRestClient)(nil).PutByQuery), resourcePath, is, queryParams)
 }
+
+// PutSubResource mocks base method.
+func (m *MockRestClient) PutSubResource(resourcePath, id, subResourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSubResource", resourcePath, id, subResourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSubResource indicates an expected call of PutSubResource.
+func (mr *MockRestClientMockRecorder) PutSubResource(resourcePath, id, subResourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSubResource", reflect.TypeOf((*MockRestClient)(nil).PutSubResource), resourcePath, id, subResourcePath)
+}
+
+// PutSubResourceWithData mocks base method.
+func (m *MockRestClient) PutSubResourceWithData(data restapi.InstanaDataObject, resourcePath, subResourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSubResourceWithData", data, resourcePath, subResourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSubResourceWithData indicates an expected call of PutSubResourceWithData.
+func (mr *MockRestClientMockRecorder) PutSubResourceWithData(data, resourcePath, subResourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSubResourceWithData", reflect.TypeOf((*MockRestClient)(nil).PutSubResourceWithData), data, resourcePath, subResourcePath)
+}
+
+// PutWithoutID mocks base method.
+func (m *MockRestClient) PutWithoutID(data any, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWithoutID", data, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWithoutID indicates an expected call of PutWithoutID.
+func (mr *MockRestClientMockRecorder) PutWithoutID(data, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithoutID", reflect.TypeOf((*MockRestClient)(nil).PutWithoutID), data, resourcePath)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: instana/restapi/users-api.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsersOverviewResource is a mock of UsersOverviewResource interface.
+type MockUsersOverviewResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersOverviewResourceMockRecorder
+}
+
+// MockUsersOverviewResourceMockRecorder is the mock recorder for MockUsersOverviewResource.
+type MockUsersOverviewResourceMockRecorder struct {
+	mock *MockUsersOverviewResource
+}
+
+// NewMockUsersOverviewResource creates a new mock instance.
+func NewMockUsersOverviewResource(ctrl *gomock.Controller) *MockUsersOverviewResource {
+	mock := &MockUsersOverviewResource{ctrl: ctrl}
+	mock.recorder = &MockUsersOverviewResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersOverviewResource) EXPECT() *MockUsersOverviewResourceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockUsersOverviewResource) Get() (*restapi.UsersOverview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get")
+	ret0, _ := ret[0].(*restapi.UsersOverview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUsersOverviewResourceMockRecorder) Get() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsersOverviewResource)(nil).Get))
+}